@@ -64,7 +64,7 @@ func accountCommands() []*cobra.Command {
 					Amount:      amount,
 				}
 
-				tx, err := prepareTxBytes(msg, pk)
+				tx, err := prepareTxBytes(cmd, msg, pk)
 				if err != nil {
 					return err
 				}
@@ -67,6 +67,7 @@ func newActorCommands(cmdDef actorCmdDef) []*cobra.Command {
 		newStakeCmd(cmdDef),
 		newEditStakeCmd(cmdDef),
 		newUnstakeCmd(cmdDef),
+		newPauseCmd(cmdDef),
 		newUnpauseCmd(cmdDef),
 	}
 	applySubcommandOptions(cmds, attachPwdFlagToSubcommands())
@@ -132,7 +133,7 @@ If no changes are desired for the parameter, just enter the current param value
 				ActorType:     cmdDef.ActorType,
 			}
 
-			tx, err := prepareTxBytes(msg, pk)
+			tx, err := prepareTxBytes(cmd, msg, pk)
 			if err != nil {
 				return err
 			}
@@ -197,7 +198,7 @@ func newEditStakeCmd(cmdDef actorCmdDef) *cobra.Command {
 				ActorType:  cmdDef.ActorType,
 			}
 
-			tx, err := prepareTxBytes(msg, pk)
+			tx, err := prepareTxBytes(cmd, msg, pk)
 			if err != nil {
 				return err
 			}
@@ -248,7 +249,7 @@ func newUnstakeCmd(cmdDef actorCmdDef) *cobra.Command {
 				ActorType: cmdDef.ActorType,
 			}
 
-			tx, err := prepareTxBytes(msg, pk)
+			tx, err := prepareTxBytes(cmd, msg, pk)
 			if err != nil {
 				return err
 			}
@@ -267,6 +268,56 @@ func newUnstakeCmd(cmdDef actorCmdDef) *cobra.Command {
 	return unstakeCmd
 }
 
+func newPauseCmd(cmdDef actorCmdDef) *cobra.Command {
+	pauseCmd := &cobra.Command{
+		Use:   "Pause <fromAddr>",
+		Short: "Pause <fromAddr>",
+		Long:  fmt.Sprintf(`Pauses the %s actor with address <fromAddr>`, cmdDef.Name),
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Unpack CLI arguments
+			fromAddrHex := args[0]
+
+			kb, err := keybaseForCLI()
+			if err != nil {
+				return err
+			}
+
+			pwd = readPassphrase(pwd)
+
+			pk, err := kb.GetPrivKey(fromAddrHex, pwd)
+			if err != nil {
+				return err
+			}
+			if err := kb.Stop(); err != nil {
+				return err
+			}
+
+			msg := &typesUtil.MessagePause{
+				Address:   pk.Address(),
+				Signer:    pk.Address(),
+				ActorType: cmdDef.ActorType,
+			}
+
+			tx, err := prepareTxBytes(cmd, msg, pk)
+			if err != nil {
+				return err
+			}
+
+			resp, err := postRawTx(cmd.Context(), pk, tx)
+			if err != nil {
+				return err
+			}
+			// DISCUSS(#310): define UX for return values - should we return the raw response or a parsed/human readable response? For now, I am simply printing to stdout
+			fmt.Printf("HTTP status code: %d\n", resp.StatusCode())
+			fmt.Println(string(resp.Body))
+
+			return nil
+		},
+	}
+	return pauseCmd
+}
+
 func newUnpauseCmd(cmdDef actorCmdDef) *cobra.Command {
 	unpauseCmd := &cobra.Command{
 		Use:   "Unpause <fromAddr>",
@@ -298,7 +349,7 @@ func newUnpauseCmd(cmdDef actorCmdDef) *cobra.Command {
 				ActorType: cmdDef.ActorType,
 			}
 
-			tx, err := prepareTxBytes(msg, pk)
+			tx, err := prepareTxBytes(cmd, msg, pk)
 			if err != nil {
 				return err
 			}
@@ -2,7 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"net/http"
 
+	"github.com/pokt-network/pocket/rpc"
 	"github.com/pokt-network/pocket/utility/types"
 	"github.com/spf13/cobra"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -26,6 +28,7 @@ func NewGovernanceCommand() *cobra.Command {
 	applySubcommandOptions(cmds, attachKeybaseFlagsToSubcommands())
 
 	cmd.AddCommand(cmds...)
+	cmd.AddCommand(govQueryCommands()...)
 
 	return cmd
 }
@@ -74,7 +77,7 @@ func govCommands() []*cobra.Command {
 					ParameterValue: pbValue,
 				}
 
-				tx, err := prepareTxBytes(msg, pk)
+				tx, err := prepareTxBytes(cmd, msg, pk)
 				if err != nil {
 					return err
 				}
@@ -93,3 +96,43 @@ func govCommands() []*cobra.Command {
 	}
 	return cmds
 }
+
+// govQueryCommands returns the governance commands querying the node, which do not need a key
+func govQueryCommands() []*cobra.Command {
+	var queryHeight int64
+	relayChainsCmd := &cobra.Command{
+		Use:     "RelayChains",
+		Short:   "RelayChains",
+		Long:    "Returns the registry of the relay chains actors can stake for",
+		Aliases: []string{"relaychains"},
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := rpc.NewClientWithResponses(remoteCLIURL)
+			if err != nil {
+				return err
+			}
+			params := &rpc.GetV1QueryRelayChainsParams{}
+			if cmd.Flags().Changed("height") {
+				params.Height = &queryHeight
+			}
+			response, err := client.GetV1QueryRelayChainsWithResponse(cmd.Context(), params)
+			if err != nil {
+				return unableToConnectToRpc(err)
+			}
+			if response.StatusCode() != http.StatusOK || response.JSON200 == nil {
+				return rpcResponseCodeUnhealthy(response.StatusCode(), response.Body)
+			}
+
+			if len(response.JSON200.RelayChains) == 0 {
+				fmt.Printf("The relay chain registry is empty at height %d: actors can stake for any chain\n", response.JSON200.Height)
+				return nil
+			}
+			for _, relayChain := range response.JSON200.RelayChains {
+				fmt.Printf("%s\t%s\n", relayChain.Id, relayChain.Name)
+			}
+			return nil
+		},
+	}
+	relayChainsCmd.Flags().Int64Var(&queryHeight, "height", 0, "the height of the query, the latest committed height by default")
+	return []*cobra.Command{relayChainsCmd}
+}
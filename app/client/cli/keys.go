@@ -26,6 +26,9 @@ var (
 	storeChild bool
 	childPwd   string
 	childHint  string
+
+	multisigPubKeys   []string
+	multisigThreshold uint32
 )
 
 func init() {
@@ -48,6 +51,7 @@ func NewKeysCommand() *cobra.Command {
 	cmd.AddCommand(keysImportCommands()...)
 	cmd.AddCommand(keysSignMsgCommands()...)
 	cmd.AddCommand(keysSignTxCommands()...)
+	cmd.AddCommand(keysMultisigCommands()...)
 	cmd.AddCommand(keysSlipCommands()...)
 
 	return cmd
@@ -644,6 +648,144 @@ func keysSignTxCommands() []*cobra.Command {
 	return cmds
 }
 
+func keysMultisigCommands() []*cobra.Command {
+	cmds := []*cobra.Command{
+		{
+			Use:     "MultisigAddress [--multisig_pub_keys] [--multisig_threshold]",
+			Short:   "Gets the address of a multisig account",
+			Long:    "Gets the address of the multisig account controlled by any [--multisig_threshold] of [--multisig_pub_keys]",
+			Aliases: []string{"multisigaddress"},
+			Args:    cobra.ExactArgs(0),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				multiSig, err := newMultiSignatureFromFlags()
+				if err != nil {
+					return err
+				}
+				multisigPubKey, err := multiSig.MultisigPublicKey()
+				if err != nil {
+					return err
+				}
+
+				logger.Global.Info().Str("address", multisigPubKey.Address().String()).Uint32("threshold", multisigThreshold).Msg("Multisig address")
+
+				return nil
+			},
+		},
+		{
+			Use:   "CoSignTx <addrHex> [--input_file] [--output_file] [--multisig_pub_keys] [--multisig_threshold]",
+			Short: "Co-signs a multisig transaction using the key provided",
+			Long: `Adds the signature of <addrHex> from the keybase to the multi signature of [--input_file], writing the co-signed transaction to [--output_file].
+The first co-signer must define the multisig account of the transaction with [--multisig_pub_keys] and [--multisig_threshold].
+The transaction can be submitted once it has been co-signed by [--multisig_threshold] keys, and cannot be co-signed by more.`,
+			Aliases: []string{"cosigntx"},
+			Args:    cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				// Unpack CLI args
+				addrHex := args[0]
+
+				if inputFile == "" {
+					return fmt.Errorf("no input file provided")
+				} else if outputFile == "" {
+					return fmt.Errorf("no output file provided")
+				}
+
+				kb, err := keybaseForCLI()
+				if err != nil {
+					return err
+				}
+
+				if !nonInteractive {
+					pwd = readPassphrase(pwd)
+				}
+
+				privKey, err := kb.GetPrivKey(addrHex, pwd)
+				if err != nil {
+					return err
+				}
+
+				if err := kb.Stop(); err != nil {
+					return err
+				}
+
+				// Unmarshal Tx from input file
+				txBz, err := utils.ReadInput(inputFile)
+				if err != nil {
+					return err
+				}
+				txProto := new(coreTypes.Transaction)
+				if err := codec.GetCodec().Unmarshal(txBz, txProto); err != nil {
+					return err
+				}
+
+				// Define the multisig account of the transaction if this is the first co-signer
+				if txProto.MultiSignature == nil {
+					txProto.MultiSignature, err = newMultiSignatureFromFlags()
+					if err != nil {
+						return err
+					}
+				}
+
+				// Add the signature to the multi signature of the transaction
+				if err := txProto.CoSign(privKey); err != nil {
+					return err
+				}
+
+				// Re-serealise the transaction and write to output_file
+				txBz, err = codec.GetCodec().Marshal(txProto)
+				if err != nil {
+					return err
+				}
+
+				if err := utils.WriteOutput(txBz, outputFile); err != nil {
+					return err
+				}
+
+				logger.Global.Info().
+					Str("signed_transaction_file", outputFile).
+					Str("address", addrHex).
+					Int("num_signatures", len(txProto.MultiSignature.Signatures)).
+					Uint32("threshold", txProto.MultiSignature.Threshold).
+					Msg("Transaction co-signed")
+
+				return nil
+			},
+		},
+	}
+
+	// Add --multisig_pub_keys and --multisig_threshold flags
+	applySubcommandOptions(cmds, attachMultisigFlagsToSubcommands())
+
+	// Add --pwd, --input_file, --output_file and --keybase flags to CoSignTx
+	coSignCmds := cmds[1:]
+	applySubcommandOptions(coSignCmds, attachPwdFlagToSubcommands())
+	applySubcommandOptions(coSignCmds, attachInputFlagToSubcommands())
+	applySubcommandOptions(coSignCmds, attachOutputFlagToSubcommands())
+	applySubcommandOptions(coSignCmds, attachKeybaseFlagsToSubcommands())
+
+	return cmds
+}
+
+// newMultiSignatureFromFlags returns an empty multi signature for the multisig account defined by the
+// [--multisig_pub_keys] and [--multisig_threshold] flags
+func newMultiSignatureFromFlags() (*coreTypes.MultiSignature, error) {
+	if len(multisigPubKeys) == 0 {
+		return nil, fmt.Errorf("no multisig public keys provided")
+	}
+	pubKeysBz := make([][]byte, len(multisigPubKeys))
+	for i, pubKeyHex := range multisigPubKeys {
+		pubKeyBz, err := hex.DecodeString(pubKeyHex)
+		if err != nil {
+			return nil, err
+		}
+		pubKeysBz[i] = pubKeyBz
+	}
+	multisigPubKey, err := crypto.NewMultisigPublicKeyFromBytes(pubKeysBz, multisigThreshold)
+	if err != nil {
+		return nil, err
+	}
+	return coreTypes.NewMultiSignature(multisigPubKey), nil
+}
+
 func keysSlipCommands() []*cobra.Command {
 	cmds := []*cobra.Command{
 		{
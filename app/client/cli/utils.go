@@ -25,6 +25,9 @@ import (
 	"golang.org/x/term"
 )
 
+// txExpiryBlocks is the number of blocks the transactions prepared by the CLI remain valid for
+const txExpiryBlocks = 50
+
 var (
 	kbTypeStrFromCLI        string
 	kbVaultAddrFromCLI      string
@@ -93,16 +96,22 @@ func confirmation(pwd string) bool {
 // prepareTxBytes wraps a Message into a Transaction and signs it with the provided pk
 //
 // returns the raw protobuf bytes of the signed transaction
-func prepareTxBytes(msg typesUtil.Message, pk crypto.PrivateKey) ([]byte, error) {
+func prepareTxBytes(cmd *cobra.Command, msg typesUtil.Message, pk crypto.PrivateKey) ([]byte, error) {
 	var err error
 	anyMsg, err := codec.GetCodec().ToAny(msg)
 	if err != nil {
 		return nil, err
 	}
 
+	expiryHeight, err := getTxExpiryHeight(cmd)
+	if err != nil {
+		return nil, err
+	}
+
 	tx := &coreTypes.Transaction{
-		Msg:   anyMsg,
-		Nonce: fmt.Sprintf("%d", crypto.GetNonce()),
+		Msg:          anyMsg,
+		Nonce:        fmt.Sprintf("%d", crypto.GetNonce()),
+		ExpiryHeight: expiryHeight,
 	}
 
 	signBytes, err := tx.SignableBytes()
@@ -127,6 +136,19 @@ func prepareTxBytes(msg typesUtil.Message, pk crypto.PrivateKey) ([]byte, error)
 	return bz, nil
 }
 
+// getTxExpiryHeight returns the height after which the transactions prepared by the CLI cannot be included in a block,
+// `txExpiryBlocks` after the current height of the node
+func getTxExpiryHeight(cmd *cobra.Command) (int64, error) {
+	response, err := getConsensusState(cmd)
+	if err != nil {
+		return 0, err
+	}
+	if response == nil || response.JSONDefault == nil {
+		return 0, fmt.Errorf("unable to get the current height from the node at %s", remoteCLIURL)
+	}
+	return response.JSONDefault.Height + txExpiryBlocks, nil
+}
+
 // postRawTx posts a signed transaction
 func postRawTx(ctx context.Context, pk crypto.PrivateKey, j []byte) (*rpc.PostV1ClientBroadcastTxSyncResponse, error) {
 	client, err := rpc.NewClientWithResponses(remoteCLIURL)
@@ -249,6 +271,13 @@ func attachChildPwdFlagToSubcommands() []cmdOption {
 	}}
 }
 
+func attachMultisigFlagsToSubcommands() []cmdOption {
+	return []cmdOption{func(c *cobra.Command) {
+		c.Flags().StringSliceVar(&multisigPubKeys, "multisig_pub_keys", nil, "comma separated hex encoded public keys of the multisig account")
+		c.Flags().Uint32Var(&multisigThreshold, "multisig_threshold", 0, "minimum number of signatures required by the multisig account")
+	}}
+}
+
 func attachKeybaseFlagsToSubcommands() []cmdOption {
 	return []cmdOption{func(c *cobra.Command) {
 		c.Flags().StringVar(&kbTypeStrFromCLI, "keybase", "", "keybase type used by the cmd, options are: file, vault")
@@ -395,6 +395,7 @@ func basePersistenceMock(t *testing.T, _ modules.EventsChannel, bus modules.Bus)
 
 	persistenceReadContextMock.EXPECT().GetAllValidators(gomock.Any()).Return(bus.GetRuntimeMgr().GetGenesis().Validators, nil).AnyTimes()
 	persistenceReadContextMock.EXPECT().GetBlockHash(gomock.Any()).Return("", nil).AnyTimes()
+	persistenceReadContextMock.EXPECT().GetUpgradePlan(gomock.Any()).Return(nil, nil).AnyTimes()
 	persistenceReadContextMock.EXPECT().Release().AnyTimes()
 
 	return persistenceMock
@@ -445,6 +446,7 @@ func baseUtilityMock(t *testing.T, _ modules.EventsChannel, genesisState *genesi
 			}).
 		MaxTimes(4)
 	utilityMock.EXPECT().GetModuleName().Return(modules.UtilityModuleName).AnyTimes()
+	utilityMock.EXPECT().HandleEvent(gomock.Any()).Return(nil).AnyTimes()
 
 	return utilityMock
 }
@@ -54,6 +54,9 @@ func (m *consensusModule) handleStateTransitionEvent(msg *messaging.StateMachine
 	case coreTypes.StateMachineState_Consensus_Pacemaker:
 		return m.HandlePacemaker(msg)
 
+	case coreTypes.StateMachineState_Consensus_Halted:
+		return m.HandleHalted(msg)
+
 	default:
 		m.logger.Warn().Msgf("Consensus module not handling this event: %s", msg.Event)
 
@@ -105,3 +108,12 @@ func (m *consensusModule) HandlePacemaker(msg *messaging.StateMachineTransitionE
 	// transitioning out of this state happens when a new block proposal is received by the hotstuff_replica
 	return nil
 }
+
+// HandleHalted handles FSM event Consensus_IsHalted, and Halted is the destination state.
+// In Halted mode the node (validator or non-validator) reached the activation height of an upgrade without running the
+// binary it expects. It neither proposes nor votes, and never transitions out of this state: it must be restarted with
+// the expected binary.
+func (m *consensusModule) HandleHalted(msg *messaging.StateMachineTransitionEvent) error {
+	m.logger.Warn().Msg("Node is halted for an upgrade, and will not participate in consensus until it is restarted with the expected binary")
+	return nil
+}
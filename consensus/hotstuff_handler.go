@@ -19,6 +19,12 @@ func (m *consensusModule) handleHotstuffMessage(msg *typesCons.HotstuffMessage)
 
 	m.logger.Debug().Fields(loggingFields).Msg("Received hotstuff msg...")
 
+	// Upgrades - Neither propose nor vote past the activation height of an upgrade this binary is not the one of
+	if halted, err := m.isHaltedForUpgrade(); halted || err != nil {
+		m.logger.Debug().Fields(loggingFields).Msg("Not handling hotstuff msg, consensus is halted for an upgrade...")
+		return err
+	}
+
 	// Pacemaker - Liveness & safety checks
 	if shouldHandle, err := m.paceMaker.ShouldHandleMessage(msg); !shouldHandle {
 		m.logger.Debug().Fields(loggingFields).Msg("Not handling hotstuff msg...")
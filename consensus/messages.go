@@ -3,7 +3,6 @@ package consensus
 import (
 	typesCons "github.com/pokt-network/pocket/consensus/types"
 	"github.com/pokt-network/pocket/logger"
-	"github.com/pokt-network/pocket/shared/codec"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"github.com/pokt-network/pocket/shared/crypto"
 )
@@ -77,7 +76,7 @@ func CreateVoteMessage(
 // Returns "partial" signature of the hotstuff message from one of the validators.
 // If there is an error signing the bytes, nil is returned instead.
 func getMessageSignature(msg *typesCons.HotstuffMessage, privKey crypto.PrivateKey) []byte {
-	bytesToSign, err := getSignableBytes(msg)
+	bytesToSign, err := msg.SignableBytes()
 	if err != nil {
 		logger.Global.Warn().Err(err).Msgf("error getting bytes to sign")
 		return nil
@@ -91,16 +90,3 @@ func getMessageSignature(msg *typesCons.HotstuffMessage, privKey crypto.PrivateK
 
 	return signature
 }
-
-// Signature only over subset of fields in HotstuffMessage
-// For reference, see section 4.3 of the the hotstuff whitepaper, partial signatures are
-// computed over `tsignr(hm.type, m.viewNumber , m.nodei)`. https://arxiv.org/pdf/1803.05069.pdf
-func getSignableBytes(msg *typesCons.HotstuffMessage) ([]byte, error) {
-	msgToSign := &typesCons.HotstuffMessage{
-		Height: msg.GetHeight(),
-		Step:   msg.GetStep(),
-		Round:  msg.GetRound(),
-		Block:  msg.GetBlock(),
-	}
-	return codec.GetCodec().Marshal(msgToSign)
-}
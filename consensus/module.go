@@ -70,6 +70,10 @@ type consensusModule struct {
 	stateSync state_sync.StateSyncModule
 
 	hotstuffMempool map[typesCons.HotstuffStep]*hotstuffFIFOMempool
+
+	// Upgrades
+	haltedForUpgrade     bool   // set once the node reached the activation height of an upgrade without running its binary
+	upgradeCheckedHeight uint64 // the last height the upgrade plan was checked at
 }
 
 func Create(bus modules.Bus, options ...modules.ModuleOption) (modules.Module, error) {
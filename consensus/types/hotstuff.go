@@ -0,0 +1,16 @@
+package types
+
+import "github.com/pokt-network/pocket/shared/codec"
+
+// SignableBytes returns the bytes validators sign, which only cover a subset of the fields of the HotstuffMessage.
+// For reference, see section 4.3 of the the hotstuff whitepaper, partial signatures are
+// computed over `tsignr(hm.type, m.viewNumber , m.nodei)`. https://arxiv.org/pdf/1803.05069.pdf
+func (m *HotstuffMessage) SignableBytes() ([]byte, error) {
+	msgToSign := &HotstuffMessage{
+		Height: m.GetHeight(),
+		Step:   m.GetStep(),
+		Round:  m.GetRound(),
+		Block:  m.GetBlock(),
+	}
+	return codec.GetCodec().Marshal(msgToSign)
+}
@@ -0,0 +1,47 @@
+package consensus
+
+import (
+	"github.com/pokt-network/pocket/app"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// isHaltedForUpgrade returns whether the node must stop proposing and voting at its current height, i.e. whether an
+// upgrade activates at it while the binary running is not the one the upgrade expects. The first time it happens, the
+// expected binary is logged and the state machine transitions to the halted state. Consensus resumes once the node is
+// restarted with the expected binary. Once the block at the activation height is committed, the plan is marked as
+// applied and no longer halts the nodes, e.g. those running a later version.
+func (m *consensusModule) isHaltedForUpgrade() (bool, error) {
+	if m.haltedForUpgrade {
+		return true, nil
+	}
+	// The upgrade plan can only change when a block is committed, so it is checked once per height
+	if m.height == 0 || m.upgradeCheckedHeight == m.height {
+		return false, nil
+	}
+
+	committedHeight := int64(m.height) - 1
+	readCtx, err := m.GetBus().GetPersistenceModule().NewReadContext(committedHeight)
+	if err != nil {
+		return false, err
+	}
+	defer readCtx.Release()
+
+	plan, err := readCtx.GetUpgradePlan(committedHeight)
+	if err != nil {
+		return false, err
+	}
+	m.upgradeCheckedHeight = m.height
+	if plan == nil || plan.GetApplied() || plan.GetActivationHeight() != int64(m.height) || plan.GetName() == app.AppVersion {
+		return false, nil
+	}
+
+	m.haltedForUpgrade = true
+	m.logger.Error().
+		Str("expected_version", plan.GetName()).
+		Str("running_version", app.AppVersion).
+		Int64("activation_height", plan.GetActivationHeight()).
+		Uint64("height", m.height).
+		Msgf("🛑 Halting consensus for the upgrade: restart the node with a binary whose app.AppVersion is %s 🛑", plan.GetName())
+
+	return true, m.GetBus().GetStateMachineModule().SendEvent(coreTypes.StateMachineEvent_Consensus_IsHalted)
+}
@@ -1,6 +1,8 @@
 package persistence
 
 import (
+	"fmt"
+
 	"github.com/pokt-network/pocket/persistence/types"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 )
@@ -127,3 +129,12 @@ func (p *PostgresContext) GetAllStakedActors(height int64) (allActors []*coreTyp
 	}
 	return
 }
+
+// GetActor returns the actor of type `actorType` with the given `address` at `height`
+func (p *PostgresContext) GetActor(actorType coreTypes.ActorType, address []byte, height int64) (*coreTypes.Actor, error) {
+	actorSchema, ok := actorTypeToSchemaName[actorType]
+	if !ok {
+		return nil, fmt.Errorf("no schema found for actor type: %s", actorType)
+	}
+	return p.getActor(actorSchema, address, height)
+}
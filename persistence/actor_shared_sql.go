@@ -75,6 +75,7 @@ func (p *PostgresContext) getActorFromRow(actorType coreTypes.ActorType, row pgx
 		&actor.StakedAmount,
 		&actor.ServiceUrl,
 		&actor.Output,
+		&actor.GeoZone,
 		&actor.PausedHeight,
 		&actor.UnstakingHeight,
 		&height)
@@ -123,7 +124,7 @@ func (p *PostgresContext) InsertActor(actorSchema types.ProtocolActorSchema, act
 
 	_, err = tx.Exec(ctx, actorSchema.InsertQuery(
 		actor.Address, actor.PublicKey, actor.StakedAmount, actor.ServiceUrl,
-		actor.Output, actor.PausedHeight, actor.UnstakingHeight, actor.Chains,
+		actor.Output, actor.GeoZone, actor.PausedHeight, actor.UnstakingHeight, actor.Chains,
 		height))
 	return err
 }
@@ -136,7 +137,7 @@ func (p *PostgresContext) UpdateActor(actorSchema types.ProtocolActorSchema, act
 		return err
 	}
 
-	if _, err = tx.Exec(ctx, actorSchema.UpdateQuery(actor.Address, actor.StakedAmount, actor.ServiceUrl, height)); err != nil {
+	if _, err = tx.Exec(ctx, actorSchema.UpdateQuery(actor.Address, actor.StakedAmount, actor.ServiceUrl, actor.GeoZone, height)); err != nil {
 		return err
 	}
 
@@ -28,12 +28,13 @@ func (p *PostgresContext) GetApp(address []byte, height int64) (operator, public
 	return
 }
 
-func (p *PostgresContext) InsertApp(address, publicKey, output []byte, _ bool, _ int32, stakedTokens string, chains []string, pausedHeight, unstakingHeight int64) error {
+func (p *PostgresContext) InsertApp(address, publicKey, output []byte, _ bool, _ int32, stakedTokens string, chains []string, geoZone string, pausedHeight, unstakingHeight int64) error {
 	return p.InsertActor(types.ApplicationActor, &coreTypes.Actor{
 		ActorType:       coreTypes.ActorType_ACTOR_TYPE_APP,
 		Address:         hex.EncodeToString(address),
 		PublicKey:       hex.EncodeToString(publicKey),
 		Chains:          chains,
+		GeoZone:         geoZone,
 		StakedAmount:    stakedTokens,
 		PausedHeight:    pausedHeight,
 		UnstakingHeight: unstakingHeight,
@@ -41,11 +42,12 @@ func (p *PostgresContext) InsertApp(address, publicKey, output []byte, _ bool, _
 	})
 }
 
-func (p *PostgresContext) UpdateApp(address []byte, stakedAmount string, chains []string) error {
+func (p *PostgresContext) UpdateApp(address []byte, stakedAmount string, chains []string, geoZone string) error {
 	return p.UpdateActor(types.ApplicationActor, &coreTypes.Actor{
 		ActorType:    coreTypes.ActorType_ACTOR_TYPE_APP,
 		Address:      hex.EncodeToString(address),
 		Chains:       chains,
+		GeoZone:      geoZone,
 		StakedAmount: stakedAmount,
 	})
 }
@@ -0,0 +1,98 @@
+package persistence
+
+import (
+	"encoding/hex"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pokt-network/pocket/persistence/types"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+func (p *PostgresContext) InsertClaim(claim *coreTypes.Claim) error {
+	ctx, tx := p.getCtxAndTx()
+	_, err := tx.Exec(ctx, types.InsertClaimQuery(claim, false, p.Height))
+	return err
+}
+
+func (p *PostgresContext) DeleteClaim(servicerAddr, sessionID []byte) error {
+	claim, removed, err := p.getClaim(hex.EncodeToString(servicerAddr), hex.EncodeToString(sessionID), p.Height)
+	if err != nil {
+		return err
+	}
+	// Deleting a claim that does not exist is a no-op
+	if claim == nil || removed {
+		return nil
+	}
+	ctx, tx := p.getCtxAndTx()
+	_, err = tx.Exec(ctx, types.InsertClaimQuery(claim, true, p.Height))
+	return err
+}
+
+func (p *PostgresContext) GetClaim(servicerAddr, sessionID []byte, height int64) (*coreTypes.Claim, error) {
+	claim, removed, err := p.getClaim(hex.EncodeToString(servicerAddr), hex.EncodeToString(sessionID), height)
+	if err != nil || removed {
+		return nil, err
+	}
+	return claim, nil
+}
+
+func (p *PostgresContext) GetAllClaims(height int64) (claims []*coreTypes.Claim, err error) {
+	ctx, tx := p.getCtxAndTx()
+	rows, err := tx.Query(ctx, types.GetAllClaimsQuery(height))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		claim, _, err := scanClaim(rows)
+		if err != nil {
+			return nil, err
+		}
+		claims = append(claims, claim)
+	}
+	return claims, rows.Err()
+}
+
+// getClaim returns the latest version of the claim at `height`, or nil if it was never submitted
+func (p *PostgresContext) getClaim(servicerAddr, sessionID string, height int64) (claim *coreTypes.Claim, removed bool, err error) {
+	ctx, tx := p.getCtxAndTx()
+	claim, removed, err = scanClaim(tx.QueryRow(ctx, types.GetClaimQuery(servicerAddr, sessionID, height)))
+	if err == pgx.ErrNoRows {
+		return nil, false, nil
+	}
+	return
+}
+
+// getClaimsUpdatedAtHeight returns the claims inserted or removed at `height`, along with whether they were removed
+func (p *PostgresContext) getClaimsUpdatedAtHeight(height int64) (claims []*coreTypes.Claim, removed []bool, err error) {
+	ctx, tx := p.getCtxAndTx()
+	rows, err := tx.Query(ctx, types.GetClaimsUpdatedAtHeightQuery(height))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		claim, isRemoved, err := scanClaim(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+		claims = append(claims, claim)
+		removed = append(removed, isRemoved)
+	}
+	return claims, removed, rows.Err()
+}
+
+func scanClaim(row pgx.Row) (claim *coreTypes.Claim, removed bool, err error) {
+	claim = new(coreTypes.Claim)
+	var totalRelays int64
+	err = row.Scan(
+		&claim.ServicerAddress, &claim.SessionId, &claim.SessionHeight, &claim.RelayChain, &claim.GeoZone,
+		&claim.ApplicationPublicKey, &claim.RootHash, &totalRelays, &claim.Height, &removed)
+	if err != nil {
+		return nil, false, err
+	}
+	claim.TotalRelays = uint64(totalRelays)
+	return claim, removed, nil
+}
@@ -4,12 +4,15 @@ package persistence
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
+	"fmt"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/pokt-network/pocket/persistence/indexer"
 	"github.com/pokt-network/pocket/persistence/kvstore"
+	"github.com/pokt-network/pocket/shared/crypto"
 	"github.com/pokt-network/pocket/shared/modules"
 )
 
@@ -31,22 +34,50 @@ type PostgresContext struct {
 	blockStore kvstore.KVStore
 	txIndexer  indexer.TxIndexer
 	stateTrees *stateTrees
+
+	// Whether the context was created by `NewSimulationContext`, in which case its changes cannot be persisted
+	isSimulation bool
+	// Called once a simulation context is released, to allow the next one to be created
+	releaseSimulation func()
 }
 
+const (
+	// simulationContextLockTimeout bounds how long a simulation context waits for the rows locked by the write context.
+	// It must stay below postgres' `deadlock_timeout` (see `NewSimulationContext`).
+	simulationContextLockTimeout = "100ms"
+	// simulationContextStatementTimeout bounds how long a single statement of a simulation context can run
+	simulationContextStatementTimeout = "1s"
+	// simulationContextSavePoint is the save point every simulation context starts from
+	simulationContextSavePoint = "simulation"
+)
+
+// NewSavePoint creates a save point in the current database transaction that it can later be rolled back to
 func (p *PostgresContext) NewSavePoint(bytes []byte) error {
-	p.logger.Info().Bool("TODO", true).Msg("NewSavePoint not implemented")
-	return nil
+	ctx, tx := p.getCtxAndTx()
+	_, err := tx.Exec(ctx, fmt.Sprintf("SAVEPOINT %s", savePointName(bytes)))
+	return err
 }
 
+// RollbackToSavePoint discards all the changes made to the current database transaction since the save point was created
 // TECHDEBT(#327): Guarantee atomicity betweens `prepareBlock`, `insertBlock` and `storeBlock` for save points & rollbacks.
 func (p *PostgresContext) RollbackToSavePoint(bytes []byte) error {
-	p.logger.Info().Bool("TODO", true).Msg("RollbackToSavePoint not fully implemented")
-	return p.tx.Rollback(context.TODO())
+	ctx, tx := p.getCtxAndTx()
+	_, err := tx.Exec(ctx, fmt.Sprintf("ROLLBACK TO SAVEPOINT %s", savePointName(bytes)))
+	return err
+}
+
+// savePointName derives the identifier of a save point from its key, bounded to the maximum identifier length of postgres
+func savePointName(bytes []byte) string {
+	return fmt.Sprintf("save_point_%s", hex.EncodeToString(crypto.SHA3Hash(bytes)[:16]))
 }
 
 // IMPROVE(#361): Guarantee the integrity of the state
 // Full details in the thread from the PR review: https://github.com/pokt-network/pocket/pull/285#discussion_r1018471719
 func (p *PostgresContext) ComputeStateHash() (string, error) {
+	// The state trees are shared with the write context
+	if p.isSimulation {
+		return "", fmt.Errorf("cannot compute the state hash of a simulation context")
+	}
 	stateHash, err := p.updateMerkleTrees()
 	if err != nil {
 		return "", err
@@ -57,6 +88,9 @@ func (p *PostgresContext) ComputeStateHash() (string, error) {
 
 // TECHDEBT(#327): Make sure these operations are atomic
 func (p *PostgresContext) Commit(proposerAddr, quorumCert []byte) error {
+	if p.isSimulation {
+		return fmt.Errorf("cannot commit a simulation context")
+	}
 	p.logger.Info().Int64("height", p.Height).Msg("About to commit block & context")
 
 	// Create a persistence block proto
@@ -105,6 +139,11 @@ func (p *PostgresContext) Release() {
 		p.conn.Release()
 		p.conn = nil
 	}
+
+	if p.releaseSimulation != nil {
+		p.releaseSimulation()
+		p.releaseSimulation = nil
+	}
 }
 
 // INVESTIGATE(#361): Revisit if is used correctly in the context of the lifecycle of a persistenceContext and a utilityUnitOfWork
@@ -128,6 +128,34 @@ func initializeAllTables(ctx context.Context, db *pgxpool.Conn) error {
 		return err
 	}
 
+	if err := initializeClaimTables(ctx, db); err != nil {
+		return err
+	}
+
+	if err := initializeDoubleSignEvidenceTables(ctx, db); err != nil {
+		return err
+	}
+
+	if err := initializeDelegationTables(ctx, db); err != nil {
+		return err
+	}
+
+	if err := initializeProposalTables(ctx, db); err != nil {
+		return err
+	}
+
+	if err := initializeUpgradePlanTables(ctx, db); err != nil {
+		return err
+	}
+
+	if err := initializeVestingScheduleTables(ctx, db); err != nil {
+		return err
+	}
+
+	if err := initializeOperatorRotationTables(ctx, db); err != nil {
+		return err
+	}
+
 	for _, actor := range protocolActorSchemas {
 		if err := initializeProtocolActorTables(ctx, db, actor); err != nil {
 			return err
@@ -184,3 +212,58 @@ func initializeBlockTables(ctx context.Context, db *pgxpool.Conn) error {
 	}
 	return nil
 }
+
+func initializeClaimTables(ctx context.Context, db *pgxpool.Conn) error {
+	if _, err := db.Exec(ctx, fmt.Sprintf(`%s %s %s %s`, CreateTable, IfNotExists, types.ClaimsTableName, types.ClaimsTableSchema)); err != nil {
+		return err
+	}
+	if _, err := db.Exec(ctx, fmt.Sprintf(`%s %s %s %s`, CreateTable, IfNotExists, types.TestScoresTableName, types.TestScoresTableSchema)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func initializeDoubleSignEvidenceTables(ctx context.Context, db *pgxpool.Conn) error {
+	if _, err := db.Exec(ctx, fmt.Sprintf(`%s %s %s %s`, CreateTable, IfNotExists, types.DoubleSignEvidenceTableName, types.DoubleSignEvidenceTableSchema)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func initializeDelegationTables(ctx context.Context, db *pgxpool.Conn) error {
+	if _, err := db.Exec(ctx, fmt.Sprintf(`%s %s %s %s`, CreateTable, IfNotExists, types.DelegationsTableName, types.DelegationsTableSchema)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func initializeProposalTables(ctx context.Context, db *pgxpool.Conn) error {
+	if _, err := db.Exec(ctx, fmt.Sprintf(`%s %s %s %s`, CreateTable, IfNotExists, types.ProposalsTableName, types.ProposalsTableSchema)); err != nil {
+		return err
+	}
+	if _, err := db.Exec(ctx, fmt.Sprintf(`%s %s %s %s`, CreateTable, IfNotExists, types.VotesTableName, types.VotesTableSchema)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func initializeUpgradePlanTables(ctx context.Context, db *pgxpool.Conn) error {
+	if _, err := db.Exec(ctx, fmt.Sprintf(`%s %s %s %s`, CreateTable, IfNotExists, types.UpgradePlansTableName, types.UpgradePlansTableSchema)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func initializeVestingScheduleTables(ctx context.Context, db *pgxpool.Conn) error {
+	if _, err := db.Exec(ctx, fmt.Sprintf(`%s %s %s %s`, CreateTable, IfNotExists, types.VestingSchedulesTableName, types.VestingSchedulesTableSchema)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func initializeOperatorRotationTables(ctx context.Context, db *pgxpool.Conn) error {
+	if _, err := db.Exec(ctx, fmt.Sprintf(`%s %s %s %s`, CreateTable, IfNotExists, types.OperatorRotationsTableName, types.OperatorRotationsTableSchema)); err != nil {
+		return err
+	}
+	return nil
+}
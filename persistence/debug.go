@@ -19,6 +19,15 @@ var nonActorClearFunctions = []func() string{
 	types.ClearAllGovParamsQuery,
 	types.ClearAllGovFlagsQuery,
 	types.ClearAllBlocksQuery,
+	types.ClearAllClaimsQuery,
+	types.ClearAllTestScoresQuery,
+	types.ClearAllDoubleSignEvidenceQuery,
+	types.ClearAllDelegationsQuery,
+	types.ClearAllProposalsQuery,
+	types.ClearAllVotesQuery,
+	types.ClearAllUpgradePlansQuery,
+	types.ClearAllVestingSchedulesQuery,
+	types.ClearAllOperatorRotationsQuery,
 }
 
 func (m *persistenceModule) HandleDebugMessage(debugMessage *messaging.DebugMessage) error {
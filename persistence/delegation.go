@@ -0,0 +1,91 @@
+package persistence
+
+import (
+	"encoding/hex"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pokt-network/pocket/persistence/types"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// SetDelegation inserts a new version of the delegation, so it is used both to create and to update delegations
+func (p *PostgresContext) SetDelegation(delegation *coreTypes.Delegation) error {
+	ctx, tx := p.getCtxAndTx()
+	_, err := tx.Exec(ctx, types.InsertDelegationQuery(delegation, false, p.Height))
+	return err
+}
+
+func (p *PostgresContext) DeleteDelegation(delegatorAddr, validatorAddr []byte) error {
+	delegation, removed, err := p.getDelegation(hex.EncodeToString(delegatorAddr), hex.EncodeToString(validatorAddr), p.Height)
+	if err != nil {
+		return err
+	}
+	// Deleting a delegation that does not exist is a no-op
+	if delegation == nil || removed {
+		return nil
+	}
+	ctx, tx := p.getCtxAndTx()
+	_, err = tx.Exec(ctx, types.InsertDelegationQuery(delegation, true, p.Height))
+	return err
+}
+
+func (p *PostgresContext) GetDelegation(delegatorAddr, validatorAddr []byte, height int64) (*coreTypes.Delegation, error) {
+	delegation, removed, err := p.getDelegation(hex.EncodeToString(delegatorAddr), hex.EncodeToString(validatorAddr), height)
+	if err != nil || removed {
+		return nil, err
+	}
+	return delegation, nil
+}
+
+func (p *PostgresContext) GetValidatorDelegations(validatorAddr []byte, height int64) ([]*coreTypes.Delegation, error) {
+	delegations, _, err := p.queryDelegations(types.GetValidatorDelegationsQuery(hex.EncodeToString(validatorAddr), height))
+	return delegations, err
+}
+
+func (p *PostgresContext) GetDelegationsReadyToUnbond(height int64) ([]*coreTypes.Delegation, error) {
+	delegations, _, err := p.queryDelegations(types.GetDelegationsReadyToUnbondQuery(height))
+	return delegations, err
+}
+
+// getDelegation returns the latest version of the delegation at `height`, or nil if it was never created
+func (p *PostgresContext) getDelegation(delegatorAddr, validatorAddr string, height int64) (delegation *coreTypes.Delegation, removed bool, err error) {
+	ctx, tx := p.getCtxAndTx()
+	delegation, removed, err = scanDelegation(tx.QueryRow(ctx, types.GetDelegationQuery(delegatorAddr, validatorAddr, height)))
+	if err == pgx.ErrNoRows {
+		return nil, false, nil
+	}
+	return
+}
+
+// getDelegationsUpdatedAtHeight returns the delegations inserted, updated or removed at `height`, along with whether they were removed
+func (p *PostgresContext) getDelegationsUpdatedAtHeight(height int64) (delegations []*coreTypes.Delegation, removed []bool, err error) {
+	return p.queryDelegations(types.GetDelegationsUpdatedAtHeightQuery(height))
+}
+
+func (p *PostgresContext) queryDelegations(query string) (delegations []*coreTypes.Delegation, removed []bool, err error) {
+	ctx, tx := p.getCtxAndTx()
+	rows, err := tx.Query(ctx, query)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		delegation, isRemoved, err := scanDelegation(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+		delegations = append(delegations, delegation)
+		removed = append(removed, isRemoved)
+	}
+	return delegations, removed, rows.Err()
+}
+
+func scanDelegation(row pgx.Row) (delegation *coreTypes.Delegation, removed bool, err error) {
+	delegation = new(coreTypes.Delegation)
+	err = row.Scan(&delegation.DelegatorAddress, &delegation.ValidatorAddress, &delegation.Amount, &delegation.UnstakingHeight, &removed)
+	if err != nil {
+		return nil, false, err
+	}
+	return delegation, removed, nil
+}
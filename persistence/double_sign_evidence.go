@@ -0,0 +1,41 @@
+package persistence
+
+import (
+	"encoding/hex"
+
+	"github.com/pokt-network/pocket/persistence/types"
+)
+
+func (p *PostgresContext) InsertDoubleSignEvidence(validatorAddr []byte, evidenceHeight int64) error {
+	ctx, tx := p.getCtxAndTx()
+	_, err := tx.Exec(ctx, types.InsertDoubleSignEvidenceQuery(hex.EncodeToString(validatorAddr), evidenceHeight, p.Height))
+	return err
+}
+
+func (p *PostgresContext) DoubleSignEvidenceExists(validatorAddr []byte, evidenceHeight, height int64) (exists bool, err error) {
+	ctx, tx := p.getCtxAndTx()
+	err = tx.QueryRow(ctx, types.DoubleSignEvidenceExistsQuery(hex.EncodeToString(validatorAddr), evidenceHeight, height)).Scan(&exists)
+	return
+}
+
+// getDoubleSignEvidenceAtHeight returns the hex encoded addresses of the validators punished at `height`, along with
+// the heights at which they double signed
+func (p *PostgresContext) getDoubleSignEvidenceAtHeight(height int64) (validatorAddrs []string, evidenceHeights []int64, err error) {
+	ctx, tx := p.getCtxAndTx()
+	rows, err := tx.Query(ctx, types.GetDoubleSignEvidenceAtHeightQuery(height))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var validatorAddr string
+		var evidenceHeight int64
+		if err := rows.Scan(&validatorAddr, &evidenceHeight); err != nil {
+			return nil, nil, err
+		}
+		validatorAddrs = append(validatorAddrs, validatorAddr)
+		evidenceHeights = append(evidenceHeights, evidenceHeight)
+	}
+	return validatorAddrs, evidenceHeights, rows.Err()
+}
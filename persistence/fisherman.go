@@ -26,12 +26,13 @@ func (p *PostgresContext) GetFisherman(address []byte, height int64) (operator,
 	return
 }
 
-func (p *PostgresContext) InsertFisherman(address, publicKey, output []byte, _ bool, _ int32, serviceURL, stakedTokens string, chains []string, pausedHeight, unstakingHeight int64) error {
+func (p *PostgresContext) InsertFisherman(address, publicKey, output []byte, _ bool, _ int32, serviceURL, stakedTokens string, chains []string, geoZone string, pausedHeight, unstakingHeight int64) error {
 	return p.InsertActor(types.FishermanActor, &coreTypes.Actor{
 		ActorType:       coreTypes.ActorType_ACTOR_TYPE_FISH,
 		Address:         hex.EncodeToString(address),
 		PublicKey:       hex.EncodeToString(publicKey),
 		Chains:          chains,
+		GeoZone:         geoZone,
 		ServiceUrl:      serviceURL,
 		StakedAmount:    stakedTokens,
 		PausedHeight:    pausedHeight,
@@ -40,13 +41,14 @@ func (p *PostgresContext) InsertFisherman(address, publicKey, output []byte, _ b
 	})
 }
 
-func (p *PostgresContext) UpdateFisherman(address []byte, serviceURL, stakedAmount string, chains []string) error {
+func (p *PostgresContext) UpdateFisherman(address []byte, serviceURL, stakedAmount string, chains []string, geoZone string) error {
 	return p.UpdateActor(types.FishermanActor, &coreTypes.Actor{
 		ActorType:    coreTypes.ActorType_ACTOR_TYPE_FISH,
 		Address:      hex.EncodeToString(address),
 		StakedAmount: stakedAmount,
 		ServiceUrl:   serviceURL,
 		Chains:       chains,
+		GeoZone:      geoZone,
 	})
 }
 
@@ -44,6 +44,28 @@ func (m *persistenceModule) populateGenesisState(state *genesis.GenesisState) {
 			m.logger.Fatal().Err(err).Str("address", acc.GetAddress()).Msg("an error occurred inserting an acc in the genesis state")
 		}
 	}
+	// The vesting tokens are credited to the account, and locked by its vesting schedule until they vest.
+	// An account has a single vesting schedule, so duplicates are rejected rather than crediting the account twice.
+	vestingAddresses := make(map[string]struct{}, len(state.GetVestingSchedules()))
+	for _, schedule := range state.GetVestingSchedules() {
+		if schedule.GetStartHeight() > schedule.GetCliffHeight() || schedule.GetCliffHeight() > schedule.GetEndHeight() {
+			m.logger.Fatal().Str("address", schedule.GetAddress()).Msg("the vesting schedule heights must satisfy start <= cliff <= end")
+		}
+		if _, ok := vestingAddresses[schedule.GetAddress()]; ok {
+			m.logger.Fatal().Str("address", schedule.GetAddress()).Msg("an account cannot have more than one vesting schedule in the genesis state")
+		}
+		vestingAddresses[schedule.GetAddress()] = struct{}{}
+		addrBz, err := hex.DecodeString(schedule.GetAddress())
+		if err != nil {
+			m.logger.Fatal().Err(err).Str("address", schedule.GetAddress()).Msg("an error occurred converting address to bytes")
+		}
+		if err = rwCtx.AddAccountAmount(addrBz, schedule.GetAmount()); err != nil {
+			m.logger.Fatal().Err(err).Str("address", schedule.GetAddress()).Msg("an error occurred crediting a vesting acc in the genesis state")
+		}
+		if err = rwCtx.SetVestingSchedule(schedule); err != nil {
+			m.logger.Fatal().Err(err).Str("address", schedule.GetAddress()).Msg("an error occurred inserting a vesting schedule in the genesis state")
+		}
+	}
 	for _, pool := range state.GetPools() {
 		err = rwCtx.InsertPool(pool.GetAddress(), pool.GetAmount()) // pool.GetAddress() returns the pool's semantic name
 		if err != nil {
@@ -54,14 +76,14 @@ func (m *persistenceModule) populateGenesisState(state *genesis.GenesisState) {
 	stakedActorsInsertConfigs := []struct {
 		Name     string
 		Getter   func() []*coreTypes.Actor
-		InsertFn func(address, publicKey, output []byte, paused bool, status int32, serviceURL, stakedTokens string, chains []string, pausedHeight, unstakingHeight int64) error
+		InsertFn func(address, publicKey, output []byte, paused bool, status int32, serviceURL, stakedTokens string, chains []string, geoZone string, pausedHeight, unstakingHeight int64) error
 		Pool     coreTypes.Pools
 	}{
 		{
 			Name:   "app",
 			Getter: state.GetApplications,
-			InsertFn: func(address, publicKey, output []byte, paused bool, status int32, serviceURL, stakedTokens string, chains []string, pausedHeight, unstakingHeight int64) error {
-				return rwCtx.InsertApp(address, publicKey, output, paused, status, stakedTokens, chains, pausedHeight, unstakingHeight)
+			InsertFn: func(address, publicKey, output []byte, paused bool, status int32, serviceURL, stakedTokens string, chains []string, geoZone string, pausedHeight, unstakingHeight int64) error {
+				return rwCtx.InsertApp(address, publicKey, output, paused, status, stakedTokens, chains, geoZone, pausedHeight, unstakingHeight)
 			},
 			Pool: coreTypes.Pools_POOLS_APP_STAKE,
 		},
@@ -80,7 +102,7 @@ func (m *persistenceModule) populateGenesisState(state *genesis.GenesisState) {
 		{
 			Name:   "validator",
 			Getter: state.GetValidators,
-			InsertFn: func(address, publicKey, output []byte, paused bool, status int32, serviceURL, stakedTokens string, chains []string, pausedHeight, unstakingHeight int64) error {
+			InsertFn: func(address, publicKey, output []byte, paused bool, status int32, serviceURL, stakedTokens string, chains []string, geoZone string, pausedHeight, unstakingHeight int64) error {
 				return rwCtx.InsertValidator(address, publicKey, output, paused, status, serviceURL, stakedTokens, pausedHeight, unstakingHeight)
 			},
 			Pool: coreTypes.Pools_POOLS_VALIDATOR_STAKE,
@@ -101,7 +123,7 @@ func (m *persistenceModule) populateGenesisState(state *genesis.GenesisState) {
 			if err != nil {
 				log.Fatalf("an error occurred converting output to bytes %s", act.GetOutput())
 			}
-			err = saic.InsertFn(addrBz, pubKeyBz, outputBz, false, int32(coreTypes.StakeStatus_Staked), act.GetServiceUrl(), act.GetStakedAmount(), act.GetChains(), act.GetPausedHeight(), act.GetUnstakingHeight())
+			err = saic.InsertFn(addrBz, pubKeyBz, outputBz, false, int32(coreTypes.StakeStatus_Staked), act.GetServiceUrl(), act.GetStakedAmount(), act.GetChains(), act.GetGeoZone(), act.GetPausedHeight(), act.GetUnstakingHeight())
 			if err != nil {
 				log.Fatalf("an error occurred inserting an %s in the genesis state: %s", saic.Name, err.Error())
 			}
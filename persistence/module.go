@@ -3,6 +3,7 @@ package persistence
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -48,6 +49,9 @@ type persistenceModule struct {
 
 	// A pool of connections to the postgres database
 	pool *pgxpool.Pool
+
+	// Only one simulation context is allowed at a time (see `NewSimulationContext`)
+	simulationMu sync.Mutex
 }
 
 func Create(bus modules.Bus, options ...modules.ModuleOption) (modules.Module, error) {
@@ -210,6 +214,67 @@ func (m *persistenceModule) NewReadContext(height int64) (modules.PersistenceRea
 	}, nil
 }
 
+// NewSimulationContext returns a read-write context at `height` whose changes are discarded when it is released.
+// None of them can be committed, and only one can exist at a time: the call fails while another simulation context
+// has not been released, so that the public RPC endpoints creating them hold at most one extra connection.
+//
+// Lock behaviour: a simulation runs on a REPEATABLE READ snapshot inside a savepoint, but postgres still locks the rows
+// it writes until it is released, so the write context can wait for a simulation touching the same rows. To keep that
+// wait short, every simulation statement gives up after `simulationContextStatementTimeout`, and waits at most
+// `simulationContextLockTimeout` for a lock. The latter is below postgres' default `deadlock_timeout` (1s), so a
+// simulation blocked on the write context fails (and is rolled back by its caller) before a deadlock check could
+// abort the write context instead.
+func (m *persistenceModule) NewSimulationContext(height int64) (modules.PersistenceRWContext, error) {
+	if !m.simulationMu.TryLock() {
+		return nil, fmt.Errorf("another simulation context is in use")
+	}
+	conn, err := connectToPool(m.pool, m.config.GetNodeSchema())
+	if err != nil {
+		m.simulationMu.Unlock()
+		return nil, err
+	}
+	ctx := context.TODO()
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:       pgx.RepeatableRead,
+		AccessMode:     pgx.ReadWrite,
+		DeferrableMode: pgx.NotDeferrable,
+	})
+	if err != nil {
+		conn.Release()
+		m.simulationMu.Unlock()
+		return nil, err
+	}
+
+	for _, stmt := range []string{
+		fmt.Sprintf("SET LOCAL lock_timeout = '%s'", simulationContextLockTimeout),
+		fmt.Sprintf("SET LOCAL statement_timeout = '%s'", simulationContextStatementTimeout),
+		fmt.Sprintf("SAVEPOINT %s", simulationContextSavePoint),
+	} {
+		if _, err := tx.Exec(ctx, stmt); err != nil {
+			_ = tx.Rollback(ctx)
+			conn.Release()
+			m.simulationMu.Unlock()
+			return nil, err
+		}
+	}
+
+	return &PostgresContext{
+		logger: m.logger,
+
+		Height: height,
+
+		conn: conn,
+		tx:   tx,
+
+		stateHash:         "",
+		blockStore:        m.blockStore,
+		txIndexer:         m.txIndexer,
+		stateTrees:        m.stateTrees,
+		isSimulation:      true,
+		releaseSimulation: m.simulationMu.Unlock,
+	}, nil
+}
+
 func (m *persistenceModule) ReleaseWriteContext() error {
 	writeContext := m.writeContext
 	if writeContext == nil {
@@ -0,0 +1,106 @@
+package persistence
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pokt-network/pocket/persistence/types"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// RotateActorOperator moves the actor staked with the operator `oldAddress` to the operator `newAddress`, keeping its
+// stake, chains, service URL, geo zone, output address and paused and unstaking heights. The old operator is retired:
+// it is left unstaked without any stake or chains, and removed from the actor merkle tree.
+func (p *PostgresContext) RotateActorOperator(actorType coreTypes.ActorType, oldAddress, newAddress, newPublicKey []byte) error {
+	actorSchema, ok := actorTypeToSchemaName[actorType]
+	if !ok {
+		return fmt.Errorf("no schema found for actor type: %s", actorType)
+	}
+	actor, err := p.getActor(actorSchema, oldAddress, p.Height)
+	if err != nil {
+		return err
+	}
+
+	rotatedActor := &coreTypes.Actor{
+		ActorType:       actor.ActorType,
+		Address:         hex.EncodeToString(newAddress),
+		PublicKey:       hex.EncodeToString(newPublicKey),
+		Chains:          actor.Chains,
+		ServiceUrl:      actor.ServiceUrl,
+		GeoZone:         actor.GeoZone,
+		StakedAmount:    actor.StakedAmount,
+		PausedHeight:    actor.PausedHeight,
+		UnstakingHeight: actor.UnstakingHeight,
+		Output:          actor.Output,
+	}
+	if err := p.InsertActor(actorSchema, rotatedActor); err != nil {
+		return err
+	}
+
+	// NB: The retired operator is unstaked at the previous height so it is not unbonded again
+	retiredActor := &coreTypes.Actor{
+		ActorType:       actor.ActorType,
+		Address:         actor.Address,
+		PublicKey:       actor.PublicKey,
+		ServiceUrl:      actor.ServiceUrl,
+		StakedAmount:    "0",
+		PausedHeight:    types.DefaultBigInt,
+		UnstakingHeight: p.Height - 1,
+		Output:          actor.Output,
+	}
+	ctx, tx := p.getCtxAndTx()
+	if chainsTableName := actorSchema.GetChainsTableName(); chainsTableName != "" {
+		if _, err := tx.Exec(ctx, types.NullifyChains(actor.Address, p.Height, chainsTableName)); err != nil {
+			return err
+		}
+	}
+	if err := p.InsertActor(actorSchema, retiredActor); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, types.InsertOperatorRotationQuery(actorType, actor.Address, rotatedActor.Address, p.Height))
+	return err
+}
+
+// GetCurrentOperator follows the key rotations of the actor that used the operator `address`, so it can still be held
+// accountable (e.g. for double signing) once it moved to a new key. Since a retired operator cannot be staked again,
+// the rotations of an actor form a chain.
+func (p *PostgresContext) GetCurrentOperator(actorType coreTypes.ActorType, address []byte, height int64) ([]byte, error) {
+	ctx, tx := p.getCtxAndTx()
+	operator := hex.EncodeToString(address)
+	for {
+		var newOperator string
+		err := tx.QueryRow(ctx, types.GetOperatorRotationQuery(actorType, operator, height)).Scan(&newOperator)
+		if err == pgx.ErrNoRows {
+			return hex.DecodeString(operator)
+		}
+		if err != nil {
+			return nil, err
+		}
+		operator = newOperator
+	}
+}
+
+// getRotatedOperatorsAtHeight returns the operator addresses of the actors of type `actorType` retired at `height`
+func (p *PostgresContext) getRotatedOperatorsAtHeight(actorType coreTypes.ActorType, height int64) (addrs [][]byte, err error) {
+	ctx, tx := p.getCtxAndTx()
+	rows, err := tx.Query(ctx, types.GetRotatedOperatorsAtHeightQuery(actorType, height))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var addr string
+		if err := rows.Scan(&addr); err != nil {
+			return nil, err
+		}
+		addrBz, err := hex.DecodeString(addr)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addrBz)
+	}
+	return addrs, rows.Err()
+}
@@ -0,0 +1,115 @@
+package persistence
+
+import (
+	"encoding/hex"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pokt-network/pocket/persistence/types"
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// InsertProposal inserts a new version of the proposal, so it is used both to submit proposals and to update their status
+func (p *PostgresContext) InsertProposal(proposal *coreTypes.Proposal) error {
+	proposalBz, err := codec.GetCodec().Marshal(proposal)
+	if err != nil {
+		return err
+	}
+	ctx, tx := p.getCtxAndTx()
+	_, err = tx.Exec(ctx, types.InsertProposalQuery(proposal.Id, hex.EncodeToString(proposalBz), proposal.Status, proposal.VotingEndHeight, p.Height))
+	return err
+}
+
+// InsertVote inserts a new version of the vote, so a validator voting again on a proposal replaces its previous vote
+func (p *PostgresContext) InsertVote(vote *coreTypes.Vote) error {
+	ctx, tx := p.getCtxAndTx()
+	_, err := tx.Exec(ctx, types.InsertVoteQuery(vote, p.Height))
+	return err
+}
+
+func (p *PostgresContext) GetProposal(id uint64, height int64) (*coreTypes.Proposal, error) {
+	ctx, tx := p.getCtxAndTx()
+	proposal, err := scanProposal(tx.QueryRow(ctx, types.GetProposalQuery(id, height)))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	return proposal, err
+}
+
+func (p *PostgresContext) GetLatestProposalID(height int64) (id uint64, err error) {
+	ctx, tx := p.getCtxAndTx()
+	err = tx.QueryRow(ctx, types.GetLatestProposalIDQuery(height)).Scan(&id)
+	return
+}
+
+func (p *PostgresContext) GetProposalsEndingAtHeight(height int64) ([]*coreTypes.Proposal, error) {
+	return p.queryProposals(types.GetProposalsEndingAtHeightQuery(height))
+}
+
+func (p *PostgresContext) GetProposalVotes(proposalID uint64, height int64) ([]*coreTypes.Vote, error) {
+	return p.queryVotes(types.GetProposalVotesQuery(proposalID, height))
+}
+
+// getProposalsUpdatedAtHeight returns the proposals submitted or whose status changed at `height`
+func (p *PostgresContext) getProposalsUpdatedAtHeight(height int64) ([]*coreTypes.Proposal, error) {
+	return p.queryProposals(types.GetProposalsUpdatedAtHeightQuery(height))
+}
+
+// getVotesUpdatedAtHeight returns the votes cast or changed at `height`
+func (p *PostgresContext) getVotesUpdatedAtHeight(height int64) ([]*coreTypes.Vote, error) {
+	return p.queryVotes(types.GetVotesUpdatedAtHeightQuery(height))
+}
+
+func (p *PostgresContext) queryProposals(query string) (proposals []*coreTypes.Proposal, err error) {
+	ctx, tx := p.getCtxAndTx()
+	rows, err := tx.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		proposal, err := scanProposal(rows)
+		if err != nil {
+			return nil, err
+		}
+		proposals = append(proposals, proposal)
+	}
+	return proposals, rows.Err()
+}
+
+func (p *PostgresContext) queryVotes(query string) (votes []*coreTypes.Vote, err error) {
+	ctx, tx := p.getCtxAndTx()
+	rows, err := tx.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var option int32
+		vote := new(coreTypes.Vote)
+		if err := rows.Scan(&vote.ProposalId, &vote.ValidatorAddress, &option); err != nil {
+			return nil, err
+		}
+		vote.Option = coreTypes.VoteOption(option)
+		votes = append(votes, vote)
+	}
+	return votes, rows.Err()
+}
+
+func scanProposal(row pgx.Row) (*coreTypes.Proposal, error) {
+	var proposalHex string
+	if err := row.Scan(&proposalHex); err != nil {
+		return nil, err
+	}
+	proposalBz, err := hex.DecodeString(proposalHex)
+	if err != nil {
+		return nil, err
+	}
+	proposal := new(coreTypes.Proposal)
+	if err := codec.GetCodec().Unmarshal(proposalBz, proposal); err != nil {
+		return nil, err
+	}
+	return proposal, nil
+}
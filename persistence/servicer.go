@@ -26,7 +26,7 @@ func (p *PostgresContext) GetServicer(address []byte, height int64) (operator, p
 	return
 }
 
-func (p *PostgresContext) InsertServicer(address, publicKey, output []byte, _ bool, _ int32, serviceURL, stakedTokens string, chains []string, pausedHeight, unstakingHeight int64) error {
+func (p *PostgresContext) InsertServicer(address, publicKey, output []byte, _ bool, _ int32, serviceURL, stakedTokens string, chains []string, geoZone string, pausedHeight, unstakingHeight int64) error {
 	return p.InsertActor(types.ServicerActor, &coreTypes.Actor{
 		ActorType:       coreTypes.ActorType_ACTOR_TYPE_SERVICER,
 		Address:         hex.EncodeToString(address),
@@ -37,16 +37,18 @@ func (p *PostgresContext) InsertServicer(address, publicKey, output []byte, _ bo
 		PausedHeight:    pausedHeight,
 		UnstakingHeight: unstakingHeight,
 		Chains:          chains,
+		GeoZone:         geoZone,
 	})
 }
 
-func (p *PostgresContext) UpdateServicer(address []byte, serviceURL, stakedAmount string, chains []string) error {
+func (p *PostgresContext) UpdateServicer(address []byte, serviceURL, stakedAmount string, chains []string, geoZone string) error {
 	return p.UpdateActor(types.ServicerActor, &coreTypes.Actor{
 		ActorType:    coreTypes.ActorType_ACTOR_TYPE_SERVICER,
 		Address:      hex.EncodeToString(address),
 		StakedAmount: stakedAmount,
 		ServiceUrl:   serviceURL,
 		Chains:       chains,
+		GeoZone:      geoZone,
 	})
 }
 
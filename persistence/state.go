@@ -3,6 +3,7 @@ package persistence
 import (
 	"bytes"
 	"crypto/sha256"
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 
@@ -45,6 +46,14 @@ const (
 	transactionsMerkleTree
 	paramsMerkleTree
 	flagsMerkleTree
+	claimsMerkleTree
+	testScoresMerkleTree
+	doubleSignEvidenceMerkleTree
+	delegationsMerkleTree
+	proposalsMerkleTree
+	votesMerkleTree
+	upgradePlansMerkleTree
+	vestingSchedulesMerkleTree
 
 	// Used for iteration purposes only; see https://stackoverflow.com/a/64178235/768439 as a reference
 	numMerkleTrees
@@ -59,9 +68,17 @@ var merkleTreeToString = map[merkleTree]string{
 	accountMerkleTree: "account",
 	poolMerkleTree:    "pool",
 
-	transactionsMerkleTree: "transactions",
-	paramsMerkleTree:       "params",
-	flagsMerkleTree:        "flags",
+	transactionsMerkleTree:       "transactions",
+	paramsMerkleTree:             "params",
+	flagsMerkleTree:              "flags",
+	claimsMerkleTree:             "claims",
+	testScoresMerkleTree:         "test_scores",
+	doubleSignEvidenceMerkleTree: "double_sign_evidence",
+	delegationsMerkleTree:        "delegations",
+	proposalsMerkleTree:          "proposals",
+	votesMerkleTree:              "votes",
+	upgradePlansMerkleTree:       "upgrade_plans",
+	vestingSchedulesMerkleTree:   "vesting_schedules",
 }
 
 var actorTypeToMerkleTreeName = map[coreTypes.ActorType]merkleTree{
@@ -165,6 +182,38 @@ func (p *PostgresContext) updateMerkleTrees() (string, error) {
 			if err := p.updateFlagsTree(); err != nil {
 				return "", err
 			}
+		case claimsMerkleTree:
+			if err := p.updateClaimsTree(); err != nil {
+				return "", err
+			}
+		case testScoresMerkleTree:
+			if err := p.updateTestScoresTree(); err != nil {
+				return "", err
+			}
+		case doubleSignEvidenceMerkleTree:
+			if err := p.updateDoubleSignEvidenceTree(); err != nil {
+				return "", err
+			}
+		case delegationsMerkleTree:
+			if err := p.updateDelegationsTree(); err != nil {
+				return "", err
+			}
+		case proposalsMerkleTree:
+			if err := p.updateProposalsTree(); err != nil {
+				return "", err
+			}
+		case votesMerkleTree:
+			if err := p.updateVotesTree(); err != nil {
+				return "", err
+			}
+		case upgradePlansMerkleTree:
+			if err := p.updateUpgradePlansTree(); err != nil {
+				return "", err
+			}
+		case vestingSchedulesMerkleTree:
+			if err := p.updateVestingSchedulesTree(); err != nil {
+				return "", err
+			}
 
 		// Default
 		default:
@@ -218,6 +267,17 @@ func (p *PostgresContext) updateActorsTree(actorType coreTypes.ActorType) error
 		}
 	}
 
+	// The operators retired by a key rotation are removed from the tree, which only commits to their new operator
+	retiredAddrs, err := p.getRotatedOperatorsAtHeight(actorType, p.Height)
+	if err != nil {
+		return err
+	}
+	for _, addr := range retiredAddrs {
+		if _, err := p.stateTrees.merkleTrees[actorTypeToMerkleTreeName[actorType]].Delete(addr); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -358,3 +418,223 @@ func (p *PostgresContext) updateFlagsTree() error {
 
 	return nil
 }
+
+// updateClaimsTree keys the claims by the servicer address followed by the session id, and removes the
+// claims that were proven or expired so the tree only commits to the outstanding ones
+func (p *PostgresContext) updateClaimsTree() error {
+	claims, removed, err := p.getClaimsUpdatedAtHeight(p.Height)
+	if err != nil {
+		return err
+	}
+
+	for i, claim := range claims {
+		claimKey, err := hex.DecodeString(claim.GetServicerAddress() + claim.GetSessionId())
+		if err != nil {
+			return err
+		}
+
+		if removed[i] {
+			if _, err := p.stateTrees.merkleTrees[claimsMerkleTree].Delete(claimKey); err != nil {
+				return err
+			}
+			continue
+		}
+
+		claimBz, err := codec.GetCodec().Marshal(claim)
+		if err != nil {
+			return err
+		}
+		if _, err := p.stateTrees.merkleTrees[claimsMerkleTree].Update(claimKey, claimBz); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateTestScoresTree keys the test scores by the fisherman address, followed by the servicer address and the session id
+func (p *PostgresContext) updateTestScoresTree() error {
+	testScores, removed, err := p.getTestScoresUpdatedAtHeight(p.Height)
+	if err != nil {
+		return err
+	}
+
+	for i, testScore := range testScores {
+		testScoreKey, err := hex.DecodeString(testScore.GetFishermanAddress() + testScore.GetServicerAddress() + testScore.GetSessionId())
+		if err != nil {
+			return err
+		}
+
+		if removed[i] {
+			if _, err := p.stateTrees.merkleTrees[testScoresMerkleTree].Delete(testScoreKey); err != nil {
+				return err
+			}
+			continue
+		}
+
+		testScoreBz, err := codec.GetCodec().Marshal(testScore)
+		if err != nil {
+			return err
+		}
+		if _, err := p.stateTrees.merkleTrees[testScoresMerkleTree].Update(testScoreKey, testScoreBz); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateDoubleSignEvidenceTree keys the evidence by the validator address followed by the big endian evidence height,
+// and commits to the height at which the validator was punished
+func (p *PostgresContext) updateDoubleSignEvidenceTree() error {
+	validatorAddrs, evidenceHeights, err := p.getDoubleSignEvidenceAtHeight(p.Height)
+	if err != nil {
+		return err
+	}
+
+	for i, validatorAddr := range validatorAddrs {
+		validatorAddrBz, err := hex.DecodeString(validatorAddr)
+		if err != nil {
+			return err
+		}
+		evidenceKey := make([]byte, len(validatorAddrBz)+8)
+		copy(evidenceKey, validatorAddrBz)
+		binary.BigEndian.PutUint64(evidenceKey[len(validatorAddrBz):], uint64(evidenceHeights[i]))
+
+		heightBz := make([]byte, 8)
+		binary.BigEndian.PutUint64(heightBz, uint64(p.Height))
+		if _, err := p.stateTrees.merkleTrees[doubleSignEvidenceMerkleTree].Update(evidenceKey, heightBz); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateDelegationsTree keys the delegations by the delegator address followed by the validator address, and removes
+// the delegations that were unbonded so the tree only commits to the bonded ones
+func (p *PostgresContext) updateDelegationsTree() error {
+	delegations, removed, err := p.getDelegationsUpdatedAtHeight(p.Height)
+	if err != nil {
+		return err
+	}
+
+	for i, delegation := range delegations {
+		delegationKey, err := hex.DecodeString(delegation.GetDelegatorAddress() + delegation.GetValidatorAddress())
+		if err != nil {
+			return err
+		}
+
+		if removed[i] {
+			if _, err := p.stateTrees.merkleTrees[delegationsMerkleTree].Delete(delegationKey); err != nil {
+				return err
+			}
+			continue
+		}
+
+		delegationBz, err := codec.GetCodec().Marshal(delegation)
+		if err != nil {
+			return err
+		}
+		if _, err := p.stateTrees.merkleTrees[delegationsMerkleTree].Update(delegationKey, delegationBz); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateProposalsTree keys the proposals by their big endian id, and commits to their latest status
+func (p *PostgresContext) updateProposalsTree() error {
+	proposals, err := p.getProposalsUpdatedAtHeight(p.Height)
+	if err != nil {
+		return err
+	}
+
+	for _, proposal := range proposals {
+		proposalKey := make([]byte, 8)
+		binary.BigEndian.PutUint64(proposalKey, proposal.GetId())
+
+		proposalBz, err := codec.GetCodec().Marshal(proposal)
+		if err != nil {
+			return err
+		}
+		if _, err := p.stateTrees.merkleTrees[proposalsMerkleTree].Update(proposalKey, proposalBz); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateVotesTree keys the votes by the big endian proposal id followed by the validator address, and commits to the
+// latest vote of every validator
+func (p *PostgresContext) updateVotesTree() error {
+	votes, err := p.getVotesUpdatedAtHeight(p.Height)
+	if err != nil {
+		return err
+	}
+
+	for _, vote := range votes {
+		validatorAddrBz, err := hex.DecodeString(vote.GetValidatorAddress())
+		if err != nil {
+			return err
+		}
+		voteKey := make([]byte, 8+len(validatorAddrBz))
+		binary.BigEndian.PutUint64(voteKey, vote.GetProposalId())
+		copy(voteKey[8:], validatorAddrBz)
+
+		voteBz, err := codec.GetCodec().Marshal(vote)
+		if err != nil {
+			return err
+		}
+		if _, err := p.stateTrees.merkleTrees[votesMerkleTree].Update(voteKey, voteBz); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateUpgradePlansTree keys the upgrade plans by their big endian activation height, and commits to the name of the
+// upgrade scheduled at every activation height
+func (p *PostgresContext) updateUpgradePlansTree() error {
+	plan, err := p.getUpgradePlanUpdatedAtHeight(p.Height)
+	if err != nil || plan == nil {
+		return err
+	}
+
+	planKey := make([]byte, 8)
+	binary.BigEndian.PutUint64(planKey, uint64(plan.GetActivationHeight()))
+	if _, err := p.stateTrees.merkleTrees[upgradePlansMerkleTree].Update(planKey, []byte(plan.GetName())); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// updateVestingSchedulesTree keys the vesting schedules by the address of their account, and commits to the latest
+// schedule of every account
+func (p *PostgresContext) updateVestingSchedulesTree() error {
+	schedules, err := p.getVestingSchedulesUpdatedAtHeight(p.Height)
+	if err != nil {
+		return err
+	}
+
+	for _, schedule := range schedules {
+		addrBz, err := hex.DecodeString(schedule.GetAddress())
+		if err != nil {
+			return err
+		}
+
+		scheduleBz, err := codec.GetCodec().Marshal(schedule)
+		if err != nil {
+			return err
+		}
+		if _, err := p.stateTrees.merkleTrees[vestingSchedulesMerkleTree].Update(addrBz, scheduleBz); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
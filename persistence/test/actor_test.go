@@ -1,6 +1,7 @@
 package test
 
 import (
+	"encoding/hex"
 	"testing"
 
 	"github.com/pokt-network/pocket/shared/core/types"
@@ -36,3 +37,25 @@ func TestGetAllStakedActors(t *testing.T) {
 	require.Equal(t, genesisStateNumApplications, actualApplications)
 	require.Equal(t, genesisStateNumFishermen, actualFishermen)
 }
+
+func TestGetActor(t *testing.T) {
+	db := NewTestPostgresContext(t, 0)
+
+	apps, err := db.GetAllApps(0)
+	require.NoError(t, err)
+	require.NotEmpty(t, apps)
+
+	addrBz, err := hex.DecodeString(apps[0].GetAddress())
+	require.NoError(t, err)
+
+	app, err := db.GetActor(types.ActorType_ACTOR_TYPE_APP, addrBz, 0)
+	require.NoError(t, err)
+	require.Equal(t, apps[0].GetPublicKey(), app.GetPublicKey())
+	require.Equal(t, apps[0].GetChains(), app.GetChains())
+
+	_, err = db.GetActor(types.ActorType_ACTOR_TYPE_VAL, addrBz, 0)
+	require.Error(t, err)
+
+	_, err = db.GetActor(types.ActorType_ACTOR_TYPE_UNSPECIFIED, addrBz, 0)
+	require.Error(t, err)
+}
@@ -75,7 +75,7 @@ func TestUpdateApp(t *testing.T) {
 
 	require.NotEqual(t, DefaultStake, StakeToUpdate)   // sanity check to make sure the tests are correct
 	require.NotEqual(t, DefaultChains, ChainsToUpdate) // sanity check to make sure the tests are correct
-	err = db.UpdateApp(addrBz, StakeToUpdate, ChainsToUpdate)
+	err = db.UpdateApp(addrBz, StakeToUpdate, ChainsToUpdate, GeoZoneToUpdate)
 	require.NoError(t, err)
 
 	_, _, stakedTokens, _, _, _, chains, err = db.GetApp(addrBz, 0)
@@ -87,6 +87,14 @@ func TestUpdateApp(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, ChainsToUpdate, chains, "chains not updated for current height")
 	require.Equal(t, StakeToUpdate, stakedTokens, "stake not updated for current height")
+
+	actor, err := db.GetActor(coreTypes.ActorType_ACTOR_TYPE_APP, addrBz, 0)
+	require.NoError(t, err)
+	require.Equal(t, DefaultGeoZone, actor.GeoZone, "default geo zone incorrect for previous height")
+
+	actor, err = db.GetActor(coreTypes.ActorType_ACTOR_TYPE_APP, addrBz, 1)
+	require.NoError(t, err)
+	require.Equal(t, GeoZoneToUpdate, actor.GeoZone, "geo zone not updated for current height")
 }
 
 func TestGetAppsReadyToUnstake(t *testing.T) {
@@ -222,6 +230,7 @@ func newTestApp() (*coreTypes.Actor, error) {
 		Address:         hex.EncodeToString(operatorKey.Address()),
 		PublicKey:       hex.EncodeToString(operatorKey.Bytes()),
 		Chains:          DefaultChains,
+		GeoZone:         DefaultGeoZone,
 		StakedAmount:    DefaultStake,
 		PausedHeight:    DefaultPauseHeight,
 		UnstakingHeight: DefaultUnstakingHeight,
@@ -279,6 +288,7 @@ func createAndInsertDefaultTestApp(db *persistence.PostgresContext) (*coreTypes.
 		DefaultStakeStatus,
 		DefaultStake,
 		DefaultChains,
+		DefaultGeoZone,
 		DefaultPauseHeight,
 		DefaultUnstakingHeight)
 }
@@ -0,0 +1,89 @@
+package test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInsertAndGetClaim(t *testing.T) {
+	db := NewTestPostgresContext(t, 1)
+
+	claim := newTestClaim(t, db.Height)
+	servicerAddr, sessionId := decodeTestClaimKey(t, claim)
+
+	require.NoError(t, db.InsertClaim(claim))
+
+	claimAtHeight, err := db.GetClaim(servicerAddr, sessionId, db.Height)
+	require.NoError(t, err)
+	require.Equal(t, claim.String(), claimAtHeight.String())
+
+	// The claim did not exist before it was submitted
+	claimBeforeHeight, err := db.GetClaim(servicerAddr, sessionId, db.Height-1)
+	require.NoError(t, err)
+	require.Nil(t, claimBeforeHeight)
+
+	claims, err := db.GetAllClaims(db.Height)
+	require.NoError(t, err)
+	require.Len(t, claims, 1)
+	require.Equal(t, claim.String(), claims[0].String())
+}
+
+func TestDeleteClaim(t *testing.T) {
+	db := NewTestPostgresContext(t, 1)
+
+	claim := newTestClaim(t, db.Height)
+	servicerAddr, sessionId := decodeTestClaimKey(t, claim)
+	require.NoError(t, db.InsertClaim(claim))
+
+	db.Height++
+	require.NoError(t, db.DeleteClaim(servicerAddr, sessionId))
+
+	deletedClaim, err := db.GetClaim(servicerAddr, sessionId, db.Height)
+	require.NoError(t, err)
+	require.Nil(t, deletedClaim)
+
+	claims, err := db.GetAllClaims(db.Height)
+	require.NoError(t, err)
+	require.Empty(t, claims)
+
+	// The claim is still retrievable at the heights before it was deleted
+	claimBeforeDeletion, err := db.GetClaim(servicerAddr, sessionId, db.Height-1)
+	require.NoError(t, err)
+	require.Equal(t, claim.String(), claimBeforeDeletion.String())
+
+	// Deleting a claim that does not exist is a no-op
+	require.NoError(t, db.DeleteClaim(servicerAddr, sessionId))
+}
+
+func newTestClaim(t *testing.T, height int64) *coreTypes.Claim {
+	t.Helper()
+	servicerAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	appPubKey, err := crypto.GeneratePublicKey()
+	require.NoError(t, err)
+
+	return &coreTypes.Claim{
+		ServicerAddress:      servicerAddr.String(),
+		SessionId:            hex.EncodeToString(crypto.SHA3Hash(servicerAddr)),
+		SessionHeight:        0,
+		RelayChain:           "0001",
+		GeoZone:              "0",
+		ApplicationPublicKey: appPubKey.String(),
+		RootHash:             hex.EncodeToString(crypto.SHA3Hash(appPubKey.Bytes())),
+		TotalRelays:          42,
+		Height:               height,
+	}
+}
+
+func decodeTestClaimKey(t *testing.T, claim *coreTypes.Claim) (servicerAddr, sessionId []byte) {
+	t.Helper()
+	servicerAddr, err := hex.DecodeString(claim.ServicerAddress)
+	require.NoError(t, err)
+	sessionId, err = hex.DecodeString(claim.SessionId)
+	require.NoError(t, err)
+	return servicerAddr, sessionId
+}
@@ -0,0 +1,123 @@
+package test
+
+import (
+	"sort"
+	"testing"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetAndGetDelegation(t *testing.T) {
+	db := NewTestPostgresContext(t, 1)
+
+	delegatorAddr, validatorAddr := newTestDelegationKey(t)
+	delegation := newTestDelegation(delegatorAddr, validatorAddr, "100")
+	require.NoError(t, db.SetDelegation(delegation))
+
+	delegationAtHeight, err := db.GetDelegation(delegatorAddr, validatorAddr, db.Height)
+	require.NoError(t, err)
+	require.Equal(t, delegation.String(), delegationAtHeight.String())
+
+	// The delegation did not exist before it was created
+	delegationBeforeHeight, err := db.GetDelegation(delegatorAddr, validatorAddr, db.Height-1)
+	require.NoError(t, err)
+	require.Nil(t, delegationBeforeHeight)
+
+	// Updating the delegation inserts a new version of it
+	db.Height++
+	delegation.Amount = "250"
+	delegation.UnstakingHeight = db.Height + 5
+	require.NoError(t, db.SetDelegation(delegation))
+
+	updatedDelegation, err := db.GetDelegation(delegatorAddr, validatorAddr, db.Height)
+	require.NoError(t, err)
+	require.Equal(t, delegation.String(), updatedDelegation.String())
+
+	previousDelegation, err := db.GetDelegation(delegatorAddr, validatorAddr, db.Height-1)
+	require.NoError(t, err)
+	require.Equal(t, "100", previousDelegation.Amount)
+	require.Equal(t, int64(-1), previousDelegation.UnstakingHeight)
+
+	readyToUnbond, err := db.GetDelegationsReadyToUnbond(db.Height + 5)
+	require.NoError(t, err)
+	require.Len(t, readyToUnbond, 1)
+	require.Equal(t, delegation.String(), readyToUnbond[0].String())
+
+	readyToUnbond, err = db.GetDelegationsReadyToUnbond(db.Height + 4)
+	require.NoError(t, err)
+	require.Empty(t, readyToUnbond)
+}
+
+func TestGetValidatorDelegations(t *testing.T) {
+	db := NewTestPostgresContext(t, 1)
+
+	delegatorAddr, validatorAddr := newTestDelegationKey(t)
+	otherDelegatorAddr, otherValidatorAddr := newTestDelegationKey(t)
+
+	delegation := newTestDelegation(delegatorAddr, validatorAddr, "100")
+	otherDelegation := newTestDelegation(otherDelegatorAddr, validatorAddr, "200")
+	otherValidatorDelegation := newTestDelegation(delegatorAddr, otherValidatorAddr, "300")
+	for _, d := range []*coreTypes.Delegation{delegation, otherDelegation, otherValidatorDelegation} {
+		require.NoError(t, db.SetDelegation(d))
+	}
+
+	delegations, err := db.GetValidatorDelegations(validatorAddr, db.Height)
+	require.NoError(t, err)
+
+	// The delegations are ordered by delegator address
+	wantDelegations := []*coreTypes.Delegation{delegation, otherDelegation}
+	sort.Slice(wantDelegations, func(i, j int) bool {
+		return wantDelegations[i].DelegatorAddress < wantDelegations[j].DelegatorAddress
+	})
+	require.Len(t, delegations, len(wantDelegations))
+	for i, wantDelegation := range wantDelegations {
+		require.Equal(t, wantDelegation.String(), delegations[i].String())
+	}
+}
+
+func TestDeleteDelegation(t *testing.T) {
+	db := NewTestPostgresContext(t, 1)
+
+	delegatorAddr, validatorAddr := newTestDelegationKey(t)
+	delegation := newTestDelegation(delegatorAddr, validatorAddr, "100")
+	require.NoError(t, db.SetDelegation(delegation))
+
+	db.Height++
+	require.NoError(t, db.DeleteDelegation(delegatorAddr, validatorAddr))
+
+	deletedDelegation, err := db.GetDelegation(delegatorAddr, validatorAddr, db.Height)
+	require.NoError(t, err)
+	require.Nil(t, deletedDelegation)
+
+	delegations, err := db.GetValidatorDelegations(validatorAddr, db.Height)
+	require.NoError(t, err)
+	require.Empty(t, delegations)
+
+	// The delegation is still retrievable at the heights before it was deleted
+	delegationBeforeDeletion, err := db.GetDelegation(delegatorAddr, validatorAddr, db.Height-1)
+	require.NoError(t, err)
+	require.Equal(t, delegation.String(), delegationBeforeDeletion.String())
+
+	// Deleting a delegation that does not exist is a no-op
+	require.NoError(t, db.DeleteDelegation(delegatorAddr, validatorAddr))
+}
+
+func newTestDelegationKey(t *testing.T) (delegatorAddr, validatorAddr crypto.Address) {
+	t.Helper()
+	delegatorAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	validatorAddr, err = crypto.GenerateAddress()
+	require.NoError(t, err)
+	return delegatorAddr, validatorAddr
+}
+
+func newTestDelegation(delegatorAddr, validatorAddr crypto.Address, amount string) *coreTypes.Delegation {
+	return &coreTypes.Delegation{
+		DelegatorAddress: delegatorAddr.String(),
+		ValidatorAddress: validatorAddr.String(),
+		Amount:           amount,
+		UnstakingHeight:  -1,
+	}
+}
@@ -0,0 +1,36 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInsertDoubleSignEvidence(t *testing.T) {
+	db := NewTestPostgresContext(t, 2)
+
+	validatorAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	evidenceHeight := int64(1)
+
+	exists, err := db.DoubleSignEvidenceExists(validatorAddr, evidenceHeight, db.Height)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	require.NoError(t, db.InsertDoubleSignEvidence(validatorAddr, evidenceHeight))
+
+	exists, err = db.DoubleSignEvidenceExists(validatorAddr, evidenceHeight, db.Height)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	// The evidence was not recorded before the height it was inserted at
+	exists, err = db.DoubleSignEvidenceExists(validatorAddr, evidenceHeight, db.Height-1)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// The evidence is specific to the height the validator double signed at
+	exists, err = db.DoubleSignEvidenceExists(validatorAddr, evidenceHeight+1, db.Height)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
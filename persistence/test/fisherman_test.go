@@ -81,7 +81,7 @@ func TestUpdateFisherman(t *testing.T) {
 
 	require.NotEqual(t, DefaultStake, StakeToUpdate)   // sanity check to make sure the tests are correct
 	require.NotEqual(t, DefaultChains, ChainsToUpdate) // sanity check to make sure the tests are correct
-	err = db.UpdateFisherman(addrBz, fisherman.ServiceUrl, StakeToUpdate, ChainsToUpdate)
+	err = db.UpdateFisherman(addrBz, fisherman.ServiceUrl, StakeToUpdate, ChainsToUpdate, GeoZoneToUpdate)
 	require.NoError(t, err)
 
 	_, _, stakedTokens, _, _, _, _, chains, err = db.GetFisherman(addrBz, 0)
@@ -93,6 +93,14 @@ func TestUpdateFisherman(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, ChainsToUpdate, chains, "chains not updated for current height")
 	require.Equal(t, StakeToUpdate, stakedTokens, "stake not updated for current height")
+
+	actor, err := db.GetActor(coreTypes.ActorType_ACTOR_TYPE_FISH, addrBz, 0)
+	require.NoError(t, err)
+	require.Equal(t, DefaultGeoZone, actor.GeoZone, "default geo zone incorrect for previous height")
+
+	actor, err = db.GetActor(coreTypes.ActorType_ACTOR_TYPE_FISH, addrBz, 1)
+	require.NoError(t, err)
+	require.Equal(t, GeoZoneToUpdate, actor.GeoZone, "geo zone not updated for current height")
 }
 
 func TestGetFishermenReadyToUnstake(t *testing.T) {
@@ -233,6 +241,7 @@ func newTestFisherman() (*coreTypes.Actor, error) {
 		Address:         hex.EncodeToString(operatorKey.Address()),
 		PublicKey:       hex.EncodeToString(operatorKey.Bytes()),
 		Chains:          DefaultChains,
+		GeoZone:         DefaultGeoZone,
 		ServiceUrl:      DefaultServiceURL,
 		StakedAmount:    DefaultStake,
 		PausedHeight:    DefaultPauseHeight,
@@ -267,6 +276,7 @@ func createAndInsertDefaultTestFisherman(db *persistence.PostgresContext) (*core
 		DefaultServiceURL,
 		DefaultStake,
 		DefaultChains,
+		DefaultGeoZone,
 		DefaultPauseHeight,
 		DefaultUnstakingHeight)
 }
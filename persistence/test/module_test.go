@@ -108,6 +108,57 @@ func TestPersistenceContextMultipleParallelReads(t *testing.T) {
 	readContext3.Release()
 }
 
+func TestPersistenceContextSimulation(t *testing.T) {
+	prepareAndCleanContext(t)
+
+	poolName := "fake"
+	originalAmount := "15"
+	modifiedAmount := "10"
+
+	rwCtx, err := testPersistenceMod.NewRWContext(0)
+	require.NoError(t, err)
+	require.NoError(t, rwCtx.InsertPool(poolName, originalAmount))
+	require.NoError(t, rwCtx.Commit([]byte("proposerAddr"), []byte("quorumCert")))
+	rwCtx.Release()
+
+	// A simulation context can be opened alongside the write context
+	writeContext, err := testPersistenceMod.NewRWContext(1)
+	require.NoError(t, err)
+	defer writeContext.Release()
+
+	simulationContext, err := testPersistenceMod.NewSimulationContext(1)
+	require.NoError(t, err)
+
+	// Only one simulation context can exist at a time
+	_, err = testPersistenceMod.NewSimulationContext(1)
+	require.Error(t, err)
+
+	// The simulation context sees its own changes, but can neither commit them nor compute a state hash
+	require.NoError(t, simulationContext.SetPoolAmount(poolName, modifiedAmount))
+	simulationAmount, err := simulationContext.GetPoolAmount(poolName, 1)
+	require.NoError(t, err)
+	require.Equal(t, modifiedAmount, simulationAmount)
+
+	_, err = simulationContext.ComputeStateHash()
+	require.Error(t, err)
+	require.Error(t, simulationContext.Commit([]byte("proposerAddr"), []byte("quorumCert")))
+	simulationContext.Release()
+
+	// The changes of the simulation context are discarded once it is released
+	readContext, err := testPersistenceMod.NewReadContext(1)
+	require.NoError(t, err)
+	defer readContext.Release()
+
+	amount, err := readContext.GetPoolAmount(poolName, 1)
+	require.NoError(t, err)
+	require.Equal(t, originalAmount, amount)
+
+	// Releasing the simulation context allows the next one to be created
+	simulationContext, err = testPersistenceMod.NewSimulationContext(1)
+	require.NoError(t, err)
+	simulationContext.Release()
+}
+
 func prepareAndCleanContext(t *testing.T) {
 	// Cleanup context after the test
 	t.Cleanup(clearAllState)
@@ -0,0 +1,89 @@
+package test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateActorOperator(t *testing.T) {
+	db := NewTestPostgresContext(t, 1)
+
+	servicer, err := createAndInsertDefaultTestServicer(db)
+	require.NoError(t, err)
+	oldAddr, err := hex.DecodeString(servicer.Address)
+	require.NoError(t, err)
+
+	db.Height++
+	newOperatorKey, err := crypto.GeneratePublicKey()
+	require.NoError(t, err)
+	newAddr := newOperatorKey.Address()
+	require.NoError(t, db.RotateActorOperator(coreTypes.ActorType_ACTOR_TYPE_SERVICER, oldAddr, newAddr, newOperatorKey.Bytes()))
+
+	rotatedServicer, err := getTestServicer(db, newAddr)
+	require.NoError(t, err)
+	require.Equal(t, newAddr.String(), rotatedServicer.Address)
+	require.Equal(t, newOperatorKey.String(), rotatedServicer.PublicKey)
+	require.Equal(t, servicer.Chains, rotatedServicer.Chains)
+	require.Equal(t, servicer.ServiceUrl, rotatedServicer.ServiceUrl)
+	require.Equal(t, servicer.StakedAmount, rotatedServicer.StakedAmount)
+	require.Equal(t, servicer.PausedHeight, rotatedServicer.PausedHeight)
+	require.Equal(t, servicer.UnstakingHeight, rotatedServicer.UnstakingHeight)
+	require.Equal(t, servicer.Output, rotatedServicer.Output)
+
+	// The old operator is retired without any stake or chains
+	retiredServicer, err := getTestServicer(db, oldAddr)
+	require.NoError(t, err)
+	require.Equal(t, "0", retiredServicer.StakedAmount)
+	require.Empty(t, retiredServicer.Chains)
+	status, err := db.GetServicerStatus(oldAddr, db.Height)
+	require.NoError(t, err)
+	require.Equal(t, int32(coreTypes.StakeStatus_Unstaked), status)
+
+	// The old operator is left untouched at the previous height
+	servicerAtPrevHeight, err := db.GetActor(coreTypes.ActorType_ACTOR_TYPE_SERVICER, oldAddr, db.Height-1)
+	require.NoError(t, err)
+	require.Equal(t, servicer.StakedAmount, servicerAtPrevHeight.StakedAmount)
+
+	_, err = db.ComputeStateHash()
+	require.NoError(t, err)
+}
+
+func TestGetCurrentOperator(t *testing.T) {
+	db := NewTestPostgresContext(t, 1)
+
+	validator, err := createAndInsertDefaultTestValidator(db)
+	require.NoError(t, err)
+	firstAddr, err := hex.DecodeString(validator.Address)
+	require.NoError(t, err)
+
+	// An operator that was never rotated is its own current operator
+	operator, err := db.GetCurrentOperator(coreTypes.ActorType_ACTOR_TYPE_VAL, firstAddr, db.Height)
+	require.NoError(t, err)
+	require.Equal(t, firstAddr, operator)
+
+	db.Height++
+	secondKey, err := crypto.GeneratePublicKey()
+	require.NoError(t, err)
+	require.NoError(t, db.RotateActorOperator(coreTypes.ActorType_ACTOR_TYPE_VAL, firstAddr, secondKey.Address(), secondKey.Bytes()))
+
+	db.Height++
+	thirdKey, err := crypto.GeneratePublicKey()
+	require.NoError(t, err)
+	require.NoError(t, db.RotateActorOperator(coreTypes.ActorType_ACTOR_TYPE_VAL, secondKey.Address(), thirdKey.Address(), thirdKey.Bytes()))
+
+	// Every operator the validator used resolves to its latest one
+	for _, addr := range [][]byte{firstAddr, secondKey.Address(), thirdKey.Address()} {
+		operator, err := db.GetCurrentOperator(coreTypes.ActorType_ACTOR_TYPE_VAL, addr, db.Height)
+		require.NoError(t, err)
+		require.Equal(t, []byte(thirdKey.Address()), operator)
+	}
+
+	// Rotations after `height` are not followed
+	operator, err = db.GetCurrentOperator(coreTypes.ActorType_ACTOR_TYPE_VAL, firstAddr, db.Height-1)
+	require.NoError(t, err)
+	require.Equal(t, []byte(secondKey.Address()), operator)
+}
@@ -0,0 +1,126 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestInsertAndGetProposal(t *testing.T) {
+	db := NewTestPostgresContext(t, 1)
+
+	latestID, err := db.GetLatestProposalID(db.Height)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), latestID)
+
+	proposal := newTestProposal(t, 1, db.Height, db.Height+10)
+	require.NoError(t, db.InsertProposal(proposal))
+
+	proposalAtHeight, err := db.GetProposal(proposal.Id, db.Height)
+	require.NoError(t, err)
+	require.Equal(t, proposal.String(), proposalAtHeight.String())
+
+	latestID, err = db.GetLatestProposalID(db.Height)
+	require.NoError(t, err)
+	require.Equal(t, proposal.Id, latestID)
+
+	// The proposal did not exist before it was submitted
+	proposalBeforeHeight, err := db.GetProposal(proposal.Id, db.Height-1)
+	require.NoError(t, err)
+	require.Nil(t, proposalBeforeHeight)
+
+	// Updating the status of the proposal inserts a new version of it
+	db.Height++
+	proposal.Status = coreTypes.ProposalStatus_PROPOSAL_STATUS_PASSED
+	require.NoError(t, db.InsertProposal(proposal))
+
+	updatedProposal, err := db.GetProposal(proposal.Id, db.Height)
+	require.NoError(t, err)
+	require.Equal(t, coreTypes.ProposalStatus_PROPOSAL_STATUS_PASSED, updatedProposal.Status)
+
+	previousProposal, err := db.GetProposal(proposal.Id, db.Height-1)
+	require.NoError(t, err)
+	require.Equal(t, coreTypes.ProposalStatus_PROPOSAL_STATUS_VOTING, previousProposal.Status)
+}
+
+func TestGetProposalsEndingAtHeight(t *testing.T) {
+	db := NewTestPostgresContext(t, 1)
+
+	proposal := newTestProposal(t, 1, db.Height, db.Height+10)
+	otherProposal := newTestProposal(t, 2, db.Height, db.Height+10)
+	laterProposal := newTestProposal(t, 3, db.Height, db.Height+20)
+	for _, p := range []*coreTypes.Proposal{proposal, otherProposal, laterProposal} {
+		require.NoError(t, db.InsertProposal(p))
+	}
+
+	// Proposals which are not being voted on anymore are not returned
+	db.Height++
+	otherProposal.Status = coreTypes.ProposalStatus_PROPOSAL_STATUS_REJECTED
+	require.NoError(t, db.InsertProposal(otherProposal))
+
+	proposals, err := db.GetProposalsEndingAtHeight(proposal.VotingEndHeight)
+	require.NoError(t, err)
+	require.Len(t, proposals, 1)
+	require.Equal(t, proposal.String(), proposals[0].String())
+}
+
+func TestInsertAndGetProposalVotes(t *testing.T) {
+	db := NewTestPostgresContext(t, 1)
+
+	validatorAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	otherValidatorAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	vote := &coreTypes.Vote{ProposalId: 1, ValidatorAddress: validatorAddr.String(), Option: coreTypes.VoteOption_VOTE_OPTION_YES}
+	otherVote := &coreTypes.Vote{ProposalId: 1, ValidatorAddress: otherValidatorAddr.String(), Option: coreTypes.VoteOption_VOTE_OPTION_NO}
+	otherProposalVote := &coreTypes.Vote{ProposalId: 2, ValidatorAddress: validatorAddr.String(), Option: coreTypes.VoteOption_VOTE_OPTION_NO}
+	for _, v := range []*coreTypes.Vote{vote, otherVote, otherProposalVote} {
+		require.NoError(t, db.InsertVote(v))
+	}
+
+	// Voting again replaces the previous vote of the validator
+	db.Height++
+	vote.Option = coreTypes.VoteOption_VOTE_OPTION_ABSTAIN
+	require.NoError(t, db.InsertVote(vote))
+
+	votes, err := db.GetProposalVotes(1, db.Height)
+	require.NoError(t, err)
+	require.Len(t, votes, 2)
+	for _, v := range votes {
+		switch v.ValidatorAddress {
+		case vote.ValidatorAddress:
+			require.Equal(t, coreTypes.VoteOption_VOTE_OPTION_ABSTAIN, v.Option)
+		case otherVote.ValidatorAddress:
+			require.Equal(t, coreTypes.VoteOption_VOTE_OPTION_NO, v.Option)
+		default:
+			t.Fatalf("unexpected vote from %s", v.ValidatorAddress)
+		}
+	}
+
+	previousVotes, err := db.GetProposalVotes(1, db.Height-1)
+	require.NoError(t, err)
+	require.Len(t, previousVotes, 2)
+}
+
+func newTestProposal(t *testing.T, id uint64, submitHeight, votingEndHeight int64) *coreTypes.Proposal {
+	t.Helper()
+	proposerAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	value, err := codec.GetCodec().ToAny(wrapperspb.Int32(50))
+	require.NoError(t, err)
+	return &coreTypes.Proposal{
+		Id:              id,
+		ProposerAddress: proposerAddr.String(),
+		ParameterChanges: []*coreTypes.ParameterChange{
+			{ParameterKey: "governance_quorum_percentage", ParameterValue: value},
+		},
+		SubmitHeight:    submitHeight,
+		VotingEndHeight: votingEndHeight,
+		Status:          coreTypes.ProposalStatus_PROPOSAL_STATUS_VOTING,
+	}
+}
@@ -81,7 +81,7 @@ func TestUpdateServicer(t *testing.T) {
 
 	require.NotEqual(t, DefaultStake, StakeToUpdate)   // sanity check to make sure the tests are correct
 	require.NotEqual(t, DefaultChains, ChainsToUpdate) // sanity check to make sure the tests are correct
-	err = db.UpdateServicer(addrBz, servicer.ServiceUrl, StakeToUpdate, ChainsToUpdate)
+	err = db.UpdateServicer(addrBz, servicer.ServiceUrl, StakeToUpdate, ChainsToUpdate, GeoZoneToUpdate)
 	require.NoError(t, err)
 
 	_, _, stakedTokens, _, _, _, _, chains, err = db.GetServicer(addrBz, 0)
@@ -93,6 +93,14 @@ func TestUpdateServicer(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, ChainsToUpdate, chains, "chains not updated for current height")
 	require.Equal(t, StakeToUpdate, stakedTokens, "stake not updated for current height")
+
+	actor, err := db.GetActor(coreTypes.ActorType_ACTOR_TYPE_SERVICER, addrBz, 0)
+	require.NoError(t, err)
+	require.Equal(t, DefaultGeoZone, actor.GeoZone, "default geo zone incorrect for previous height")
+
+	actor, err = db.GetActor(coreTypes.ActorType_ACTOR_TYPE_SERVICER, addrBz, 1)
+	require.NoError(t, err)
+	require.Equal(t, GeoZoneToUpdate, actor.GeoZone, "geo zone not updated for current height")
 }
 
 func TestGetServicersReadyToUnstake(t *testing.T) {
@@ -235,6 +243,7 @@ func newTestServicer() (*coreTypes.Actor, error) {
 		Address:         hex.EncodeToString(operatorKey.Address()),
 		PublicKey:       hex.EncodeToString(operatorKey.Bytes()),
 		Chains:          DefaultChains,
+		GeoZone:         DefaultGeoZone,
 		ServiceUrl:      DefaultServiceURL,
 		StakedAmount:    DefaultStake,
 		PausedHeight:    DefaultPauseHeight,
@@ -269,6 +278,7 @@ func createAndInsertDefaultTestServicer(db *persistence.PostgresContext) (*coreT
 		DefaultServiceURL,
 		DefaultStake,
 		DefaultChains,
+		DefaultGeoZone,
 		DefaultPauseHeight,
 		DefaultUnstakingHeight)
 }
@@ -29,6 +29,8 @@ import (
 var (
 	DefaultChains     = []string{"0001"}
 	ChainsToUpdate    = []string{"0002"}
+	DefaultGeoZone    = "0"
+	GeoZoneToUpdate   = "1"
 	DefaultServiceURL = "https://foo.bar"
 	DefaultPoolName   = "TESTING_POOL"
 
@@ -0,0 +1,110 @@
+package test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInsertAndGetTestScore(t *testing.T) {
+	db := NewTestPostgresContext(t, 1)
+
+	testScore := newTestTestScore(t, db.Height)
+	fishermanAddr, servicerAddr, sessionId := decodeTestTestScoreKey(t, testScore)
+
+	require.NoError(t, db.InsertTestScore(testScore))
+
+	testScoreAtHeight, err := db.GetTestScore(fishermanAddr, servicerAddr, sessionId, db.Height)
+	require.NoError(t, err)
+	require.Equal(t, testScore.String(), testScoreAtHeight.String())
+
+	// The test score did not exist before it was submitted
+	testScoreBeforeHeight, err := db.GetTestScore(fishermanAddr, servicerAddr, sessionId, db.Height-1)
+	require.NoError(t, err)
+	require.Nil(t, testScoreBeforeHeight)
+
+	// Proving the test score inserts a new version of it
+	db.Height++
+	testScore.Proven = true
+	testScore.ProvenTestFailed = true
+	require.NoError(t, db.InsertTestScore(testScore))
+
+	provenTestScore, err := db.GetTestScore(fishermanAddr, servicerAddr, sessionId, db.Height)
+	require.NoError(t, err)
+	require.True(t, provenTestScore.Proven)
+	require.True(t, provenTestScore.ProvenTestFailed)
+
+	unprovenTestScore, err := db.GetTestScore(fishermanAddr, servicerAddr, sessionId, db.Height-1)
+	require.NoError(t, err)
+	require.False(t, unprovenTestScore.Proven)
+
+	testScores, err := db.GetAllTestScores(db.Height)
+	require.NoError(t, err)
+	require.Len(t, testScores, 1)
+	require.Equal(t, testScore.String(), testScores[0].String())
+}
+
+func TestDeleteTestScore(t *testing.T) {
+	db := NewTestPostgresContext(t, 1)
+
+	testScore := newTestTestScore(t, db.Height)
+	fishermanAddr, servicerAddr, sessionId := decodeTestTestScoreKey(t, testScore)
+	require.NoError(t, db.InsertTestScore(testScore))
+
+	db.Height++
+	require.NoError(t, db.DeleteTestScore(fishermanAddr, servicerAddr, sessionId))
+
+	deletedTestScore, err := db.GetTestScore(fishermanAddr, servicerAddr, sessionId, db.Height)
+	require.NoError(t, err)
+	require.Nil(t, deletedTestScore)
+
+	testScores, err := db.GetAllTestScores(db.Height)
+	require.NoError(t, err)
+	require.Empty(t, testScores)
+
+	// The test score is still retrievable at the heights before it was deleted
+	testScoreBeforeDeletion, err := db.GetTestScore(fishermanAddr, servicerAddr, sessionId, db.Height-1)
+	require.NoError(t, err)
+	require.Equal(t, testScore.String(), testScoreBeforeDeletion.String())
+
+	// Deleting a test score that does not exist is a no-op
+	require.NoError(t, db.DeleteTestScore(fishermanAddr, servicerAddr, sessionId))
+}
+
+func newTestTestScore(t *testing.T, height int64) *coreTypes.TestScore {
+	t.Helper()
+	fishermanAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	servicerAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	appPubKey, err := crypto.GeneratePublicKey()
+	require.NoError(t, err)
+
+	return &coreTypes.TestScore{
+		FishermanAddress:     fishermanAddr.String(),
+		ServicerAddress:      servicerAddr.String(),
+		SessionId:            hex.EncodeToString(crypto.SHA3Hash(servicerAddr)),
+		SessionHeight:        0,
+		RelayChain:           "0001",
+		GeoZone:              "0",
+		ApplicationPublicKey: appPubKey.String(),
+		RootHash:             hex.EncodeToString(crypto.SHA3Hash(appPubKey.Bytes())),
+		TotalTests:           10,
+		SuccessfulTests:      7,
+		Height:               height,
+	}
+}
+
+func decodeTestTestScoreKey(t *testing.T, testScore *coreTypes.TestScore) (fishermanAddr, servicerAddr, sessionId []byte) {
+	t.Helper()
+	fishermanAddr, err := hex.DecodeString(testScore.FishermanAddress)
+	require.NoError(t, err)
+	servicerAddr, err = hex.DecodeString(testScore.ServicerAddress)
+	require.NoError(t, err)
+	sessionId, err = hex.DecodeString(testScore.SessionId)
+	require.NoError(t, err)
+	return fishermanAddr, servicerAddr, sessionId
+}
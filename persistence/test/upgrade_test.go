@@ -0,0 +1,48 @@
+package test
+
+import (
+	"testing"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetAndGetUpgradePlan(t *testing.T) {
+	db := NewTestPostgresContext(t, 1)
+
+	plan, err := db.GetUpgradePlan(db.Height)
+	require.NoError(t, err)
+	require.Nil(t, plan)
+
+	upgradePlan := &coreTypes.UpgradePlan{Name: "v1.0.0", ActivationHeight: db.Height + 10}
+	require.NoError(t, db.SetUpgradePlan(upgradePlan))
+
+	plan, err = db.GetUpgradePlan(db.Height)
+	require.NoError(t, err)
+	require.Equal(t, upgradePlan.String(), plan.String())
+
+	// Scheduling another upgrade replaces the previous one
+	db.Height++
+	otherUpgradePlan := &coreTypes.UpgradePlan{Name: "v1.1.0", ActivationHeight: db.Height + 20}
+	require.NoError(t, db.SetUpgradePlan(otherUpgradePlan))
+
+	plan, err = db.GetUpgradePlan(db.Height)
+	require.NoError(t, err)
+	require.Equal(t, otherUpgradePlan.String(), plan.String())
+
+	plan, err = db.GetUpgradePlan(db.Height - 1)
+	require.NoError(t, err)
+	require.Equal(t, upgradePlan.String(), plan.String())
+
+	// The plan is marked as applied at its activation height
+	db.Height = otherUpgradePlan.ActivationHeight
+	appliedUpgradePlan := &coreTypes.UpgradePlan{Name: otherUpgradePlan.Name, ActivationHeight: otherUpgradePlan.ActivationHeight, Applied: true}
+	require.NoError(t, db.SetUpgradePlan(appliedUpgradePlan))
+
+	plan, err = db.GetUpgradePlan(db.Height)
+	require.NoError(t, err)
+	require.True(t, plan.GetApplied())
+	plan, err = db.GetUpgradePlan(db.Height - 1)
+	require.NoError(t, err)
+	require.False(t, plan.GetApplied())
+}
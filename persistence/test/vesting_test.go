@@ -0,0 +1,53 @@
+package test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetAndGetVestingSchedule(t *testing.T) {
+	db := NewTestPostgresContext(t, 1)
+
+	addr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	schedule, err := db.GetVestingSchedule(addr, db.Height)
+	require.NoError(t, err)
+	require.Nil(t, schedule)
+
+	vestingSchedule := &coreTypes.VestingSchedule{
+		Address:     hex.EncodeToString(addr),
+		Amount:      DefaultAccountAmount,
+		StartHeight: 10,
+		CliffHeight: 20,
+		EndHeight:   100,
+	}
+	require.NoError(t, db.SetVestingSchedule(vestingSchedule))
+
+	schedule, err = db.GetVestingSchedule(addr, db.Height)
+	require.NoError(t, err)
+	require.Equal(t, vestingSchedule.String(), schedule.String())
+
+	// Setting another schedule replaces the previous one
+	db.Height++
+	otherVestingSchedule := &coreTypes.VestingSchedule{
+		Address:     hex.EncodeToString(addr),
+		Amount:      DefaultAccountAmount,
+		StartHeight: 10,
+		CliffHeight: 10,
+		EndHeight:   50,
+	}
+	require.NoError(t, db.SetVestingSchedule(otherVestingSchedule))
+
+	schedule, err = db.GetVestingSchedule(addr, db.Height)
+	require.NoError(t, err)
+	require.Equal(t, otherVestingSchedule.String(), schedule.String())
+
+	schedule, err = db.GetVestingSchedule(addr, db.Height-1)
+	require.NoError(t, err)
+	require.Equal(t, vestingSchedule.String(), schedule.String())
+}
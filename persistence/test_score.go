@@ -0,0 +1,101 @@
+package persistence
+
+import (
+	"encoding/hex"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pokt-network/pocket/persistence/types"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// InsertTestScore inserts a new version of the test score, so it also records when a test score is proven
+func (p *PostgresContext) InsertTestScore(testScore *coreTypes.TestScore) error {
+	ctx, tx := p.getCtxAndTx()
+	_, err := tx.Exec(ctx, types.InsertTestScoreQuery(testScore, false, p.Height))
+	return err
+}
+
+func (p *PostgresContext) DeleteTestScore(fishermanAddr, servicerAddr, sessionID []byte) error {
+	testScore, removed, err := p.getTestScore(hex.EncodeToString(fishermanAddr), hex.EncodeToString(servicerAddr), hex.EncodeToString(sessionID), p.Height)
+	if err != nil {
+		return err
+	}
+	// Deleting a test score that does not exist is a no-op
+	if testScore == nil || removed {
+		return nil
+	}
+	ctx, tx := p.getCtxAndTx()
+	_, err = tx.Exec(ctx, types.InsertTestScoreQuery(testScore, true, p.Height))
+	return err
+}
+
+func (p *PostgresContext) GetTestScore(fishermanAddr, servicerAddr, sessionID []byte, height int64) (*coreTypes.TestScore, error) {
+	testScore, removed, err := p.getTestScore(hex.EncodeToString(fishermanAddr), hex.EncodeToString(servicerAddr), hex.EncodeToString(sessionID), height)
+	if err != nil || removed {
+		return nil, err
+	}
+	return testScore, nil
+}
+
+func (p *PostgresContext) GetAllTestScores(height int64) (testScores []*coreTypes.TestScore, err error) {
+	ctx, tx := p.getCtxAndTx()
+	rows, err := tx.Query(ctx, types.GetAllTestScoresQuery(height))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		testScore, _, err := scanTestScore(rows)
+		if err != nil {
+			return nil, err
+		}
+		testScores = append(testScores, testScore)
+	}
+	return testScores, rows.Err()
+}
+
+// getTestScore returns the latest version of the test score at `height`, or nil if it was never submitted
+func (p *PostgresContext) getTestScore(fishermanAddr, servicerAddr, sessionID string, height int64) (testScore *coreTypes.TestScore, removed bool, err error) {
+	ctx, tx := p.getCtxAndTx()
+	testScore, removed, err = scanTestScore(tx.QueryRow(ctx, types.GetTestScoreQuery(fishermanAddr, servicerAddr, sessionID, height)))
+	if err == pgx.ErrNoRows {
+		return nil, false, nil
+	}
+	return
+}
+
+// getTestScoresUpdatedAtHeight returns the test scores inserted, proven or removed at `height`, along with whether they were removed
+func (p *PostgresContext) getTestScoresUpdatedAtHeight(height int64) (testScores []*coreTypes.TestScore, removed []bool, err error) {
+	ctx, tx := p.getCtxAndTx()
+	rows, err := tx.Query(ctx, types.GetTestScoresUpdatedAtHeightQuery(height))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		testScore, isRemoved, err := scanTestScore(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+		testScores = append(testScores, testScore)
+		removed = append(removed, isRemoved)
+	}
+	return testScores, removed, rows.Err()
+}
+
+func scanTestScore(row pgx.Row) (testScore *coreTypes.TestScore, removed bool, err error) {
+	testScore = new(coreTypes.TestScore)
+	var totalTests, successfulTests int64
+	err = row.Scan(
+		&testScore.FishermanAddress, &testScore.ServicerAddress, &testScore.SessionId, &testScore.SessionHeight,
+		&testScore.RelayChain, &testScore.GeoZone, &testScore.ApplicationPublicKey, &testScore.RootHash,
+		&totalTests, &successfulTests, &testScore.Proven, &testScore.ProvenTestFailed, &testScore.Height, &removed)
+	if err != nil {
+		return nil, false, err
+	}
+	testScore.TotalTests = uint64(totalTests)
+	testScore.SuccessfulTests = uint64(successfulTests)
+	return testScore, removed, nil
+}
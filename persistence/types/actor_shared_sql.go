@@ -29,6 +29,7 @@ const (
 	ServiceURLCol      = "service_url"
 	UnusedCol          = "unused" // TECHDEBT: Unused column name from legacy behaviour
 	OutputAddressCol   = "output_address"
+	GeoZoneCol         = "geo_zone"
 	UnstakingHeightCol = "unstaking_height"
 	PausedHeightCol    = "paused_height"
 	ChainIDCol         = "chain_id"
@@ -42,6 +43,7 @@ func protocolActorTableSchema(actorSpecificColName, constraintName string) strin
 			%s TEXT NOT NULL,
 			%s TEXT NOT NULL,
 			%s TEXT NOT NULL,
+			%s TEXT NOT NULL default '',
 			%s BIGINT NOT NULL default %d,
 			%s BIGINT NOT NULL default %d,
 			%s BIGINT NOT NULL default %d,
@@ -53,6 +55,7 @@ func protocolActorTableSchema(actorSpecificColName, constraintName string) strin
 		StakedTokensCol,
 		actorSpecificColName,
 		OutputAddressCol,
+		GeoZoneCol,
 		PausedHeightCol,
 		DefaultBigInt,
 		UnstakingHeightCol,
@@ -86,7 +89,7 @@ func Select(selector, address string, height int64, tableName string) string {
 
 func SelectActors(actorSpecificParam string, height int64, tableName string) string {
 	return fmt.Sprintf(`
-			SELECT DISTINCT ON (address) address, public_key, staked_tokens, %s, output_address, paused_height, unstaking_height, height
+			SELECT DISTINCT ON (address) address, public_key, staked_tokens, %s, output_address, geo_zone, paused_height, unstaking_height, height
 			FROM %s
 			WHERE height<=%d
 			ORDER BY address, height DESC
@@ -123,15 +126,15 @@ func Insert(
 	tableName, chainsTableName string,
 	height int64) string {
 	insertStatement := fmt.Sprintf(
-		`INSERT INTO %s (address, public_key, staked_tokens, %s, output_address, paused_height, unstaking_height, height)
-				VALUES('%s', '%s', '%s', '%s', '%s', %d, %d, %d)
+		`INSERT INTO %s (address, public_key, staked_tokens, %s, output_address, geo_zone, paused_height, unstaking_height, height)
+				VALUES('%s', '%s', '%s', '%s', '%s', '%s', %d, %d, %d)
 				ON CONFLICT ON CONSTRAINT %s
-				DO UPDATE SET staked_tokens=EXCLUDED.staked_tokens, %s=EXCLUDED.%s,
+				DO UPDATE SET staked_tokens=EXCLUDED.staked_tokens, %s=EXCLUDED.%s, geo_zone=EXCLUDED.geo_zone,
 							  paused_height=EXCLUDED.paused_height, unstaking_height=EXCLUDED.unstaking_height,
 							  height=EXCLUDED.height`,
 		tableName, actorSpecificParam,
 		actor.Address, actor.PublicKey, actor.StakedAmount, actorSpecificParamValue,
-		actor.Output, actor.PausedHeight, actor.UnstakingHeight, height,
+		actor.Output, actor.GeoZone, actor.PausedHeight, actor.UnstakingHeight, height,
 		constraintName,
 		actorSpecificParam, actorSpecificParam)
 
@@ -161,17 +164,17 @@ func insertChains(address string, chains []string, height int64, tableName, cons
 	return buffer.String()
 }
 
-func Update(address, stakedTokens, actorSpecificParam, actorSpecificParamValue string, height int64, tableName, constraintName string) string {
+func Update(address, stakedTokens, actorSpecificParam, actorSpecificParamValue, geoZone string, height int64, tableName, constraintName string) string {
 	return fmt.Sprintf(
-		`INSERT INTO %s(address, public_key, staked_tokens, %s, output_address, paused_height, unstaking_height, height)
+		`INSERT INTO %s(address, public_key, staked_tokens, %s, output_address, geo_zone, paused_height, unstaking_height, height)
 			(
-				SELECT address, public_key, '%s', '%s', output_address, paused_height, unstaking_height, %d
+				SELECT address, public_key, '%s', '%s', output_address, '%s', paused_height, unstaking_height, %d
 				FROM %s WHERE address='%s' AND height<=%d ORDER BY height DESC LIMIT 1
 			)
 		    ON CONFLICT ON CONSTRAINT %s
-			DO UPDATE SET staked_tokens=EXCLUDED.staked_tokens, %s=EXCLUDED.%s, height=EXCLUDED.height`,
+			DO UPDATE SET staked_tokens=EXCLUDED.staked_tokens, %s=EXCLUDED.%s, geo_zone=EXCLUDED.geo_zone, height=EXCLUDED.height`,
 		tableName, actorSpecificParam,
-		stakedTokens, actorSpecificParamValue, height,
+		stakedTokens, actorSpecificParamValue, geoZone, height,
 		tableName, address, height,
 		constraintName,
 		actorSpecificParam, actorSpecificParam)
@@ -179,9 +182,9 @@ func Update(address, stakedTokens, actorSpecificParam, actorSpecificParamValue s
 
 func updateUnstakingHeight(address, actorSpecificParam string, unstakingHeight, height int64, tableName, constraintName string) string {
 	return fmt.Sprintf(`
-		INSERT INTO %s(address, public_key, staked_tokens, %s, output_address, paused_height, unstaking_height, height)
+		INSERT INTO %s(address, public_key, staked_tokens, %s, output_address, geo_zone, paused_height, unstaking_height, height)
 		(
-			SELECT address, public_key, staked_tokens, %s, output_address, paused_height, %d, %d
+			SELECT address, public_key, staked_tokens, %s, output_address, geo_zone, paused_height, %d, %d
 			FROM %s WHERE address='%s' AND height<=%d ORDER BY height DESC LIMIT 1
 		)
 		ON CONFLICT ON CONSTRAINT %s
@@ -194,9 +197,9 @@ func updateUnstakingHeight(address, actorSpecificParam string, unstakingHeight,
 
 func updateStakeAmount(address, actorSpecificParam, stakeAmount string, height int64, tableName, constraintName string) string {
 	return fmt.Sprintf(`
-		INSERT INTO %s(address, public_key, staked_tokens, %s, output_address, paused_height, unstaking_height, height)
+		INSERT INTO %s(address, public_key, staked_tokens, %s, output_address, geo_zone, paused_height, unstaking_height, height)
 		(
-			SELECT address, public_key, '%s', %s, output_address, paused_height, unstaking_height, %d
+			SELECT address, public_key, '%s', %s, output_address, geo_zone, paused_height, unstaking_height, %d
 			FROM %s WHERE address='%s' AND height<=%d ORDER BY height DESC LIMIT 1
 		)
 		ON CONFLICT ON CONSTRAINT %s
@@ -209,9 +212,9 @@ func updateStakeAmount(address, actorSpecificParam, stakeAmount string, height i
 
 func updatePausedHeight(address, actorSpecificParam string, pausedHeight, height int64, tableName, constraintName string) string {
 	return fmt.Sprintf(`
-		INSERT INTO %s(address, public_key, staked_tokens, %s, output_address, paused_height, unstaking_height, height)
+		INSERT INTO %s(address, public_key, staked_tokens, %s, output_address, geo_zone, paused_height, unstaking_height, height)
 		(
-			SELECT address, public_key, staked_tokens, %s, output_address, %d, unstaking_height, %d
+			SELECT address, public_key, staked_tokens, %s, output_address, geo_zone, %d, unstaking_height, %d
 			FROM %s WHERE address='%s' AND height<=%d ORDER BY height DESC LIMIT 1
 		)
 		ON CONFLICT ON CONSTRAINT %s
@@ -223,9 +226,9 @@ func updatePausedHeight(address, actorSpecificParam string, pausedHeight, height
 
 func updateUnstakedHeightIfPausedBefore(actorSpecificParam string, unstakingHeight, pausedBeforeHeight, height int64, tableName, constraintName string) string {
 	return fmt.Sprintf(`
-		INSERT INTO %s (address, public_key, staked_tokens, %s, output_address, paused_height, unstaking_height, height)
+		INSERT INTO %s (address, public_key, staked_tokens, %s, output_address, geo_zone, paused_height, unstaking_height, height)
 		(
-			SELECT address, public_key, staked_tokens, %s, output_address, paused_height, %d, %d
+			SELECT address, public_key, staked_tokens, %s, output_address, geo_zone, paused_height, %d, %d
 			FROM %s WHERE paused_height<%d AND paused_height>=0
 				AND (height,address) IN (SELECT MAX(height),address from %s GROUP BY address)
         )
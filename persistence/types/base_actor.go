@@ -93,12 +93,13 @@ func (actor *BaseProtocolActorSchema) GetChainsQuery(address string, height int6
 	return selectChains(AllColsSelector, address, height, actor.tableName, actor.chainsTableName)
 }
 
-func (actor *BaseProtocolActorSchema) InsertQuery(address, publicKey, stakedTokens, generic, outputAddress string, pausedHeight, unstakingHeight int64, chains []string, height int64) string {
+func (actor *BaseProtocolActorSchema) InsertQuery(address, publicKey, stakedTokens, generic, outputAddress, geoZone string, pausedHeight, unstakingHeight int64, chains []string, height int64) string {
 	return Insert(&coreTypes.Actor{
 		Address:         address,
 		PublicKey:       publicKey,
 		StakedAmount:    stakedTokens,
 		Output:          outputAddress,
+		GeoZone:         geoZone,
 		PausedHeight:    pausedHeight,
 		UnstakingHeight: unstakingHeight,
 		Chains:          chains,
@@ -109,8 +110,8 @@ func (actor *BaseProtocolActorSchema) InsertQuery(address, publicKey, stakedToke
 		height)
 }
 
-func (actor *BaseProtocolActorSchema) UpdateQuery(address, stakedTokens, generic string, height int64) string {
-	return Update(address, stakedTokens, actor.actorSpecificColName, generic, height, actor.tableName, actor.heightConstraintName)
+func (actor *BaseProtocolActorSchema) UpdateQuery(address, stakedTokens, generic, geoZone string, height int64) string {
+	return Update(address, stakedTokens, actor.actorSpecificColName, generic, geoZone, height, actor.tableName, actor.heightConstraintName)
 }
 
 func (actor *BaseProtocolActorSchema) UpdateChainsQuery(address string, chains []string, height int64) string {
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// Claims are versioned by height like the rest of the state: removing a claim (i.e. once it is proven
+// or expired) inserts a new row marking it as removed rather than deleting the previous ones.
+const (
+	ClaimsTableName   = "claims"
+	ClaimsTableSchema = `(
+			servicer_address       TEXT NOT NULL,
+			session_id             TEXT NOT NULL,
+			session_height         BIGINT NOT NULL,
+			relay_chain            TEXT NOT NULL,
+			geo_zone               TEXT NOT NULL,
+			application_public_key TEXT NOT NULL,
+			root_hash              TEXT NOT NULL,
+			total_relays           BIGINT NOT NULL,
+			claim_height           BIGINT NOT NULL,
+			removed                BOOLEAN NOT NULL,
+			height                 BIGINT NOT NULL,
+
+			PRIMARY KEY(servicer_address, session_id, height)
+		)`
+
+	claimCols = "servicer_address, session_id, session_height, relay_chain, geo_zone, application_public_key, root_hash, total_relays, claim_height, removed"
+)
+
+func InsertClaimQuery(claim *coreTypes.Claim, removed bool, height int64) string {
+	return fmt.Sprintf(`
+		INSERT INTO %s (%s, height)
+			VALUES ('%s', '%s', %d, '%s', '%s', '%s', '%s', %d, %d, %t, %d)
+			ON CONFLICT (servicer_address, session_id, height)
+			DO UPDATE SET removed=EXCLUDED.removed
+		`,
+		ClaimsTableName, claimCols,
+		claim.ServicerAddress, claim.SessionId, claim.SessionHeight, claim.RelayChain, claim.GeoZone,
+		claim.ApplicationPublicKey, claim.RootHash, claim.TotalRelays, claim.Height, removed, height)
+}
+
+// GetClaimQuery returns the latest version of the claim at `height`, including whether it was removed
+func GetClaimQuery(servicerAddr, sessionID string, height int64) string {
+	return fmt.Sprintf(`
+		SELECT %s FROM %s
+		WHERE servicer_address='%s' AND session_id='%s' AND height<=%d
+		ORDER BY height DESC LIMIT 1
+		`, claimCols, ClaimsTableName, servicerAddr, sessionID, height)
+}
+
+// GetAllClaimsQuery returns the latest version of all the claims that were not removed at `height`
+func GetAllClaimsQuery(height int64) string {
+	return fmt.Sprintf(`
+		SELECT %s FROM (
+			SELECT DISTINCT ON (servicer_address, session_id) %s
+			FROM %s
+			WHERE height<=%d
+			ORDER BY servicer_address, session_id, height DESC
+		) AS latest_claims
+		WHERE removed=false
+		`, claimCols, claimCols, ClaimsTableName, height)
+}
+
+// GetClaimsUpdatedAtHeightQuery returns the claims inserted or removed at `height`
+func GetClaimsUpdatedAtHeightQuery(height int64) string {
+	return fmt.Sprintf(`SELECT %s FROM %s WHERE height=%d`, claimCols, ClaimsTableName, height)
+}
+
+func ClearAllClaimsQuery() string {
+	return fmt.Sprintf(`DELETE FROM %s`, ClaimsTableName)
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// Delegations are versioned by height like test scores: changing the amount or the unstaking height of a delegation
+// inserts a new row, and unbonding it inserts a new row marking it as removed.
+const (
+	DelegationsTableName   = "delegations"
+	DelegationsTableSchema = `(
+			delegator_address TEXT NOT NULL,
+			validator_address TEXT NOT NULL,
+			amount            TEXT NOT NULL,
+			unstaking_height  BIGINT NOT NULL,
+			removed           BOOLEAN NOT NULL,
+			height            BIGINT NOT NULL,
+
+			PRIMARY KEY(delegator_address, validator_address, height)
+		)`
+
+	delegationCols = "delegator_address, validator_address, amount, unstaking_height, removed"
+)
+
+func InsertDelegationQuery(delegation *coreTypes.Delegation, removed bool, height int64) string {
+	return fmt.Sprintf(`
+		INSERT INTO %s (%s, height)
+			VALUES ('%s', '%s', '%s', %d, %t, %d)
+			ON CONFLICT (delegator_address, validator_address, height)
+			DO UPDATE SET amount=EXCLUDED.amount, unstaking_height=EXCLUDED.unstaking_height, removed=EXCLUDED.removed
+		`,
+		DelegationsTableName, delegationCols,
+		delegation.DelegatorAddress, delegation.ValidatorAddress, delegation.Amount, delegation.UnstakingHeight, removed, height)
+}
+
+// GetDelegationQuery returns the latest version of the delegation at `height`, including whether it was removed
+func GetDelegationQuery(delegatorAddr, validatorAddr string, height int64) string {
+	return fmt.Sprintf(`
+		SELECT %s FROM %s
+		WHERE delegator_address='%s' AND validator_address='%s' AND height<=%d
+		ORDER BY height DESC LIMIT 1
+		`, delegationCols, DelegationsTableName, delegatorAddr, validatorAddr, height)
+}
+
+// GetValidatorDelegationsQuery returns the latest version of the delegations to the validator that were not removed
+// at `height`, ordered by delegator address
+func GetValidatorDelegationsQuery(validatorAddr string, height int64) string {
+	return fmt.Sprintf(`
+		SELECT %s FROM (
+			SELECT DISTINCT ON (delegator_address) %s
+			FROM %s
+			WHERE validator_address='%s' AND height<=%d
+			ORDER BY delegator_address, height DESC
+		) AS latest_delegations
+		WHERE removed=false
+		ORDER BY delegator_address
+		`, delegationCols, delegationCols, DelegationsTableName, validatorAddr, height)
+}
+
+// GetDelegationsReadyToUnbondQuery returns the latest version of the delegations, not removed at `height`, whose
+// unstaking height is `height`
+func GetDelegationsReadyToUnbondQuery(height int64) string {
+	return fmt.Sprintf(`
+		SELECT %s FROM (
+			SELECT DISTINCT ON (delegator_address, validator_address) %s
+			FROM %s
+			WHERE height<=%d
+			ORDER BY delegator_address, validator_address, height DESC
+		) AS latest_delegations
+		WHERE removed=false AND unstaking_height=%d
+		ORDER BY delegator_address, validator_address
+		`, delegationCols, delegationCols, DelegationsTableName, height, height)
+}
+
+// GetDelegationsUpdatedAtHeightQuery returns the delegations inserted, updated or removed at `height`
+func GetDelegationsUpdatedAtHeightQuery(height int64) string {
+	return fmt.Sprintf(`SELECT %s FROM %s WHERE height=%d`, delegationCols, DelegationsTableName, height)
+}
+
+func ClearAllDelegationsQuery() string {
+	return fmt.Sprintf(`DELETE FROM %s`, DelegationsTableName)
+}
@@ -0,0 +1,35 @@
+package types
+
+import "fmt"
+
+// Double sign evidence records the heights at which validators were punished for double signing, so the same
+// evidence cannot be used to punish a validator more than once. It is never removed.
+const (
+	DoubleSignEvidenceTableName   = "double_sign_evidence"
+	DoubleSignEvidenceTableSchema = `(
+			validator_address TEXT NOT NULL,
+			evidence_height   BIGINT NOT NULL,
+			height            BIGINT NOT NULL,
+
+			PRIMARY KEY(validator_address, evidence_height)
+		)`
+)
+
+func InsertDoubleSignEvidenceQuery(validatorAddr string, evidenceHeight, height int64) string {
+	return fmt.Sprintf(`INSERT INTO %s (validator_address, evidence_height, height) VALUES ('%s', %d, %d)`,
+		DoubleSignEvidenceTableName, validatorAddr, evidenceHeight, height)
+}
+
+// DoubleSignEvidenceExistsQuery returns whether the validator was punished, at or before `height`, for double signing at `evidenceHeight`
+func DoubleSignEvidenceExistsQuery(validatorAddr string, evidenceHeight, height int64) string {
+	return fmt.Sprintf(`SELECT EXISTS(SELECT 1 FROM %s WHERE validator_address='%s' AND evidence_height=%d AND height<=%d)`,
+		DoubleSignEvidenceTableName, validatorAddr, evidenceHeight, height)
+}
+
+func GetDoubleSignEvidenceAtHeightQuery(height int64) string {
+	return fmt.Sprintf(`SELECT validator_address, evidence_height FROM %s WHERE height=%d`, DoubleSignEvidenceTableName, height)
+}
+
+func ClearAllDoubleSignEvidenceQuery() string {
+	return fmt.Sprintf(`DELETE FROM %s`, DoubleSignEvidenceTableName)
+}
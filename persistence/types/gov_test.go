@@ -24,6 +24,11 @@ func TestInsertParams(t *testing.T) {
 				height: DefaultBigInt,
 			},
 			want: "INSERT INTO params VALUES ('blocks_per_session', -1, 'BIGINT', 4)," +
+				"('allowed_geo_zones', -1, 'STRING', '')," +
+				"('relay_chains', -1, 'STRING', '0001:Pocket Network')," +
+				"('max_transaction_expiry_blocks', -1, 'BIGINT', 100)," +
+				"('governance_voting_period_blocks', -1, 'BIGINT', 100)," +
+				"('governance_quorum_percentage', -1, 'BIGINT', 33)," +
 				"('app_minimum_stake', -1, 'STRING', '15000000000')," +
 				"('app_max_chains', -1, 'SMALLINT', 15)," +
 				"('app_session_tokens_multiplier', -1, 'BIGINT', 100)," +
@@ -36,11 +41,16 @@ func TestInsertParams(t *testing.T) {
 				"('servicer_minimum_pause_blocks', -1, 'SMALLINT', 4)," +
 				"('servicer_max_pause_blocks', -1, 'BIGINT', 672)," +
 				"('servicers_per_session', -1, 'SMALLINT', 24)," +
+				"('claim_expiration_blocks', -1, 'BIGINT', 4)," +
+				"('test_score_expiration_blocks', -1, 'BIGINT', 4)," +
+				"('relays_to_tokens_multiplier', -1, 'BIGINT', 100)," +
+				"('dao_percentage_of_relay_rewards', -1, 'SMALLINT', 10)," +
 				"('fisherman_minimum_stake', -1, 'STRING', '15000000000')," +
 				"('fisherman_max_chains', -1, 'SMALLINT', 15)," +
 				"('fisherman_unstaking_blocks', -1, 'BIGINT', 2016)," +
 				"('fisherman_minimum_pause_blocks', -1, 'SMALLINT', 4)," +
 				"('fisherman_max_pause_blocks', -1, 'SMALLINT', 672)," +
+				"('fishermen_per_session', -1, 'SMALLINT', 1)," +
 				"('validator_minimum_stake', -1, 'STRING', '15000000000')," +
 				"('validator_unstaking_blocks', -1, 'BIGINT', 2016)," +
 				"('validator_minimum_pause_blocks', -1, 'SMALLINT', 4)," +
@@ -50,7 +60,14 @@ func TestInsertParams(t *testing.T) {
 				"('proposer_percentage_of_fees', -1, 'SMALLINT', 10)," +
 				"('missed_blocks_burn_percentage', -1, 'SMALLINT', 1)," +
 				"('double_sign_burn_percentage', -1, 'SMALLINT', 5)," +
+				"('double_sign_reporter_reward_percentage', -1, 'SMALLINT', 10)," +
 				"('message_double_sign_fee', -1, 'STRING', '10000')," +
+				"('message_delegate_fee', -1, 'STRING', '10000')," +
+				"('message_undelegate_fee', -1, 'STRING', '10000')," +
+				"('message_submit_proposal_fee', -1, 'STRING', '10000')," +
+				"('message_vote_fee', -1, 'STRING', '10000')," +
+				"('message_schedule_upgrade_fee', -1, 'STRING', '10000')," +
+				"('message_rotate_operator_key_fee', -1, 'STRING', '10000')," +
 				"('message_send_fee', -1, 'STRING', '10000')," +
 				"('message_stake_fisherman_fee', -1, 'STRING', '10000')," +
 				"('message_edit_stake_fisherman_fee', -1, 'STRING', '10000')," +
@@ -76,8 +93,15 @@ func TestInsertParams(t *testing.T) {
 				"('message_pause_servicer_fee', -1, 'STRING', '10000')," +
 				"('message_unpause_servicer_fee', -1, 'STRING', '10000')," +
 				"('message_change_parameter_fee', -1, 'STRING', '10000')," +
+				"('message_claim_fee', -1, 'STRING', '10000')," +
+				"('message_proof_fee', -1, 'STRING', '10000')," +
 				"('acl_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('blocks_per_session_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('allowed_geo_zones_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('relay_chains_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('max_transaction_expiry_blocks_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('governance_voting_period_blocks_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('governance_quorum_percentage_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('app_minimum_stake_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('app_max_chains_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('app_session_tokens_multiplier_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
@@ -90,11 +114,16 @@ func TestInsertParams(t *testing.T) {
 				"('servicer_minimum_pause_blocks_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('servicer_max_paused_blocks_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('servicers_per_session_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('claim_expiration_blocks_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('test_score_expiration_blocks_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('relays_to_tokens_multiplier_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('dao_percentage_of_relay_rewards_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('fisherman_minimum_stake_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('fisherman_max_chains_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('fisherman_unstaking_blocks_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('fisherman_minimum_pause_blocks_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('fisherman_max_paused_blocks_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('fishermen_per_session_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('validator_minimum_stake_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('validator_unstaking_blocks_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('validator_minimum_pause_blocks_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
@@ -104,7 +133,14 @@ func TestInsertParams(t *testing.T) {
 				"('proposer_percentage_of_fees_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('missed_blocks_burn_percentage_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('double_sign_burn_percentage_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('double_sign_reporter_reward_percentage_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('message_double_sign_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('message_delegate_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('message_undelegate_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('message_submit_proposal_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('message_vote_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('message_schedule_upgrade_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('message_rotate_operator_key_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('message_send_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('message_stake_fisherman_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('message_edit_stake_fisherman_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
@@ -129,7 +165,9 @@ func TestInsertParams(t *testing.T) {
 				"('message_unstake_servicer_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('message_pause_servicer_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('message_unpause_servicer_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
-				"('message_change_parameter_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45') " +
+				"('message_change_parameter_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('message_claim_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('message_proof_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45') " +
 				"ON CONFLICT ON CONSTRAINT params_pkey DO UPDATE SET value=EXCLUDED.value, type=EXCLUDED.type",
 		},
 	}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// Operator rotations record the operator addresses retired by rotating the key of an actor. An operator address can
+// only be retired once, since it cannot be staked again.
+const (
+	OperatorRotationsTableName   = "operator_rotations"
+	OperatorRotationsTableSchema = `(
+			actor_type  INT NOT NULL,
+			old_address TEXT NOT NULL,
+			new_address TEXT NOT NULL,
+			height      BIGINT NOT NULL,
+
+			PRIMARY KEY(actor_type, old_address)
+		)`
+)
+
+func InsertOperatorRotationQuery(actorType coreTypes.ActorType, oldAddress, newAddress string, height int64) string {
+	return fmt.Sprintf(`INSERT INTO %s (actor_type, old_address, new_address, height) VALUES (%d, '%s', '%s', %d)`,
+		OperatorRotationsTableName, actorType, oldAddress, newAddress, height)
+}
+
+// GetRotatedOperatorsAtHeightQuery returns the operator addresses of the actors of type `actorType` retired at `height`
+func GetRotatedOperatorsAtHeightQuery(actorType coreTypes.ActorType, height int64) string {
+	return fmt.Sprintf(`SELECT old_address FROM %s WHERE actor_type=%d AND height=%d`,
+		OperatorRotationsTableName, actorType, height)
+}
+
+// GetOperatorRotationQuery returns the operator address `oldAddress` was rotated to at or before `height`, if any
+func GetOperatorRotationQuery(actorType coreTypes.ActorType, oldAddress string, height int64) string {
+	return fmt.Sprintf(`SELECT new_address FROM %s WHERE actor_type=%d AND old_address='%s' AND height<=%d`,
+		OperatorRotationsTableName, actorType, oldAddress, height)
+}
+
+func ClearAllOperatorRotationsQuery() string {
+	return fmt.Sprintf(`DELETE FROM %s`, OperatorRotationsTableName)
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"fmt"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// Proposals are versioned by height like test scores: updating the status of a proposal inserts a new row. The
+// proposal is stored as its hex encoded protobuf, its status and voting end height being duplicated so they can be queried.
+// Votes are versioned by height as well, so a validator can change its vote during the voting period.
+const (
+	ProposalsTableName   = "proposals"
+	ProposalsTableSchema = `(
+			id                BIGINT NOT NULL,
+			proposal          TEXT NOT NULL,
+			status            INT NOT NULL,
+			voting_end_height BIGINT NOT NULL,
+			height            BIGINT NOT NULL,
+
+			PRIMARY KEY(id, height)
+		)`
+
+	VotesTableName   = "proposal_votes"
+	VotesTableSchema = `(
+			proposal_id       BIGINT NOT NULL,
+			validator_address TEXT NOT NULL,
+			option            INT NOT NULL,
+			height            BIGINT NOT NULL,
+
+			PRIMARY KEY(proposal_id, validator_address, height)
+		)`
+)
+
+func InsertProposalQuery(id uint64, proposal string, status coreTypes.ProposalStatus, votingEndHeight, height int64) string {
+	return fmt.Sprintf(`
+		INSERT INTO %s (id, proposal, status, voting_end_height, height)
+			VALUES (%d, '%s', %d, %d, %d)
+			ON CONFLICT (id, height)
+			DO UPDATE SET proposal=EXCLUDED.proposal, status=EXCLUDED.status
+		`, ProposalsTableName, id, proposal, status, votingEndHeight, height)
+}
+
+// GetProposalQuery returns the latest version of the proposal at `height`
+func GetProposalQuery(id uint64, height int64) string {
+	return fmt.Sprintf(`SELECT proposal FROM %s WHERE id=%d AND height<=%d ORDER BY height DESC LIMIT 1`,
+		ProposalsTableName, id, height)
+}
+
+// GetLatestProposalIDQuery returns the highest id of the proposals submitted at or before `height`, or 0 if there are none
+func GetLatestProposalIDQuery(height int64) string {
+	return fmt.Sprintf(`SELECT COALESCE(MAX(id), 0) FROM %s WHERE height<=%d`, ProposalsTableName, height)
+}
+
+// GetProposalsEndingAtHeightQuery returns the latest version of the proposals still being voted on whose voting
+// period ends at `height`, ordered by id
+func GetProposalsEndingAtHeightQuery(height int64) string {
+	return fmt.Sprintf(`
+		SELECT proposal FROM (
+			SELECT DISTINCT ON (id) proposal, status, voting_end_height, id
+			FROM %s
+			WHERE height<=%d
+			ORDER BY id, height DESC
+		) AS latest_proposals
+		WHERE status=%d AND voting_end_height=%d
+		ORDER BY id
+		`, ProposalsTableName, height, coreTypes.ProposalStatus_PROPOSAL_STATUS_VOTING, height)
+}
+
+// GetProposalsUpdatedAtHeightQuery returns the proposals submitted or updated at `height`
+func GetProposalsUpdatedAtHeightQuery(height int64) string {
+	return fmt.Sprintf(`SELECT proposal FROM %s WHERE height=%d`, ProposalsTableName, height)
+}
+
+func InsertVoteQuery(vote *coreTypes.Vote, height int64) string {
+	return fmt.Sprintf(`
+		INSERT INTO %s (proposal_id, validator_address, option, height)
+			VALUES (%d, '%s', %d, %d)
+			ON CONFLICT (proposal_id, validator_address, height)
+			DO UPDATE SET option=EXCLUDED.option
+		`, VotesTableName, vote.ProposalId, vote.ValidatorAddress, vote.Option, height)
+}
+
+// GetProposalVotesQuery returns the latest vote of every validator that voted on the proposal at or before `height`,
+// ordered by validator address
+func GetProposalVotesQuery(proposalID uint64, height int64) string {
+	return fmt.Sprintf(`
+		SELECT DISTINCT ON (validator_address) proposal_id, validator_address, option
+		FROM %s
+		WHERE proposal_id=%d AND height<=%d
+		ORDER BY validator_address, height DESC
+		`, VotesTableName, proposalID, height)
+}
+
+// GetVotesUpdatedAtHeightQuery returns the votes cast or changed at `height`
+func GetVotesUpdatedAtHeightQuery(height int64) string {
+	return fmt.Sprintf(`SELECT proposal_id, validator_address, option FROM %s WHERE height=%d`, VotesTableName, height)
+}
+
+func ClearAllProposalsQuery() string {
+	return fmt.Sprintf(`DELETE FROM %s`, ProposalsTableName)
+}
+
+func ClearAllVotesQuery() string {
+	return fmt.Sprintf(`DELETE FROM %s`, VotesTableName)
+}
@@ -46,11 +46,11 @@ type ProtocolActorSchema interface {
 	/*** Create/Insert Queries ***/
 
 	// Returns a query to create a new Actor with all of the necessary data.
-	InsertQuery(address, publicKey, stakedTokens, serviceURL, outputAddress string, pausedHeight, unstakingHeight int64, chains []string, height int64) string
+	InsertQuery(address, publicKey, stakedTokens, serviceURL, outputAddress, geoZone string, pausedHeight, unstakingHeight int64, chains []string, height int64) string
 
 	/*** Update Queries ***/
-	// Returns a query to update an Actor's stake and/or max relays.
-	UpdateQuery(address, stakedTokens, serviceURL string, height int64) string
+	// Returns a query to update an Actor's stake, service URL and geo zone.
+	UpdateQuery(address, stakedTokens, serviceURL, geoZone string, height int64) string
 	// Returns a query to update the chains an Actor is staked for.
 	UpdateChainsQuery(address string, chains []string, height int64) string
 	// Returns a query to update the height at which an Actor is unstaking.
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// Test scores are versioned by height like claims: proving a test score inserts a new row marking it as proven, and
+// removing it (i.e. once it expires) inserts a new row marking it as removed.
+const (
+	TestScoresTableName   = "test_scores"
+	TestScoresTableSchema = `(
+			fisherman_address      TEXT NOT NULL,
+			servicer_address       TEXT NOT NULL,
+			session_id             TEXT NOT NULL,
+			session_height         BIGINT NOT NULL,
+			relay_chain            TEXT NOT NULL,
+			geo_zone               TEXT NOT NULL,
+			application_public_key TEXT NOT NULL,
+			root_hash              TEXT NOT NULL,
+			total_tests            BIGINT NOT NULL,
+			successful_tests       BIGINT NOT NULL,
+			proven                 BOOLEAN NOT NULL,
+			proven_test_failed     BOOLEAN NOT NULL,
+			test_score_height      BIGINT NOT NULL,
+			removed                BOOLEAN NOT NULL,
+			height                 BIGINT NOT NULL,
+
+			PRIMARY KEY(fisherman_address, servicer_address, session_id, height)
+		)`
+
+	testScoreCols = "fisherman_address, servicer_address, session_id, session_height, relay_chain, geo_zone, application_public_key, root_hash, total_tests, successful_tests, proven, proven_test_failed, test_score_height, removed"
+)
+
+func InsertTestScoreQuery(testScore *coreTypes.TestScore, removed bool, height int64) string {
+	return fmt.Sprintf(`
+		INSERT INTO %s (%s, height)
+			VALUES ('%s', '%s', '%s', %d, '%s', '%s', '%s', '%s', %d, %d, %t, %t, %d, %t, %d)
+			ON CONFLICT (fisherman_address, servicer_address, session_id, height)
+			DO UPDATE SET proven=EXCLUDED.proven, proven_test_failed=EXCLUDED.proven_test_failed, removed=EXCLUDED.removed
+		`,
+		TestScoresTableName, testScoreCols,
+		testScore.FishermanAddress, testScore.ServicerAddress, testScore.SessionId, testScore.SessionHeight,
+		testScore.RelayChain, testScore.GeoZone, testScore.ApplicationPublicKey, testScore.RootHash,
+		testScore.TotalTests, testScore.SuccessfulTests, testScore.Proven, testScore.ProvenTestFailed, testScore.Height, removed, height)
+}
+
+// GetTestScoreQuery returns the latest version of the test score at `height`, including whether it was removed
+func GetTestScoreQuery(fishermanAddr, servicerAddr, sessionID string, height int64) string {
+	return fmt.Sprintf(`
+		SELECT %s FROM %s
+		WHERE fisherman_address='%s' AND servicer_address='%s' AND session_id='%s' AND height<=%d
+		ORDER BY height DESC LIMIT 1
+		`, testScoreCols, TestScoresTableName, fishermanAddr, servicerAddr, sessionID, height)
+}
+
+// GetAllTestScoresQuery returns the latest version of all the test scores that were not removed at `height`
+func GetAllTestScoresQuery(height int64) string {
+	return fmt.Sprintf(`
+		SELECT %s FROM (
+			SELECT DISTINCT ON (fisherman_address, servicer_address, session_id) %s
+			FROM %s
+			WHERE height<=%d
+			ORDER BY fisherman_address, servicer_address, session_id, height DESC
+		) AS latest_test_scores
+		WHERE removed=false
+		`, testScoreCols, testScoreCols, TestScoresTableName, height)
+}
+
+// GetTestScoresUpdatedAtHeightQuery returns the test scores inserted, proven or removed at `height`
+func GetTestScoresUpdatedAtHeightQuery(height int64) string {
+	return fmt.Sprintf(`SELECT %s FROM %s WHERE height=%d`, testScoreCols, TestScoresTableName, height)
+}
+
+func ClearAllTestScoresQuery() string {
+	return fmt.Sprintf(`DELETE FROM %s`, TestScoresTableName)
+}
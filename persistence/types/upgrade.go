@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// Upgrade plans are versioned by height: scheduling an upgrade replaces the plan previously scheduled, if any.
+const (
+	UpgradePlansTableName   = "upgrade_plans"
+	UpgradePlansTableSchema = `(
+			name              TEXT NOT NULL,
+			activation_height BIGINT NOT NULL,
+			applied           BOOLEAN NOT NULL,
+			height            BIGINT NOT NULL,
+
+			PRIMARY KEY(height)
+		)`
+)
+
+func InsertUpgradePlanQuery(plan *coreTypes.UpgradePlan, height int64) string {
+	return fmt.Sprintf(`
+		INSERT INTO %s (name, activation_height, applied, height)
+			VALUES ('%s', %d, %t, %d)
+			ON CONFLICT (height)
+			DO UPDATE SET name=EXCLUDED.name, activation_height=EXCLUDED.activation_height, applied=EXCLUDED.applied
+		`, UpgradePlansTableName, plan.Name, plan.ActivationHeight, plan.Applied, height)
+}
+
+// GetUpgradePlanQuery returns the latest upgrade plan scheduled at or before `height`
+func GetUpgradePlanQuery(height int64) string {
+	return fmt.Sprintf(`SELECT name, activation_height, applied FROM %s WHERE height<=%d ORDER BY height DESC LIMIT 1`,
+		UpgradePlansTableName, height)
+}
+
+// GetUpgradePlanUpdatedAtHeightQuery returns the upgrade plan scheduled at `height`, if any
+func GetUpgradePlanUpdatedAtHeightQuery(height int64) string {
+	return fmt.Sprintf(`SELECT name, activation_height, applied FROM %s WHERE height=%d`, UpgradePlansTableName, height)
+}
+
+func ClearAllUpgradePlansQuery() string {
+	return fmt.Sprintf(`DELETE FROM %s`, UpgradePlansTableName)
+}
@@ -29,7 +29,7 @@ var ValidatorActor ProtocolActorSchema = &ValidatorSchema{
 	},
 }
 
-func (actor *ValidatorSchema) InsertQuery(address, publicKey, stakedTokens, serviceURL, outputAddress string, pausedHeight, unstakingHeight int64, _ []string, height int64) string {
+func (actor *ValidatorSchema) InsertQuery(address, publicKey, stakedTokens, serviceURL, outputAddress, _ string, pausedHeight, unstakingHeight int64, _ []string, height int64) string {
 	return Insert(&coreTypes.Actor{
 		Address:         address,
 		PublicKey:       publicKey,
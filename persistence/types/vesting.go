@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// Vesting schedules are versioned by height: setting the schedule of an account replaces its previous one, if any.
+const (
+	VestingSchedulesTableName   = "vesting_schedules"
+	VestingSchedulesTableSchema = `(
+			address      TEXT NOT NULL,
+			amount       TEXT NOT NULL,
+			start_height BIGINT NOT NULL,
+			cliff_height BIGINT NOT NULL,
+			end_height   BIGINT NOT NULL,
+			height       BIGINT NOT NULL,
+
+			PRIMARY KEY(address, height)
+		)`
+
+	vestingScheduleCols = "address, amount, start_height, cliff_height, end_height"
+)
+
+func InsertVestingScheduleQuery(schedule *coreTypes.VestingSchedule, height int64) string {
+	return fmt.Sprintf(`
+		INSERT INTO %s (%s, height)
+			VALUES ('%s', '%s', %d, %d, %d, %d)
+			ON CONFLICT (address, height)
+			DO UPDATE SET amount=EXCLUDED.amount, start_height=EXCLUDED.start_height, cliff_height=EXCLUDED.cliff_height, end_height=EXCLUDED.end_height
+		`,
+		VestingSchedulesTableName, vestingScheduleCols,
+		schedule.Address, schedule.Amount, schedule.StartHeight, schedule.CliffHeight, schedule.EndHeight, height)
+}
+
+// GetVestingScheduleQuery returns the latest vesting schedule of the account set at or before `height`
+func GetVestingScheduleQuery(address string, height int64) string {
+	return fmt.Sprintf(`
+		SELECT %s FROM %s
+		WHERE address='%s' AND height<=%d
+		ORDER BY height DESC LIMIT 1
+		`, vestingScheduleCols, VestingSchedulesTableName, address, height)
+}
+
+// GetVestingSchedulesUpdatedAtHeightQuery returns the vesting schedules set at `height`
+func GetVestingSchedulesUpdatedAtHeightQuery(height int64) string {
+	return fmt.Sprintf(`SELECT %s FROM %s WHERE height=%d`, vestingScheduleCols, VestingSchedulesTableName, height)
+}
+
+func ClearAllVestingSchedulesQuery() string {
+	return fmt.Sprintf(`DELETE FROM %s`, VestingSchedulesTableName)
+}
@@ -0,0 +1,35 @@
+package persistence
+
+import (
+	"github.com/jackc/pgx/v5"
+	"github.com/pokt-network/pocket/persistence/types"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// SetUpgradePlan schedules the upgrade, replacing the upgrade previously scheduled if any, or marks it as applied
+func (p *PostgresContext) SetUpgradePlan(plan *coreTypes.UpgradePlan) error {
+	ctx, tx := p.getCtxAndTx()
+	_, err := tx.Exec(ctx, types.InsertUpgradePlanQuery(plan, p.Height))
+	return err
+}
+
+func (p *PostgresContext) GetUpgradePlan(height int64) (*coreTypes.UpgradePlan, error) {
+	return p.getUpgradePlan(types.GetUpgradePlanQuery(height))
+}
+
+// getUpgradePlanUpdatedAtHeight returns the upgrade plan scheduled at `height`, or nil if none was
+func (p *PostgresContext) getUpgradePlanUpdatedAtHeight(height int64) (*coreTypes.UpgradePlan, error) {
+	return p.getUpgradePlan(types.GetUpgradePlanUpdatedAtHeightQuery(height))
+}
+
+func (p *PostgresContext) getUpgradePlan(query string) (*coreTypes.UpgradePlan, error) {
+	ctx, tx := p.getCtxAndTx()
+	plan := new(coreTypes.UpgradePlan)
+	if err := tx.QueryRow(ctx, query).Scan(&plan.Name, &plan.ActivationHeight, &plan.Applied); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return plan, nil
+}
@@ -0,0 +1,53 @@
+package persistence
+
+import (
+	"encoding/hex"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pokt-network/pocket/persistence/types"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// SetVestingSchedule inserts a new version of the vesting schedule, replacing the schedule of the account if any
+func (p *PostgresContext) SetVestingSchedule(schedule *coreTypes.VestingSchedule) error {
+	ctx, tx := p.getCtxAndTx()
+	_, err := tx.Exec(ctx, types.InsertVestingScheduleQuery(schedule, p.Height))
+	return err
+}
+
+func (p *PostgresContext) GetVestingSchedule(address []byte, height int64) (*coreTypes.VestingSchedule, error) {
+	ctx, tx := p.getCtxAndTx()
+	schedule, err := scanVestingSchedule(tx.QueryRow(ctx, types.GetVestingScheduleQuery(hex.EncodeToString(address), height)))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	return schedule, err
+}
+
+// getVestingSchedulesUpdatedAtHeight returns the vesting schedules set at `height`
+func (p *PostgresContext) getVestingSchedulesUpdatedAtHeight(height int64) (schedules []*coreTypes.VestingSchedule, err error) {
+	ctx, tx := p.getCtxAndTx()
+	rows, err := tx.Query(ctx, types.GetVestingSchedulesUpdatedAtHeightQuery(height))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		schedule, err := scanVestingSchedule(rows)
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, schedule)
+	}
+	return schedules, rows.Err()
+}
+
+func scanVestingSchedule(row pgx.Row) (*coreTypes.VestingSchedule, error) {
+	schedule := new(coreTypes.VestingSchedule)
+	err := row.Scan(&schedule.Address, &schedule.Amount, &schedule.StartHeight, &schedule.CliffHeight, &schedule.EndHeight)
+	if err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
@@ -2,13 +2,17 @@ package rpc
 
 import (
 	"encoding/hex"
+	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
 	"github.com/pokt-network/pocket/app"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
 	"github.com/pokt-network/pocket/shared/modules"
 	"github.com/pokt-network/pocket/utility"
+	"github.com/pokt-network/pocket/utility/session"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
 )
 
 func (s *rpcServer) GetV1Health(ctx echo.Context) error {
@@ -41,6 +45,29 @@ func (s *rpcServer) PostV1ClientBroadcastTxSync(ctx echo.Context) error {
 	return nil
 }
 
+func (s *rpcServer) PostV1ClientSimulateTx(ctx echo.Context) error {
+	txParams := new(RawTXRequest)
+	if err := ctx.Bind(txParams); err != nil {
+		return ctx.String(http.StatusBadRequest, "bad request")
+	}
+
+	txBz, err := hex.DecodeString(txParams.RawHexBytes)
+	if err != nil {
+		return ctx.String(http.StatusBadRequest, "cannot decode tx bytes")
+	}
+
+	txResult, fee, err := s.GetBus().GetUtilityModule().SimulateTransaction(txBz)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, SimulateTxResponse{
+		ResultCode: txResult.GetResultCode(),
+		Error:      txResult.GetError(),
+		Fee:        fee,
+	})
+}
+
 func (s *rpcServer) GetV1ConsensusState(ctx echo.Context) error {
 	consensus := s.GetBus().GetConsensusModule()
 	return ctx.JSON(200, ConsensusState{
@@ -50,6 +77,150 @@ func (s *rpcServer) GetV1ConsensusState(ctx echo.Context) error {
 	})
 }
 
+func (s *rpcServer) PostV1ClientDispatch(ctx echo.Context) error {
+	dispatchReq := new(DispatchRequest)
+	if err := ctx.Bind(dispatchReq); err != nil {
+		return ctx.String(http.StatusBadRequest, "bad request")
+	}
+
+	appPubKey, err := crypto.NewPublicKey(dispatchReq.AppPublicKey)
+	if err != nil {
+		return ctx.String(http.StatusBadRequest, "cannot decode application public key")
+	}
+
+	height := s.getLatestCommittedHeight()
+	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	defer readCtx.Release()
+
+	// the application is loaded as it was when the session started, like when servicing its relays
+	sessionHeight, er := session.GetSessionHeight(readCtx, height)
+	if er != nil {
+		return ctx.String(http.StatusInternalServerError, er.Error())
+	}
+	application, err := readCtx.GetActor(coreTypes.ActorType_ACTOR_TYPE_APP, appPubKey.Address(), sessionHeight)
+	if err != nil {
+		return ctx.String(http.StatusBadRequest, "application not found")
+	}
+
+	appSession, er := session.NewSession(readCtx, height, session.RelayChain(dispatchReq.Chain), session.GeoZone(dispatchReq.GeoZone), application)
+	if er != nil {
+		return ctx.String(http.StatusInternalServerError, er.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, DispatchResponse{
+		SessionId:            hex.EncodeToString(appSession.GetSessionID()),
+		SessionHeight:        appSession.GetSessionHeight(),
+		Servicers:            protocolActorsToRPCActors(appSession.GetServicers()),
+		Fishermen:            protocolActorsToRPCActors(appSession.GetFishermen()),
+		MaxRelaysPerServicer: appSession.GetMaxRelaysPerServicer().String(),
+	})
+}
+
+func (s *rpcServer) PostV1ClientRelay(ctx echo.Context) error {
+	relayParams := new(RelayRequest)
+	if err := ctx.Bind(relayParams); err != nil {
+		return ctx.String(http.StatusBadRequest, "bad request")
+	}
+
+	response, err := s.GetBus().GetUtilityModule().HandleRelay(rpcRelayToProtocolRelay(relayParams))
+	if err != nil {
+		return ctx.String(relayErrorStatusCode(err), err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, RelayResponse{
+		Payload:           response.GetPayload(),
+		ServicerSignature: response.GetServicerSignature(),
+	})
+}
+
+func (s *rpcServer) PostV1ClientRelayBudget(ctx echo.Context) error {
+	budgetReq := new(DispatchRequest)
+	if err := ctx.Bind(budgetReq); err != nil {
+		return ctx.String(http.StatusBadRequest, "bad request")
+	}
+
+	maxRelays, remainingRelays, err := s.GetBus().GetUtilityModule().GetRelayBudget(budgetReq.AppPublicKey, budgetReq.Chain, budgetReq.GeoZone)
+	if err != nil {
+		return ctx.String(relayErrorStatusCode(err), err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, RelayBudgetResponse{
+		MaxRelays:       maxRelays.String(),
+		RemainingRelays: remainingRelays.String(),
+	})
+}
+
+// rpcRelayToProtocolRelay converts a relay from its rpc representation to the protocol one
+func rpcRelayToProtocolRelay(relayParams *RelayRequest) *coreTypes.Relay {
+	payload := &coreTypes.RelayPayload{
+		Data:     relayParams.Payload.Data,
+		HttpPath: relayParams.Payload.HttpPath,
+	}
+	if relayParams.Payload.Method != nil {
+		payload.Method = *relayParams.Payload.Method
+	}
+	if relayParams.Payload.Headers != nil {
+		payload.Headers = relayParams.Payload.Headers.AdditionalProperties
+	}
+
+	meta := relayParams.Meta
+	return &coreTypes.Relay{
+		Payload: payload,
+		Meta: &coreTypes.RelayMeta{
+			BlockHeight:       meta.BlockHeight,
+			ServicerPublicKey: meta.ServicerPublicKey,
+			RelayChain:        meta.RelayChain,
+			GeoZone:           meta.GeoZone,
+			Token: &coreTypes.AAT{
+				Version:              meta.Token.Version,
+				ApplicationPublicKey: meta.Token.ApplicationPublicKey,
+				ClientPublicKey:      meta.Token.ClientPublicKey,
+				ApplicationSignature: meta.Token.ApplicationSignature,
+			},
+			Signature: meta.Signature,
+		},
+	}
+}
+
+// relayErrorStatusCode returns the HTTP status code matching the error returned while handling a relay
+func relayErrorStatusCode(err error) int {
+	var utilityErr typesUtil.Error
+	if !errors.As(err, &utilityErr) {
+		return http.StatusInternalServerError
+	}
+	switch utilityErr.Code() {
+	case typesUtil.CodeInvalidRelayError,
+		typesUtil.CodeUnsupportedRelayChainError,
+		typesUtil.CodeInvalidAATError,
+		typesUtil.CodeNewPublicKeyFromBytesError,
+		typesUtil.CodeSignatureVerificationFailedError,
+		typesUtil.CodeRelayOutOfSessionError,
+		typesUtil.CodeServicerNotInSessionError:
+		return http.StatusBadRequest
+	case typesUtil.CodeRelayLimitExceededError:
+		return http.StatusTooManyRequests
+	case typesUtil.CodeRelayExecutionError:
+		return http.StatusBadGateway
+	case typesUtil.CodeServicerDisabledError:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// getLatestCommittedHeight returns the height of the last block committed to the chain. The consensus
+// module reports the height it is currently working on, whose block hash is not known until it is committed.
+func (s *rpcServer) getLatestCommittedHeight() int64 {
+	height := int64(s.GetBus().GetConsensusModule().CurrentHeight())
+	if height > 0 {
+		height--
+	}
+	return height
+}
+
 // Broadcast to the entire validator set
 func (s *rpcServer) broadcastMessage(msgBz []byte) error {
 	utilityMsg, err := utility.PrepareTxGossipMessage(msgBz)
@@ -68,7 +239,6 @@ func (s *rpcServer) broadcastMessage(msgBz []byte) error {
 
 func (s *rpcServer) GetV1P2pStakedActorsAddressBook(ctx echo.Context, params GetV1P2pStakedActorsAddressBookParams) error {
 	var height int64
-	var actors []Actor
 
 	if params.Height != nil {
 		height = *params.Height
@@ -89,6 +259,17 @@ func (s *rpcServer) GetV1P2pStakedActorsAddressBook(ctx echo.Context, params Get
 		return ctx.String(http.StatusInternalServerError, err.Error())
 	}
 
+	response := P2PStakedActorsResponse{
+		Actors: protocolActorsToRPCActors(protocolActors),
+		Height: height,
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// protocolActorsToRPCActors converts a list of protocol actors to their rpc representation
+func protocolActorsToRPCActors(protocolActors []*coreTypes.Actor) []Actor {
+	actors := make([]Actor, 0, len(protocolActors))
 	for _, protocolActor := range protocolActors {
 		actors = append(actors, Actor{
 			Address:    protocolActor.Address,
@@ -97,13 +278,7 @@ func (s *rpcServer) GetV1P2pStakedActorsAddressBook(ctx echo.Context, params Get
 			ServiceUrl: protocolActor.ServiceUrl,
 		})
 	}
-
-	response := P2PStakedActorsResponse{
-		Actors: actors,
-		Height: height,
-	}
-
-	return ctx.JSON(http.StatusOK, response)
+	return actors
 }
 
 // protocolActorToRPCActorTypeEnum converts a protocol actor type to the rpc actor type enum
@@ -140,3 +315,38 @@ func getProtocolActorGetter(persistenceContext modules.PersistenceReadContext, p
 	}
 	return protocolActorGetter
 }
+
+func (s *rpcServer) GetV1QueryRelayChains(ctx echo.Context, params GetV1QueryRelayChainsParams) error {
+	height := s.getLatestCommittedHeight()
+	if params.Height != nil {
+		height = *params.Height
+	}
+
+	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	defer readCtx.Release()
+
+	relayChainsParam, err := readCtx.GetStringParam(typesUtil.RelayChainsParamName, height)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	registry, er := typesUtil.ParseRelayChains(relayChainsParam)
+	if er != nil {
+		return ctx.String(http.StatusInternalServerError, er.Error())
+	}
+
+	relayChains := make([]RelayChain, 0, len(registry))
+	for _, relayChain := range registry {
+		relayChains = append(relayChains, RelayChain{
+			Id:   relayChain.ID,
+			Name: relayChain.Name,
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, RelayChainsResponse{
+		RelayChains: relayChains,
+		Height:      height,
+	})
+}
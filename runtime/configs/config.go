@@ -29,6 +29,7 @@ type Config struct {
 	Logger      *LoggerConfig      `json:"logger"`
 	RPC         *RPCConfig         `json:"rpc"`
 	Keybase     *KeybaseConfig     `json:"keybase"`
+	Servicer    *ServicerConfig    `json:"servicer"`
 }
 
 // ParseConfig parses the config file and returns a Config struct
@@ -117,6 +118,7 @@ func NewDefaultConfig(options ...func(*Config)) *Config {
 		Utility: &UtilityConfig{
 			MaxMempoolTransactionBytes: defaults.DefaultUtilityMaxMempoolTransactionBytes,
 			MaxMempoolTransactions:     defaults.DefaultUtilityMaxMempoolTransactions,
+			MempoolType:                defaults.DefaultUtilityMempoolType,
 		},
 		Persistence: &PersistenceConfig{
 			PostgresUrl:    defaults.DefaultPersistencePostgresURL,
@@ -148,6 +150,10 @@ func NewDefaultConfig(options ...func(*Config)) *Config {
 			VaultToken:     defaults.DefaultKeybaseVaultToken,
 			VaultMountPath: defaults.DefaultKeybaseVaultMountPath,
 		},
+		Servicer: &ServicerConfig{
+			Enabled:        defaults.DefaultServicerEnabled,
+			RelayStorePath: defaults.DefaultServicerRelayStorePath,
+		},
 	}
 
 	for _, option := range options {
@@ -167,6 +173,7 @@ func WithPK(pk string) func(*Config) {
 		cfg.PrivateKey = pk
 		cfg.Consensus.PrivateKey = pk
 		cfg.P2P.PrivateKey = pk
+		cfg.Servicer.PrivateKey = pk
 	}
 }
 
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+func (m MempoolType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(MempoolType_name[int32(m)])
+}
+
+// UnmarshalJSON converts the JSON string to a MempoolType
+func (m *MempoolType) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	mempoolType, ok := MempoolType_value[strings.ToUpper(value)]
+	if !ok {
+		return fmt.Errorf("invalid mempool type: %s", value)
+	}
+	*m = MempoolType(mempoolType)
+	return nil
+}
@@ -4230,7 +4230,8 @@ func TestNewManagerFromReaders(t *testing.T) {
 						Timeout: 30000,
 						UseCors: false,
 					},
-					Keybase: defaultCfg.Keybase,
+					Keybase:  defaultCfg.Keybase,
+					Servicer: defaultCfg.Servicer,
 				},
 				genesisState: expectedGenesis,
 				clock:        clock.New(),
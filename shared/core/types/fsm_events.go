@@ -11,4 +11,5 @@ const (
 	StateMachineEvent_Consensus_IsSyncing            StateMachineEvent = "Consensus_IsSyncing"
 	StateMachineEvent_Consensus_IsSyncedValidator    StateMachineEvent = "Consensus_IsSyncedValidator"
 	StateMachineEvent_Consensus_IsSyncedNonValidator StateMachineEvent = "Consensus_IsSyncedNonValidator"
+	StateMachineEvent_Consensus_IsHalted             StateMachineEvent = "Consensus_IsHalted"
 )
@@ -14,4 +14,7 @@ const (
 
 	// Used by synced validators to participate in the HotPOKT lifecycle
 	StateMachineState_Consensus_Pacemaker StateMachineState = "Consensus_Pacemaker"
+
+	// Used by nodes that reached the activation height of an upgrade without running the binary it expects
+	StateMachineState_Consensus_Halted StateMachineState = "Consensus_Halted"
 )
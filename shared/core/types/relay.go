@@ -0,0 +1,42 @@
+package types
+
+import (
+	"github.com/pokt-network/pocket/shared/codec"
+	"github.com/pokt-network/pocket/shared/crypto"
+)
+
+// The bytes of the relay that should have been signed by the client
+func (r *Relay) SignableBytes() ([]byte, error) {
+	relayCopy := codec.GetCodec().Clone(r).(*Relay)
+	if relayCopy.Meta != nil {
+		relayCopy.Meta.Signature = ""
+	}
+	return codec.GetCodec().Marshal(relayCopy)
+}
+
+func (r *Relay) Bytes() ([]byte, error) {
+	return codec.GetCodec().Marshal(r)
+}
+
+// Hash returns the hash of the proto marshaled relay
+func (r *Relay) Hash() ([]byte, error) {
+	relayBz, err := r.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	return crypto.SHA3Hash(relayBz), nil
+}
+
+// The bytes of the token that should have been signed by the application
+func (a *AAT) SignableBytes() ([]byte, error) {
+	aatCopy := codec.GetCodec().Clone(a).(*AAT)
+	aatCopy.ApplicationSignature = ""
+	return codec.GetCodec().Marshal(aatCopy)
+}
+
+// The bytes of the relay response that should have been signed by the servicer
+func (r *RelayResponse) SignableBytes() ([]byte, error) {
+	responseCopy := codec.GetCodec().Clone(r).(*RelayResponse)
+	responseCopy.ServicerSignature = ""
+	return codec.GetCodec().Marshal(responseCopy)
+}
@@ -1,6 +1,11 @@
 package types
 
-import "fmt"
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pokt-network/pocket/shared/crypto"
+)
 
 func (s *Signature) ValidateBasic() error {
 	if s.Signature == nil {
@@ -11,3 +16,57 @@ func (s *Signature) ValidateBasic() error {
 	}
 	return nil
 }
+
+// MultisigPublicKey returns the multisig account that the multi signature is for
+func (s *MultiSignature) MultisigPublicKey() (*crypto.MultisigPublicKey, error) {
+	return crypto.NewMultisigPublicKeyFromBytes(s.PublicKeys, s.Threshold)
+}
+
+// NewMultiSignature returns an unsigned multi signature for `multisigPublicKey`, with its public keys in their
+// canonical (i.e. sorted) order
+func NewMultiSignature(multisigPublicKey *crypto.MultisigPublicKey) *MultiSignature {
+	multiSig := &MultiSignature{Threshold: multisigPublicKey.Threshold}
+	for _, publicKey := range multisigPublicKey.PublicKeys {
+		multiSig.PublicKeys = append(multiSig.PublicKeys, publicKey.Bytes())
+	}
+	return multiSig
+}
+
+// Verify checks that `msg` was signed by exactly `threshold` distinct public keys of the multisig account.
+//
+// Since the multi signature is not part of the signable bytes of a transaction, it must be in its canonical form so
+// a signed transaction cannot be re-encoded (e.g. by reordering or dropping signatures) into a transaction with a
+// different hash and replayed: its public keys and its signatures must both be sorted by public key.
+func (s *MultiSignature) Verify(msg []byte) error {
+	multisigPublicKey, err := s.MultisigPublicKey()
+	if err != nil {
+		return err
+	}
+	for i, publicKey := range multisigPublicKey.PublicKeys {
+		if !bytes.Equal(s.PublicKeys[i], publicKey.Bytes()) {
+			return fmt.Errorf("the public keys of the multi signature are not sorted")
+		}
+	}
+	if len(s.Signatures) != int(s.Threshold) {
+		return fmt.Errorf("expected exactly %d signatures, actual %d", s.Threshold, len(s.Signatures))
+	}
+	for i, signature := range s.Signatures {
+		if err := signature.ValidateBasic(); err != nil {
+			return err
+		}
+		publicKey, err := crypto.NewPublicKeyFromBytes(signature.PublicKey)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(signature.PublicKey, publicKey.Bytes()) || !multisigPublicKey.Contains(publicKey) {
+			return fmt.Errorf("public key %s is not part of the multisig account", publicKey.String())
+		}
+		if i > 0 && bytes.Compare(s.Signatures[i-1].PublicKey, signature.PublicKey) >= 0 {
+			return fmt.Errorf("the signatures of the multi signature are not sorted by public key or contain duplicates")
+		}
+		if !publicKey.Verify(msg, signature.Signature) {
+			return fmt.Errorf("signature verification failed for public key %s", publicKey.String())
+		}
+	}
+	return nil
+}
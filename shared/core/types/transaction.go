@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"sort"
 
 	"github.com/pokt-network/pocket/shared/codec"
 	"github.com/pokt-network/pocket/shared/crypto"
@@ -29,6 +30,7 @@ func TxHash(txProtoBz []byte) string {
 // It is unlikely for there to be multiple implementations of this interface in prod.
 type ITransaction interface {
 	GetMessage() (proto.Message, error)
+	GetMessages() ([]proto.Message, error)
 	Sign(privateKey crypto.PrivateKey) error
 	Hash() (string, error)
 	SignableBytes() ([]byte, error)
@@ -43,22 +45,21 @@ func (tx *Transaction) ValidateBasic() error {
 		return fmt.Errorf("nonce cannot be empty") // ErrEmptyNonce
 	}
 
+	// Expiry height must be set to bound the window in which the transaction can be replayed
+	if tx.ExpiryHeight <= 0 {
+		return fmt.Errorf("expiry height must be positive") // ErrInvalidExpiryHeight
+	}
+
 	// Is there a signature we can verify?
-	if tx.Signature == nil {
+	if tx.Signature == nil && tx.MultiSignature == nil {
 		return fmt.Errorf("signature cannot be empty") // ErrEmptySignature
 	}
-	if err := tx.Signature.ValidateBasic(); err != nil {
-		return err
-	}
-
-	// Does the transaction have a valid key?
-	publicKey, err := crypto.NewPublicKeyFromBytes(tx.Signature.PublicKey)
-	if err != nil {
-		return err // ErrEmptyPublicKey or ErrNewPublicKeyFromBytes
+	if tx.Signature != nil && tx.MultiSignature != nil {
+		return fmt.Errorf("a transaction cannot contain both a signature and a multi signature") // ErrBadSignature
 	}
 
-	// Is there a valid msg that can be decoded?
-	if _, err := tx.GetMessage(); err != nil {
+	// Are there valid msgs that can be decoded?
+	if _, err := tx.GetMessages(); err != nil {
 		return err // ? ErrBadMessage
 	}
 
@@ -67,6 +68,21 @@ func (tx *Transaction) ValidateBasic() error {
 		return err // ? ErrBadSignature
 	}
 
+	// Is the multisig account valid and did enough of its keys sign the transaction?
+	if tx.MultiSignature != nil {
+		return tx.MultiSignature.Verify(signBytes)
+	}
+
+	if err := tx.Signature.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// Does the transaction have a valid key?
+	publicKey, err := crypto.NewPublicKeyFromBytes(tx.Signature.PublicKey)
+	if err != nil {
+		return err // ErrEmptyPublicKey or ErrNewPublicKeyFromBytes
+	}
+
 	if ok := publicKey.Verify(signBytes, tx.Signature.Signature); !ok {
 		return fmt.Errorf("signature verification failed") // ErrSignatureVerificationFailed
 	}
@@ -74,6 +90,23 @@ func (tx *Transaction) ValidateBasic() error {
 	return nil
 }
 
+// SignerAddress returns the address of the account that signed the transaction: the address of the public key of
+// its `signature`, or the address of the multisig account of its `multi_signature`
+func (tx *Transaction) SignerAddress() (crypto.Address, error) {
+	if tx.MultiSignature != nil {
+		multisigPublicKey, err := tx.MultiSignature.MultisigPublicKey()
+		if err != nil {
+			return nil, err
+		}
+		return multisigPublicKey.Address(), nil
+	}
+	publicKey, err := crypto.NewPublicKeyFromBytes(tx.GetSignature().GetPublicKey())
+	if err != nil {
+		return nil, err
+	}
+	return publicKey.Address(), nil
+}
+
 func (tx *Transaction) GetMessage() (proto.Message, error) {
 	anyMsg, err := codec.GetCodec().FromAny(tx.Msg)
 	if err != nil {
@@ -82,6 +115,30 @@ func (tx *Transaction) GetMessage() (proto.Message, error) {
 	return anyMsg, nil
 }
 
+// GetMessages returns the messages of the transaction in the order they must be applied: either its
+// single `msg` or its batch of `msgs`
+func (tx *Transaction) GetMessages() ([]proto.Message, error) {
+	if len(tx.Msgs) == 0 {
+		msg, err := tx.GetMessage()
+		if err != nil {
+			return nil, err
+		}
+		return []proto.Message{msg}, nil
+	}
+	if tx.Msg != nil {
+		return nil, fmt.Errorf("a transaction cannot contain both a msg and a batch of msgs") // ErrBadMessage
+	}
+	msgs := make([]proto.Message, len(tx.Msgs))
+	for i, anyMsg := range tx.Msgs {
+		msg, err := codec.GetCodec().FromAny(anyMsg)
+		if err != nil {
+			return nil, err
+		}
+		msgs[i] = msg
+	}
+	return msgs, nil
+}
+
 func (tx *Transaction) Sign(privateKey crypto.PrivateKey) error {
 	txSignableBz, err := tx.SignableBytes()
 	if err != nil {
@@ -98,6 +155,53 @@ func (tx *Transaction) Sign(privateKey crypto.PrivateKey) error {
 	return nil
 }
 
+// CoSign adds the signature of `privateKey` to the multi signature of the transaction, replacing its previous
+// signature if any. The multi signature must already define the public keys and threshold of the multisig account.
+// The signatures are kept sorted by public key, and the transaction cannot be co-signed by more than `threshold` keys.
+func (tx *Transaction) CoSign(privateKey crypto.PrivateKey) error {
+	if tx.Signature != nil {
+		return fmt.Errorf("the transaction is already signed by a single key")
+	}
+	if tx.MultiSignature == nil {
+		return fmt.Errorf("the transaction does not have a multi signature to co-sign")
+	}
+	multisigPublicKey, err := tx.MultiSignature.MultisigPublicKey()
+	if err != nil {
+		return err
+	}
+	publicKey := privateKey.PublicKey()
+	if !multisigPublicKey.Contains(publicKey) {
+		return fmt.Errorf("public key %s is not part of the multisig account", publicKey.String())
+	}
+	txSignableBz, err := tx.SignableBytes()
+	if err != nil {
+		return err
+	}
+	signature, err := privateKey.Sign(txSignableBz)
+	if err != nil {
+		return err
+	}
+
+	signatures := make([]*Signature, 0, len(tx.MultiSignature.Signatures)+1)
+	for _, sig := range tx.MultiSignature.Signatures {
+		if !bytes.Equal(sig.PublicKey, publicKey.Bytes()) {
+			signatures = append(signatures, sig)
+		}
+	}
+	if len(signatures) >= int(tx.MultiSignature.Threshold) {
+		return fmt.Errorf("the transaction already has the %d signatures required", tx.MultiSignature.Threshold)
+	}
+	signatures = append(signatures, &Signature{
+		PublicKey: publicKey.Bytes(),
+		Signature: signature,
+	})
+	sort.Slice(signatures, func(i, j int) bool {
+		return bytes.Compare(signatures[i].PublicKey, signatures[j].PublicKey) < 0
+	})
+	tx.MultiSignature.Signatures = signatures
+	return nil
+}
+
 func (tx *Transaction) Hash() (string, error) {
 	txProtoBz, err := tx.Bytes()
 	if err != nil {
@@ -109,9 +213,10 @@ func (tx *Transaction) Hash() (string, error) {
 // The bytes of the transaction that should have been signed.
 func (tx *Transaction) SignableBytes() ([]byte, error) {
 	// All the contents of the transaction (including the nonce), with the exception of the signature
-	// need to be signed by the signer.
+	// (or multi signature) need to be signed by the signer(s).
 	txCopy := codec.GetCodec().Clone(tx).(*Transaction)
 	txCopy.Signature = nil
+	txCopy.MultiSignature = nil
 	return codec.GetCodec().Marshal(txCopy)
 }
 
@@ -8,6 +8,7 @@ import (
 	"github.com/pokt-network/pocket/shared/crypto"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 )
 
 var (
@@ -59,6 +60,11 @@ func TestTransaction_ValidateBasic(t *testing.T) {
 	err = txNoNonce.ValidateBasic()
 	require.Error(t, err)
 
+	txNoExpiryHeight := proto.Clone(&tx).(*Transaction)
+	txNoExpiryHeight.ExpiryHeight = 0
+	err = txNoExpiryHeight.ValidateBasic()
+	require.Error(t, err)
+
 	txInvalidMessageAny := proto.Clone(&tx).(*Transaction)
 	txInvalidMessageAny.Msg = nil
 	err = txInvalidMessageAny.ValidateBasic()
@@ -86,13 +92,149 @@ func TestTransaction_ValidateBasic(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestTransaction_GetMessages(t *testing.T) {
+	tx := newUnsignedTestingTransaction(t)
+	msgs, err := tx.GetMessages()
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+
+	txBatch := proto.Clone(&tx).(*Transaction)
+	txBatch.Msgs = []*anypb.Any{tx.Msg, tx.Msg, tx.Msg}
+	txBatch.Msg = nil
+	msgs, err = txBatch.GetMessages()
+	require.NoError(t, err)
+	require.Len(t, msgs, 3)
+
+	require.NoError(t, txBatch.Sign(testingSenderPrivateKey))
+	require.NoError(t, txBatch.ValidateBasic())
+
+	// A transaction cannot contain both a single message and a batch of messages
+	txBoth := proto.Clone(txBatch).(*Transaction)
+	txBoth.Msg = tx.Msg
+	_, err = txBoth.GetMessages()
+	require.Error(t, err)
+
+	txInvalidBatch := proto.Clone(txBatch).(*Transaction)
+	txInvalidBatch.Msgs[1] = &anypb.Any{}
+	_, err = txInvalidBatch.GetMessages()
+	require.Error(t, err)
+}
+
+func TestTransaction_MultiSignature(t *testing.T) {
+	privateKeys := make([]crypto.PrivateKey, 3)
+	publicKeysBz := make([][]byte, 3)
+	for i := range privateKeys {
+		privateKey, err := crypto.GeneratePrivateKey()
+		require.NoError(t, err)
+		privateKeys[i] = privateKey
+		publicKeysBz[i] = privateKey.PublicKey().Bytes()
+	}
+
+	multisigPublicKey, err := crypto.NewMultisigPublicKeyFromBytes(publicKeysBz, 2)
+	require.NoError(t, err)
+
+	tx := newUnsignedTestingTransaction(t)
+	tx.MultiSignature = NewMultiSignature(multisigPublicKey)
+
+	// Not enough co-signers
+	require.NoError(t, tx.CoSign(privateKeys[0]))
+	require.Error(t, tx.ValidateBasic())
+
+	// Co-signing twice with the same key does not count twice
+	require.NoError(t, tx.CoSign(privateKeys[0]))
+	require.Len(t, tx.MultiSignature.Signatures, 1)
+	require.Error(t, tx.ValidateBasic())
+
+	require.NoError(t, tx.CoSign(privateKeys[2]))
+	require.NoError(t, tx.ValidateBasic())
+
+	signerAddr, err := tx.SignerAddress()
+	require.NoError(t, err)
+	require.Equal(t, multisigPublicKey.Address(), signerAddr)
+
+	// Keys outside of the multisig account cannot co-sign
+	require.Error(t, tx.CoSign(testingSenderPrivateKey))
+
+	// Co-signing beyond the threshold would make the transaction invalid
+	txExtraCoSigner := proto.Clone(&tx).(*Transaction)
+	require.Error(t, txExtraCoSigner.CoSign(privateKeys[1]))
+
+	// The signatures cannot be reordered, nor can extra ones be added, to replay the transaction with another hash
+	txReorderedSigs := proto.Clone(&tx).(*Transaction)
+	sigs := txReorderedSigs.MultiSignature.Signatures
+	sigs[0], sigs[1] = sigs[1], sigs[0]
+	require.Error(t, txReorderedSigs.ValidateBasic())
+
+	txExtraSig := proto.Clone(&tx).(*Transaction)
+	extraSigner := newUnsignedTestingTransaction(t)
+	extraSigner.MultiSignature = NewMultiSignature(multisigPublicKey)
+	require.NoError(t, extraSigner.CoSign(privateKeys[1]))
+	txExtraSig.MultiSignature.Signatures = append(txExtraSig.MultiSignature.Signatures, extraSigner.MultiSignature.Signatures...)
+	require.Error(t, txExtraSig.ValidateBasic())
+
+	// The public keys of the multisig account must be sorted too
+	txReorderedPubKeys := proto.Clone(&tx).(*Transaction)
+	pubKeys := txReorderedPubKeys.MultiSignature.PublicKeys
+	pubKeys[0], pubKeys[2] = pubKeys[2], pubKeys[0]
+	require.Error(t, txReorderedPubKeys.ValidateBasic())
+
+	txTamperedSig := proto.Clone(&tx).(*Transaction)
+	txTamperedSig.MultiSignature.Signatures[1].Signature = []byte("signature")
+	require.Error(t, txTamperedSig.ValidateBasic())
+
+	txDuplicateSig := proto.Clone(&tx).(*Transaction)
+	txDuplicateSig.MultiSignature.Signatures[1] = txDuplicateSig.MultiSignature.Signatures[0]
+	require.Error(t, txDuplicateSig.ValidateBasic())
+
+	txBothSigs := proto.Clone(&tx).(*Transaction)
+	require.NoError(t, txBothSigs.Sign(testingSenderPrivateKey))
+	require.Error(t, txBothSigs.ValidateBasic())
+
+	txNoMultiSig := newUnsignedTestingTransaction(t)
+	require.Error(t, txNoMultiSig.CoSign(privateKeys[0]))
+
+	// A transaction signed by a single key cannot be co-signed
+	txSingleSig := newUnsignedTestingTransaction(t)
+	require.NoError(t, txSingleSig.Sign(testingSenderPrivateKey))
+	txSingleSig.MultiSignature = NewMultiSignature(multisigPublicKey)
+	require.Error(t, txSingleSig.CoSign(privateKeys[0]))
+}
+
+func TestMultisigPublicKey_Address(t *testing.T) {
+	publicKeys := make([]crypto.PublicKey, 3)
+	for i := range publicKeys {
+		publicKey, err := crypto.GeneratePublicKey()
+		require.NoError(t, err)
+		publicKeys[i] = publicKey
+	}
+	reversed := []crypto.PublicKey{publicKeys[2], publicKeys[1], publicKeys[0]}
+
+	multisigPublicKey, err := crypto.NewMultisigPublicKey(publicKeys, 2)
+	require.NoError(t, err)
+	reversedMultisigPublicKey, err := crypto.NewMultisigPublicKey(reversed, 2)
+	require.NoError(t, err)
+	require.Equal(t, multisigPublicKey.Address(), reversedMultisigPublicKey.Address())
+
+	otherThreshold, err := crypto.NewMultisigPublicKey(publicKeys, 3)
+	require.NoError(t, err)
+	require.NotEqual(t, multisigPublicKey.Address(), otherThreshold.Address())
+
+	_, err = crypto.NewMultisigPublicKey(publicKeys, 0)
+	require.Error(t, err)
+	_, err = crypto.NewMultisigPublicKey(publicKeys, 4)
+	require.Error(t, err)
+	_, err = crypto.NewMultisigPublicKey(append(publicKeys, publicKeys[0]), 2)
+	require.Error(t, err)
+}
+
 func newUnsignedTestingTransaction(t *testing.T) Transaction {
 	txMsg := &Transaction{}
 	anyMsg, err := codec.GetCodec().ToAny(txMsg)
 	require.NoError(t, err)
 
 	return Transaction{
-		Msg:   anyMsg,
-		Nonce: fmt.Sprint(crypto.GetNonce()),
+		Msg:          anyMsg,
+		Nonce:        fmt.Sprint(crypto.GetNonce()),
+		ExpiryHeight: 1,
 	}
 }
@@ -6,15 +6,17 @@ import (
 )
 
 const (
-	InvalidAddressLenError        = "the address length is not valid"
-	InvalidHashLenError           = "the hash length is not valid"
-	CreateAddressError            = "an error occurred creating the address"
-	InvalidPrivateKeyLenError     = "the private key length is not valid"
-	InvalidPrivateKeySeedLenError = "the seed is too short to create a private key"
-	CreatePrivateKeyError         = "an error occurred creating the private key"
-	InvalidPublicKeyLenError      = "the public key length is not valid"
-	CreatePublicKeyError          = "an error occurred creating the public key"
-	decodePrivateKeyError         = "decoding private key"
+	InvalidAddressLenError          = "the address length is not valid"
+	InvalidHashLenError             = "the hash length is not valid"
+	CreateAddressError              = "an error occurred creating the address"
+	InvalidPrivateKeyLenError       = "the private key length is not valid"
+	InvalidPrivateKeySeedLenError   = "the seed is too short to create a private key"
+	CreatePrivateKeyError           = "an error occurred creating the private key"
+	InvalidPublicKeyLenError        = "the public key length is not valid"
+	CreatePublicKeyError            = "an error occurred creating the public key"
+	InvalidMultisigThresholdError   = "the multisig threshold is not valid"
+	DuplicateMultisigPublicKeyError = "the multisig public keys must be unique"
+	decodePrivateKeyError           = "decoding private key"
 )
 
 func ErrInvalidAddressLen(length int) error {
@@ -49,6 +51,14 @@ func ErrCreatePublicKey(err error) error {
 	return fmt.Errorf("%s; %s", CreatePublicKeyError, err.Error())
 }
 
+func ErrInvalidMultisigThreshold(threshold uint32, numPublicKeys int) error {
+	return fmt.Errorf("%s, expected a threshold between 1 and %d, actual threshold %d", InvalidMultisigThresholdError, numPublicKeys, threshold)
+}
+
+func ErrDuplicateMultisigPublicKey(publicKey string) error {
+	return fmt.Errorf("%s, duplicate public key %s", DuplicateMultisigPublicKeyError, publicKey)
+}
+
 func errDecodePrivateKey(err error) error {
 	return fmt.Errorf("%s; %w", decodePrivateKeyError, err)
 }
@@ -0,0 +1,71 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+)
+
+// multisigAddressPrefix separates the addresses of multisig accounts from the addresses of single public keys
+const multisigAddressPrefix = "multisig"
+
+// MultisigPublicKey identifies a multisig account, which is controlled by any `Threshold` of its `PublicKeys`
+type MultisigPublicKey struct {
+	PublicKeys []PublicKey
+	Threshold  uint32
+}
+
+// NewMultisigPublicKey returns the multisig account controlled by any `threshold` of `publicKeys`. The public keys
+// are sorted so the address of the account does not depend on the order they are provided in.
+func NewMultisigPublicKey(publicKeys []PublicKey, threshold uint32) (*MultisigPublicKey, error) {
+	if threshold == 0 || int(threshold) > len(publicKeys) {
+		return nil, ErrInvalidMultisigThreshold(threshold, len(publicKeys))
+	}
+	sortedPublicKeys := make([]PublicKey, len(publicKeys))
+	copy(sortedPublicKeys, publicKeys)
+	sort.Slice(sortedPublicKeys, func(i, j int) bool {
+		return bytes.Compare(sortedPublicKeys[i].Bytes(), sortedPublicKeys[j].Bytes()) < 0
+	})
+	for i := 1; i < len(sortedPublicKeys); i++ {
+		if sortedPublicKeys[i].Equals(sortedPublicKeys[i-1]) {
+			return nil, ErrDuplicateMultisigPublicKey(sortedPublicKeys[i].String())
+		}
+	}
+	return &MultisigPublicKey{PublicKeys: sortedPublicKeys, Threshold: threshold}, nil
+}
+
+// NewMultisigPublicKeyFromBytes is a convenience wrapper around `NewMultisigPublicKey` for serialized public keys
+func NewMultisigPublicKeyFromBytes(publicKeysBz [][]byte, threshold uint32) (*MultisigPublicKey, error) {
+	publicKeys := make([]PublicKey, len(publicKeysBz))
+	for i, publicKeyBz := range publicKeysBz {
+		publicKey, err := NewPublicKeyFromBytes(publicKeyBz)
+		if err != nil {
+			return nil, err
+		}
+		publicKeys[i] = publicKey
+	}
+	return NewMultisigPublicKey(publicKeys, threshold)
+}
+
+// Address is the deterministic address of the multisig account, derived from its threshold and sorted public keys
+func (m *MultisigPublicKey) Address() Address {
+	thresholdBz := make([]byte, 4)
+	binary.BigEndian.PutUint32(thresholdBz, m.Threshold)
+	bz := append([]byte(multisigAddressPrefix), thresholdBz...)
+	for _, publicKey := range m.PublicKeys {
+		bz = append(bz, publicKey.Bytes()...)
+	}
+	hash := sha256.Sum256(bz)
+	return hash[:AddressLen]
+}
+
+// Contains returns whether `publicKey` is one of the public keys of the multisig account
+func (m *MultisigPublicKey) Contains(publicKey PublicKey) bool {
+	for _, pk := range m.PublicKeys {
+		if pk.Equals(publicKey) {
+			return true
+		}
+	}
+	return false
+}
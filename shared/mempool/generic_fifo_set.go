@@ -105,6 +105,18 @@ func (g *GenericFIFOSet[TIdx, TData]) Len() int {
 	return g.queue.Len()
 }
 
+// GetAll returns all the items in the set, oldest first
+func (g *GenericFIFOSet[TIdx, TData]) GetAll() []TData {
+	g.m.Lock()
+	defer g.m.Unlock()
+
+	items := make([]TData, 0, g.queue.Len())
+	for e := g.queue.Front(); e != nil; e = e.Next() {
+		items = append(items, e.Value.(TData))
+	}
+	return items
+}
+
 func (g *GenericFIFOSet[TIdx, TData]) Clear() {
 	g.m.Lock()
 	defer g.m.Unlock()
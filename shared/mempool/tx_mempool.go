@@ -10,4 +10,5 @@ type TXMempool interface {
 	IsEmpty() bool
 	TxsBytesTotal() uint64 // Returns the total sum of all transactions' sizes (in bytes) stored in the mempool
 	PopTx() (tx []byte, err error)
+	GetAll() [][]byte // Returns all the transactions stored in the mempool, without removing them
 }
@@ -19,6 +19,10 @@ type PersistenceModule interface {
 	NewRWContext(height int64) (PersistenceRWContext, error)
 	NewReadContext(height int64) (PersistenceReadContext, error)
 	ReleaseWriteContext() error // The module can maintain many read contexts, but only one write context can exist at a time
+	// NewSimulationContext returns a read-write context whose changes are never persisted, to apply state transitions
+	// (e.g. transactions) without affecting the write context. It can neither be committed nor compute a state hash,
+	// and only one can exist at a time. It may briefly hold row locks the write context has to wait for.
+	NewSimulationContext(height int64) (PersistenceRWContext, error)
 
 	// BlockStore operations
 	GetBlockStore() kvstore.KVStore
@@ -84,27 +88,28 @@ type PersistenceWriteContext interface {
 	// Account Operations
 	AddAccountAmount(address []byte, amount string) error
 	SubtractAccountAmount(address []byte, amount string) error
-	SetAccountAmount(address []byte, amount string) error // NOTE: same as (insert)
+	SetAccountAmount(address []byte, amount string) error         // NOTE: same as (insert)
+	SetVestingSchedule(schedule *coreTypes.VestingSchedule) error // NOTE: replaces the schedule of the account, if any
 
 	// App Operations
-	InsertApp(address []byte, publicKey []byte, output []byte, paused bool, status int32, stakedTokens string, chains []string, pausedHeight int64, unstakingHeight int64) error
-	UpdateApp(address []byte, amount string, chainsToUpdate []string) error
+	InsertApp(address []byte, publicKey []byte, output []byte, paused bool, status int32, stakedTokens string, chains []string, geoZone string, pausedHeight int64, unstakingHeight int64) error
+	UpdateApp(address []byte, amount string, chainsToUpdate []string, geoZone string) error
 	SetAppStakeAmount(address []byte, stakeAmount string) error
 	SetAppUnstakingHeightAndStatus(address []byte, unstakingHeight int64, status int32) error
 	SetAppStatusAndUnstakingHeightIfPausedBefore(pausedBeforeHeight, unstakingHeight int64, status int32) error
 	SetAppPauseHeight(address []byte, height int64) error
 
 	// Servicer Operations
-	InsertServicer(address []byte, publicKey []byte, output []byte, paused bool, status int32, serviceURL string, stakedTokens string, chains []string, pausedHeight int64, unstakingHeight int64) error
-	UpdateServicer(address []byte, serviceURL string, amount string, chains []string) error
+	InsertServicer(address []byte, publicKey []byte, output []byte, paused bool, status int32, serviceURL string, stakedTokens string, chains []string, geoZone string, pausedHeight int64, unstakingHeight int64) error
+	UpdateServicer(address []byte, serviceURL string, amount string, chains []string, geoZone string) error
 	SetServicerStakeAmount(address []byte, stakeAmount string) error
 	SetServicerUnstakingHeightAndStatus(address []byte, unstakingHeight int64, status int32) error
 	SetServicerStatusAndUnstakingHeightIfPausedBefore(pausedBeforeHeight, unstakingHeight int64, status int32) error
 	SetServicerPauseHeight(address []byte, height int64) error
 
 	// Fisherman Operations
-	InsertFisherman(address []byte, publicKey []byte, output []byte, paused bool, status int32, serviceURL string, stakedTokens string, chains []string, pausedHeight int64, unstakingHeight int64) error
-	UpdateFisherman(address []byte, serviceURL string, amount string, chains []string) error
+	InsertFisherman(address []byte, publicKey []byte, output []byte, paused bool, status int32, serviceURL string, stakedTokens string, chains []string, geoZone string, pausedHeight int64, unstakingHeight int64) error
+	UpdateFisherman(address []byte, serviceURL string, amount string, chains []string, geoZone string) error
 	SetFishermanStakeAmount(address []byte, stakeAmount string) error
 	SetFishermanUnstakingHeightAndStatus(address []byte, unstakingHeight int64, status int32) error
 	SetFishermanStatusAndUnstakingHeightIfPausedBefore(pausedBeforeHeight, unstakingHeight int64, status int32) error
@@ -126,6 +131,32 @@ type PersistenceWriteContext interface {
 	// Flag Operations
 	InitFlags() error
 	SetFlag(paramName string, value any, enabled bool) error
+
+	// Claim Operations
+	InsertClaim(claim *coreTypes.Claim) error
+	DeleteClaim(servicerAddr []byte, sessionID []byte) error // NOTE: no-op if the claim does not exist
+
+	// Test Score Operations
+	InsertTestScore(testScore *coreTypes.TestScore) error                              // NOTE: also used to mark a test score as proven
+	DeleteTestScore(fishermanAddr []byte, servicerAddr []byte, sessionID []byte) error // NOTE: no-op if the test score does not exist
+
+	// Double Sign Evidence Operations
+	InsertDoubleSignEvidence(validatorAddr []byte, evidenceHeight int64) error
+
+	// Delegation Operations
+	SetDelegation(delegation *coreTypes.Delegation) error              // NOTE: same as (insert)
+	DeleteDelegation(delegatorAddr []byte, validatorAddr []byte) error // NOTE: no-op if the delegation does not exist
+
+	// Governance Proposal Operations
+	InsertProposal(proposal *coreTypes.Proposal) error // NOTE: also used to update the status of a proposal
+	InsertVote(vote *coreTypes.Vote) error             // NOTE: replaces the previous vote of the validator on the proposal
+
+	// Upgrade Plan Operations
+	SetUpgradePlan(plan *coreTypes.UpgradePlan) error // NOTE: replaces the upgrade plan previously scheduled, if any, e.g. to mark it as applied
+
+	// Actors Operations
+	// Moves the actor to a new operator key, retiring the old operator (i.e. left unstaked without any stake)
+	RotateActorOperator(actorType coreTypes.ActorType, oldAddress []byte, newAddress []byte, newPublicKey []byte) error
 }
 
 type PersistenceReadContext interface {
@@ -150,6 +181,8 @@ type PersistenceReadContext interface {
 	// Returns "0" if the account does not exist
 	GetAccountAmount(address []byte, height int64) (string, error)
 	GetAllAccounts(height int64) ([]*coreTypes.Account, error)
+	// Returns nil if the account does not have a vesting schedule
+	GetVestingSchedule(address []byte, height int64) (*coreTypes.VestingSchedule, error)
 
 	// App Queries
 	GetAllApps(height int64) ([]*coreTypes.Actor, error)
@@ -191,6 +224,10 @@ type PersistenceReadContext interface {
 
 	// Actors Queries
 	GetAllStakedActors(height int64) ([]*coreTypes.Actor, error)
+	GetActor(actorType coreTypes.ActorType, address []byte, height int64) (*coreTypes.Actor, error)
+	// Returns the current operator of the actor that used the operator `address`, following its key rotations up to
+	// `height`, or `address` itself if it was never rotated
+	GetCurrentOperator(actorType coreTypes.ActorType, address []byte, height int64) ([]byte, error)
 
 	// Params
 	GetIntParam(paramName string, height int64) (int, error)
@@ -202,4 +239,35 @@ type PersistenceReadContext interface {
 	GetIntFlag(paramName string, height int64) (int, bool, error)
 	GetStringFlag(paramName string, height int64) (string, bool, error)
 	GetBytesFlag(paramName string, height int64) ([]byte, bool, error)
+
+	// Claim Queries
+	// Returns nil if the claim does not exist (i.e. was never submitted, or was already proven or expired)
+	GetClaim(servicerAddr []byte, sessionID []byte, height int64) (*coreTypes.Claim, error)
+	GetAllClaims(height int64) ([]*coreTypes.Claim, error)
+
+	// Test Score Queries
+	// Returns nil if the test score does not exist (i.e. was never submitted, or already expired)
+	GetTestScore(fishermanAddr []byte, servicerAddr []byte, sessionID []byte, height int64) (*coreTypes.TestScore, error)
+	GetAllTestScores(height int64) ([]*coreTypes.TestScore, error)
+
+	// Double Sign Evidence Queries
+	// Returns whether the validator was already punished for double signing at `evidenceHeight`
+	DoubleSignEvidenceExists(validatorAddr []byte, evidenceHeight int64, height int64) (bool, error)
+
+	// Delegation Queries
+	// Returns nil if the delegation does not exist (i.e. was never created, or was already unbonded)
+	GetDelegation(delegatorAddr []byte, validatorAddr []byte, height int64) (*coreTypes.Delegation, error)
+	GetValidatorDelegations(validatorAddr []byte, height int64) ([]*coreTypes.Delegation, error) // Ordered by delegator address
+	GetDelegationsReadyToUnbond(height int64) ([]*coreTypes.Delegation, error)
+
+	// Governance Proposal Queries
+	// Returns nil if the proposal does not exist
+	GetProposal(id uint64, height int64) (*coreTypes.Proposal, error)
+	GetLatestProposalID(height int64) (uint64, error)                            // Returns 0 if no proposal was submitted yet
+	GetProposalsEndingAtHeight(height int64) ([]*coreTypes.Proposal, error)      // Proposals still being voted on, ordered by id
+	GetProposalVotes(proposalID uint64, height int64) ([]*coreTypes.Vote, error) // Ordered by validator address
+
+	// Upgrade Plan Queries
+	// Returns the latest upgrade plan scheduled at or before `height`, or nil if no upgrade was ever scheduled
+	GetUpgradePlan(height int64) (*coreTypes.UpgradePlan, error)
 }
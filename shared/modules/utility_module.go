@@ -3,6 +3,9 @@ package modules
 //go:generate mockgen -source=$GOFILE -destination=./mocks/utility_module_mock.go -aux_files=github.com/pokt-network/pocket/shared/modules=module.go
 
 import (
+	"math/big"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"github.com/pokt-network/pocket/shared/mempool"
 	"google.golang.org/protobuf/types/known/anypb"
 )
@@ -22,6 +25,10 @@ type UtilityModule interface {
 	// HandleTransaction does basic `Transaction` validation & adds it to the utility's module mempool if valid
 	HandleTransaction(tx []byte) error
 
+	// SimulateTransaction applies `tx` on top of the last committed state and discards the changes, returning the
+	// result the transaction would have if it was included in the next block, along with the fee it would cost
+	SimulateTransaction(tx []byte) (txResult TxResult, fee string, err error)
+
 	// GetMempool returns the utility module's mempool of transactions gossiped throughout the network
 	GetMempool() mempool.TXMempool
 
@@ -29,6 +36,19 @@ type UtilityModule interface {
 	// It is useful for handling messages from the utility module's of other nodes that do not directly affect the state.
 	// IMPROVE: Find opportunities to break this apart as the module matures.
 	HandleUtilityMessage(*anypb.Any) error
+
+	// HandleEvent handles the events published on the bus that the utility module reacts to, such as rechecking
+	// the transactions in the mempool when a new block is committed
+	HandleEvent(*anypb.Any) error
+
+	// HandleRelay validates and executes a relay sent by a client to this node, acting as a servicer, and
+	// returns the response of the relay chain signed by the servicer
+	HandleRelay(relay *coreTypes.Relay) (*coreTypes.RelayResponse, error)
+
+	// GetRelayBudget returns the number of relays the application with public key `appPublicKey` can send to this
+	// node, acting as a servicer, during its current session for `relayChain` and `geoZone`, along with the number of
+	// relays it can still send
+	GetRelayBudget(appPublicKey, relayChain, geoZone string) (maxRelays, remainingRelays *big.Int, err error)
 }
 
 // TECHDEBT: Remove this interface from `shared/modules` and use the `Actor` protobuf type instead
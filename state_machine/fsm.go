@@ -63,6 +63,17 @@ func NewNodeFSM(callbacks *fsm.Callbacks, options ...func(*fsm.FSM)) *fsm.FSM {
 				},
 				Dst: string(coreTypes.StateMachineState_Consensus_Unsynced),
 			},
+			{
+				Name: string(coreTypes.StateMachineEvent_Consensus_IsHalted),
+				Src: []string{
+					string(coreTypes.StateMachineState_P2P_Bootstrapped),
+					string(coreTypes.StateMachineState_Consensus_Unsynced),
+					string(coreTypes.StateMachineState_Consensus_SyncMode),
+					string(coreTypes.StateMachineState_Consensus_Synced),
+					string(coreTypes.StateMachineState_Consensus_Pacemaker),
+				},
+				Dst: string(coreTypes.StateMachineState_Consensus_Halted),
+			},
 		},
 		cb,
 	)
@@ -0,0 +1,32 @@
+package utility
+
+import (
+	"fmt"
+
+	"github.com/pokt-network/pocket/shared/codec"
+	"github.com/pokt-network/pocket/shared/messaging"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// HandleEvent implements the exposed functionality of the shared utilityModule interface.
+func (u *utilityModule) HandleEvent(event *anypb.Any) error {
+	evt, err := codec.GetCodec().FromAny(event)
+	if err != nil {
+		return err
+	}
+
+	switch event.MessageName() {
+	case messaging.ConsensusNewHeightEventType:
+		consensusNewHeightEvent, ok := evt.(*messaging.ConsensusNewHeightEvent)
+		if !ok {
+			return fmt.Errorf("failed to cast event to ConsensusNewHeightEvent")
+		}
+		if err := u.recheckMempool(consensusNewHeightEvent.Height); err != nil {
+			return err
+		}
+		return u.handleServicerNewHeight(consensusNewHeightEvent.Height)
+
+	default:
+		return fmt.Errorf("unsupported event type: %s", event.MessageName())
+	}
+}
@@ -0,0 +1,303 @@
+package utility
+
+import (
+	"math/big"
+
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/mempool"
+	"github.com/pokt-network/pocket/shared/modules"
+	"github.com/pokt-network/pocket/shared/utils"
+	utilityTelemetry "github.com/pokt-network/pocket/utility/telemetry"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"github.com/pokt-network/pocket/utility/unit_of_work"
+)
+
+const (
+	// mempoolRecheckMaxTxs bounds the number of transactions rechecked after a new block
+	mempoolRecheckMaxTxs = 1000
+	// mempoolRecheckMaxBlocks bounds the number of blocks whose transactions are loaded by a recheck, e.g. for the
+	// first recheck after the node started
+	mempoolRecheckMaxBlocks = 100
+)
+
+// mempoolEvictions counts the transactions evicted from the mempool by a recheck, by reason
+type mempoolEvictions struct {
+	rechecked         int
+	committed         int
+	expired           int
+	insufficientFunds int
+	nonceReused       int
+	invalid           int
+}
+
+func (e *mempoolEvictions) total() int {
+	return e.committed + e.expired + e.insufficientFunds + e.nonceReused + e.invalid
+}
+
+// mempoolRecheckCursor rotates the mempool rechecks through all the transactions of the mempool: at most
+// `mempoolRecheckMaxTxs` transactions are rechecked after each block, starting with those not rechecked yet during the
+// current rotation, and a new rotation starts once all the transactions of the mempool were rechecked.
+type mempoolRecheckCursor struct {
+	// The hashes of the transactions rechecked during the current rotation
+	rechecked map[string]struct{}
+	// The keys, as returned by `txNonceKey`, of the transactions committed during the current and previous rotations.
+	// A transaction rechecked during the current rotation was rechecked, or added to the mempool, after the previous
+	// rotation started, so it can only reuse the nonces committed since.
+	committedNonces     map[string]struct{}
+	prevCommittedNonces map[string]struct{}
+}
+
+// addCommittedNonces records the nonces committed since the last recheck
+func (c *mempoolRecheckCursor) addCommittedNonces(committedNonces map[string]struct{}) {
+	if c.committedNonces == nil {
+		c.committedNonces = make(map[string]struct{}, len(committedNonces))
+	}
+	for key := range committedNonces {
+		c.committedNonces[key] = struct{}{}
+	}
+}
+
+// isNonceCommitted returns whether a transaction with the `txNonceKey` key was committed since the previous rotation started
+func (c *mempoolRecheckCursor) isNonceCommitted(key string) bool {
+	if _, ok := c.committedNonces[key]; ok {
+		return true
+	}
+	_, ok := c.prevCommittedNonces[key]
+	return ok
+}
+
+// nextTxs returns the next (up to `mempoolRecheckMaxTxs`) transactions of `txs` to recheck, in their order in the
+// mempool, and records them as rechecked
+func (c *mempoolRecheckCursor) nextTxs(txs [][]byte) [][]byte {
+	next := c.selectTxs(txs)
+	if len(next) == 0 {
+		// all the transactions of the mempool were rechecked during the current rotation
+		c.rechecked = nil
+		c.prevCommittedNonces = c.committedNonces
+		c.committedNonces = nil
+		next = c.selectTxs(txs)
+	}
+	if c.rechecked == nil {
+		c.rechecked = make(map[string]struct{}, len(next))
+	}
+	for _, txBz := range next {
+		c.rechecked[coreTypes.TxHash(txBz)] = struct{}{}
+	}
+	return next
+}
+
+func (c *mempoolRecheckCursor) selectTxs(txs [][]byte) [][]byte {
+	next := make([][]byte, 0, mempoolRecheckMaxTxs)
+	for _, txBz := range txs {
+		if len(next) == mempoolRecheckMaxTxs {
+			break
+		}
+		if _, ok := c.rechecked[coreTypes.TxHash(txBz)]; !ok {
+			next = append(next, txBz)
+		}
+	}
+	return next
+}
+
+// reset discards the state of the rotation, e.g. once the mempool is empty: the transactions added to the mempool
+// afterwards can only reuse the nonces committed after they were added
+func (c *mempoolRecheckCursor) reset() {
+	*c = mempoolRecheckCursor{}
+}
+
+// recheckMempool evicts the transactions of the mempool that became invalid with the blocks committed since the last
+// recheck, up to the block preceding `height`
+func (u *utilityModule) recheckMempool(height uint64) error {
+	if height == 0 {
+		return nil
+	}
+	committedHeight := int64(height) - 1
+	if committedHeight <= u.mempoolRecheckedHeight {
+		return nil
+	}
+	fromHeight := u.mempoolRecheckedHeight + 1
+	if minHeight := committedHeight - mempoolRecheckMaxBlocks + 1; fromHeight < minHeight {
+		fromHeight = minHeight
+	}
+	u.mempoolRecheckedHeight = committedHeight
+	if u.mempool.IsEmpty() {
+		u.mempoolRecheckCursor.reset()
+		return nil
+	}
+
+	persistenceModule := u.GetBus().GetPersistenceModule()
+	readCtx, err := persistenceModule.NewReadContext(committedHeight)
+	if err != nil {
+		return err
+	}
+	defer readCtx.Release()
+
+	committedNonces, err := getCommittedTxNonces(persistenceModule, fromHeight, committedHeight)
+	if err != nil {
+		return err
+	}
+	u.mempoolRecheckCursor.addCommittedNonces(committedNonces)
+
+	evictions, err := recheckMempoolTxs(u.mempool, persistenceModule, readCtx, committedHeight, &u.mempoolRecheckCursor)
+	if err != nil {
+		return err
+	}
+
+	u.logger.Info().
+		Int64("height", committedHeight).
+		Int("rechecked", evictions.rechecked).
+		Int("evicted", evictions.total()).
+		Msg("Rechecked the mempool")
+
+	telemetryMod := u.GetBus().GetTelemetryModule()
+	for i := 0; i < evictions.total(); i++ {
+		telemetryMod.GetTimeSeriesAgent().CounterIncrement(utilityTelemetry.MEMPOOL_EVICTED_TXS_COUNTER_NAME)
+	}
+	telemetryMod.GetEventMetricsAgent().EmitEvent(
+		utilityTelemetry.UTILITY_EVENT_METRICS_NAMESPACE,
+		utilityTelemetry.MEMPOOL_RECHECK_EVENT_METRIC_NAME,
+		utilityTelemetry.MEMPOOL_RECHECK_EVENT_METRIC_LABEL_HEIGHT, committedHeight,
+		utilityTelemetry.MEMPOOL_RECHECK_EVENT_METRIC_LABEL_RECHECKED, evictions.rechecked,
+		utilityTelemetry.MEMPOOL_RECHECK_EVENT_METRIC_LABEL_EVICTED_COMMITTED, evictions.committed,
+		utilityTelemetry.MEMPOOL_RECHECK_EVENT_METRIC_LABEL_EVICTED_EXPIRED, evictions.expired,
+		utilityTelemetry.MEMPOOL_RECHECK_EVENT_METRIC_LABEL_EVICTED_INSUFFICIENT, evictions.insufficientFunds,
+		utilityTelemetry.MEMPOOL_RECHECK_EVENT_METRIC_LABEL_EVICTED_NONCE_REUSED, evictions.nonceReused,
+		utilityTelemetry.MEMPOOL_RECHECK_EVENT_METRIC_LABEL_EVICTED_INVALID, evictions.invalid,
+	)
+
+	return nil
+}
+
+// recheckMempoolTxs runs cheap checks on the next (up to `mempoolRecheckMaxTxs`) transactions of `txMempool` selected
+// by `cursor` against the state at `height`, evicting the ones that:
+// - were already committed
+// - expired, i.e. cannot be included in the block following `height`
+// - reuse the nonce of a transaction of the same signer committed since they were last rechecked
+// - cannot be paid for by their signer, taking into account the fees of its previous transactions rechecked with them
+func recheckMempoolTxs(
+	txMempool mempool.TXMempool,
+	persistenceModule modules.PersistenceModule,
+	readCtx modules.PersistenceReadContext,
+	height int64,
+	cursor *mempoolRecheckCursor,
+) (*mempoolEvictions, error) {
+	txs := cursor.nextTxs(txMempool.GetAll())
+
+	evictions := &mempoolEvictions{rechecked: len(txs)}
+	// The funds left to each signer once the fees of its transactions already rechecked are paid
+	signersFunds := make(map[string]*big.Int)
+	for _, txBz := range txs {
+		evicted, err := recheckMempoolTx(txBz, persistenceModule, readCtx, height, cursor, signersFunds, evictions)
+		if err != nil {
+			return nil, err
+		}
+		if evicted {
+			if err := txMempool.RemoveTx(txBz); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return evictions, nil
+}
+
+// recheckMempoolTx returns whether `txBz` should be evicted from the mempool, counting it in `evictions` if so
+func recheckMempoolTx(
+	txBz []byte,
+	persistenceModule modules.PersistenceModule,
+	readCtx modules.PersistenceReadContext,
+	height int64,
+	cursor *mempoolRecheckCursor,
+	signersFunds map[string]*big.Int,
+	evictions *mempoolEvictions,
+) (bool, error) {
+	txExists, err := persistenceModule.TransactionExists(coreTypes.TxHash(txBz))
+	if err != nil {
+		return false, err
+	}
+	if txExists {
+		evictions.committed++
+		return true, nil
+	}
+
+	tx, err := coreTypes.TxFromBytes(txBz)
+	if err != nil {
+		evictions.invalid++
+		return true, nil
+	}
+	signer, err := tx.SignerAddress()
+	if err != nil {
+		evictions.invalid++
+		return true, nil
+	}
+
+	if tx.ExpiryHeight <= height {
+		evictions.expired++
+		return true, nil
+	}
+
+	if cursor.isNonceCommitted(txNonceKey(signer, tx.Nonce)) {
+		evictions.nonceReused++
+		return true, nil
+	}
+
+	fee, er := unit_of_work.GetTxFee(readCtx, height, tx)
+	if er != nil {
+		if er.Code() == typesUtil.CodeDecodeMessageError {
+			evictions.invalid++
+			return true, nil
+		}
+		return false, er
+	}
+	funds, ok := signersFunds[signer.String()]
+	if !ok {
+		amount, err := readCtx.GetAccountAmount(signer, height)
+		if err != nil {
+			return false, err
+		}
+		funds, err = utils.StringToBigInt(amount)
+		if err != nil {
+			return false, err
+		}
+	}
+	if funds.Cmp(fee) < 0 {
+		signersFunds[signer.String()] = funds
+		evictions.insufficientFunds++
+		return true, nil
+	}
+	signersFunds[signer.String()] = new(big.Int).Sub(funds, fee)
+	return false, nil
+}
+
+// getCommittedTxNonces returns the keys, as returned by `txNonceKey`, of the transactions of the blocks from
+// `fromHeight` to `toHeight` included
+func getCommittedTxNonces(persistenceModule modules.PersistenceModule, fromHeight, toHeight int64) (map[string]struct{}, error) {
+	committedNonces := make(map[string]struct{})
+	for height := fromHeight; height <= toHeight; height++ {
+		blockBz, err := persistenceModule.GetBlockStore().Get(utils.HeightToBytes(uint64(height)))
+		if err != nil {
+			return nil, typesUtil.ErrGetBlock(err)
+		}
+		block := new(coreTypes.Block)
+		if err := codec.GetCodec().Unmarshal(blockBz, block); err != nil {
+			return nil, typesUtil.ErrProtoUnmarshal(err)
+		}
+
+		for _, txBz := range block.Transactions {
+			tx, err := coreTypes.TxFromBytes(txBz)
+			if err != nil {
+				return nil, typesUtil.ErrProtoUnmarshal(err)
+			}
+			signer, err := tx.SignerAddress()
+			if err != nil {
+				return nil, typesUtil.ErrNewPublicKeyFromBytes(err)
+			}
+			committedNonces[txNonceKey(signer, tx.Nonce)] = struct{}{}
+		}
+	}
+	return committedNonces, nil
+}
+
+func txNonceKey(signer []byte, nonce string) string {
+	return string(signer) + "/" + nonce
+}
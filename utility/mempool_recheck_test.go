@@ -0,0 +1,181 @@
+package utility
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pokt-network/pocket/persistence/kvstore"
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	mockModules "github.com/pokt-network/pocket/shared/modules/mocks"
+	"github.com/pokt-network/pocket/shared/utils"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRecheckHeight       = int64(5)
+	testRecheckExpiryHeight = testRecheckHeight + 10
+	testTxFee               = "100"
+)
+
+func TestUtilityModule_RecheckMempoolTxs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	committedSigner := newTestRecheckSigner(t)
+	nonceReuseSigner := newTestRecheckSigner(t)
+	poorSigner := newTestRecheckSigner(t)
+	richSigner := newTestRecheckSigner(t)
+
+	committedTx := newTestRecheckTx(t, committedSigner, 1, testRecheckExpiryHeight)
+	nonceReusedTx := newTestRecheckTx(t, nonceReuseSigner, 7, testRecheckExpiryHeight)
+	poorSignerTx1 := newTestRecheckTx(t, poorSigner, 1, testRecheckExpiryHeight)
+	poorSignerTx2 := newTestRecheckTx(t, poorSigner, 2, testRecheckExpiryHeight)
+	poorSignerTx3 := newTestRecheckTx(t, poorSigner, 3, testRecheckExpiryHeight)
+	richSignerTx := newTestRecheckTx(t, richSigner, 1, testRecheckExpiryHeight)
+	expiredTx := newTestRecheckTx(t, richSigner, 2, testRecheckHeight)
+
+	txMempool := typesUtil.NewTxFIFOMempool(1e6, 100)
+	for _, txBz := range [][]byte{committedTx, nonceReusedTx, poorSignerTx1, poorSignerTx2, poorSignerTx3, richSignerTx, expiredTx} {
+		require.NoError(t, txMempool.AddTx(txBz))
+	}
+
+	persistenceMock := mockModules.NewMockPersistenceModule(ctrl)
+	persistenceMock.EXPECT().
+		TransactionExists(gomock.Any()).
+		DoAndReturn(func(txHash string) (bool, error) {
+			return txHash == coreTypes.TxHash(committedTx), nil
+		}).
+		AnyTimes()
+
+	// The poor signer can only pay the fees of two of its transactions
+	accountAmounts := map[string]string{
+		committedSigner.Address().String():  "1000",
+		nonceReuseSigner.Address().String(): "1000",
+		poorSigner.Address().String():       "250",
+		richSigner.Address().String():       "1000",
+	}
+	readCtxMock := mockModules.NewMockPersistenceReadContext(ctrl)
+	readCtxMock.EXPECT().
+		GetStringParam(typesUtil.MessageSendFee, testRecheckHeight).
+		Return(testTxFee, nil).
+		AnyTimes()
+	readCtxMock.EXPECT().
+		GetAccountAmount(gomock.Any(), testRecheckHeight).
+		DoAndReturn(func(address []byte, _ int64) (string, error) {
+			return accountAmounts[crypto.Address(address).String()], nil
+		}).
+		AnyTimes()
+
+	cursor := &mempoolRecheckCursor{}
+	cursor.addCommittedNonces(map[string]struct{}{
+		txNonceKey(nonceReuseSigner.Address(), "7"): {},
+	})
+
+	evictions, err := recheckMempoolTxs(txMempool, persistenceMock, readCtxMock, testRecheckHeight, cursor)
+	require.NoError(t, err)
+	require.Equal(t, &mempoolEvictions{
+		rechecked:         7,
+		committed:         1,
+		expired:           1,
+		insufficientFunds: 1,
+		nonceReused:       1,
+	}, evictions)
+
+	require.Equal(t, [][]byte{poorSignerTx1, poorSignerTx2, richSignerTx}, txMempool.GetAll())
+}
+
+func TestUtilityModule_RecheckMempoolTxs_Rotation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// More transactions than can be rechecked after a single block, the last ones expired
+	numTxs := 2*mempoolRecheckMaxTxs + mempoolRecheckMaxTxs/2
+	signer := newTestRecheckSigner(t)
+	txMempool := typesUtil.NewTxFIFOMempool(1e9, uint32(numTxs))
+	for i := 0; i < numTxs; i++ {
+		expiryHeight := testRecheckExpiryHeight
+		if i >= numTxs-10 {
+			expiryHeight = testRecheckHeight
+		}
+		require.NoError(t, txMempool.AddTx(newTestRecheckTx(t, signer, uint64(i), expiryHeight)))
+	}
+
+	persistenceMock := mockModules.NewMockPersistenceModule(ctrl)
+	persistenceMock.EXPECT().TransactionExists(gomock.Any()).Return(false, nil).AnyTimes()
+	readCtxMock := mockModules.NewMockPersistenceReadContext(ctrl)
+	readCtxMock.EXPECT().GetStringParam(typesUtil.MessageSendFee, testRecheckHeight).Return("0", nil).AnyTimes()
+	readCtxMock.EXPECT().GetAccountAmount(gomock.Any(), testRecheckHeight).Return("1000", nil).AnyTimes()
+
+	// The rechecks continue from the transactions not rechecked yet, reaching the expired ones at the end of the mempool
+	cursor := &mempoolRecheckCursor{}
+	for _, expectedRechecked := range []int{mempoolRecheckMaxTxs, mempoolRecheckMaxTxs, mempoolRecheckMaxTxs / 2} {
+		evictions, err := recheckMempoolTxs(txMempool, persistenceMock, readCtxMock, testRecheckHeight, cursor)
+		require.NoError(t, err)
+		require.Equal(t, expectedRechecked, evictions.rechecked)
+	}
+	require.Equal(t, uint32(numTxs-10), txMempool.TxCount())
+
+	// A nonce committed after a transaction was rechecked is caught once the rechecks rotate back to it
+	reusedNonceTx := txMempool.GetAll()[0]
+	cursor.addCommittedNonces(map[string]struct{}{txNonceKey(signer.Address(), "0"): {}})
+	evictions, err := recheckMempoolTxs(txMempool, persistenceMock, readCtxMock, testRecheckHeight, cursor)
+	require.NoError(t, err)
+	require.Equal(t, mempoolRecheckMaxTxs, evictions.rechecked)
+	require.Equal(t, 1, evictions.nonceReused)
+	require.False(t, txMempool.Contains(coreTypes.TxHash(reusedNonceTx)))
+}
+
+func TestUtilityModule_GetCommittedTxNonces(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	signer := newTestRecheckSigner(t)
+	blockStore := kvstore.NewMemKVStore()
+	for height, nonces := range [][]uint64{{1}, {2, 3}, {4}} {
+		txs := make([][]byte, len(nonces))
+		for i, nonce := range nonces {
+			txs[i] = newTestRecheckTx(t, signer, nonce, testRecheckExpiryHeight)
+		}
+		blockBz, err := codec.GetCodec().Marshal(&coreTypes.Block{Transactions: txs})
+		require.NoError(t, err)
+		require.NoError(t, blockStore.Set(utils.HeightToBytes(uint64(height)), blockBz))
+	}
+	persistenceMock := mockModules.NewMockPersistenceModule(ctrl)
+	persistenceMock.EXPECT().GetBlockStore().Return(blockStore).AnyTimes()
+
+	// The nonces of all the blocks since the last recheck are loaded
+	committedNonces, err := getCommittedTxNonces(persistenceMock, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, map[string]struct{}{
+		txNonceKey(signer.Address(), "2"): {},
+		txNonceKey(signer.Address(), "3"): {},
+		txNonceKey(signer.Address(), "4"): {},
+	}, committedNonces)
+}
+
+func newTestRecheckSigner(t *testing.T) crypto.PrivateKey {
+	t.Helper()
+	privateKey, err := crypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	return privateKey
+}
+
+func newTestRecheckTx(t *testing.T, signer crypto.PrivateKey, nonce uint64, expiryHeight int64) []byte {
+	t.Helper()
+	anyMsg, err := codec.GetCodec().ToAny(&typesUtil.MessageSend{
+		FromAddress: signer.Address(),
+		ToAddress:   signer.Address(),
+		Amount:      "1",
+	})
+	require.NoError(t, err)
+	tx := &coreTypes.Transaction{
+		Msg:          anyMsg,
+		Nonce:        strconv.FormatUint(nonce, 10),
+		ExpiryHeight: expiryHeight,
+	}
+	require.NoError(t, tx.Sign(signer))
+	txBz, err := tx.Bytes()
+	require.NoError(t, err)
+	return txBz
+}
@@ -3,9 +3,12 @@ package utility
 import (
 	"github.com/pokt-network/pocket/logger"
 	"github.com/pokt-network/pocket/runtime/configs"
+	configTypes "github.com/pokt-network/pocket/runtime/configs/types"
 	"github.com/pokt-network/pocket/shared/mempool"
 	"github.com/pokt-network/pocket/shared/modules"
 	"github.com/pokt-network/pocket/shared/modules/base_modules"
+	"github.com/pokt-network/pocket/utility/service"
+	utilityTelemetry "github.com/pokt-network/pocket/utility/telemetry"
 	"github.com/pokt-network/pocket/utility/types"
 )
 
@@ -22,6 +25,15 @@ type utilityModule struct {
 
 	logger  *modules.Logger
 	mempool mempool.TXMempool
+
+	// mempoolRecheckedHeight is the last committed height the mempool was rechecked against, so the next recheck
+	// takes into account all the blocks committed since
+	mempoolRecheckedHeight int64
+	// mempoolRecheckCursor rotates the rechecks through all the transactions of the mempool across blocks
+	mempoolRecheckCursor mempoolRecheckCursor
+
+	// servicer handles the relays sent to this node; nil unless the node is configured as a servicer
+	servicer *service.Servicer
 }
 
 func Create(bus modules.Bus, options ...modules.ModuleOption) (modules.Module, error) {
@@ -43,13 +55,46 @@ func (*utilityModule) Create(bus modules.Bus, options ...modules.ModuleOption) (
 	utilityCfg := cfg.Utility
 
 	m.config = utilityCfg
-	m.mempool = types.NewTxFIFOMempool(utilityCfg.MaxMempoolTransactionBytes, utilityCfg.MaxMempoolTransactions)
+	m.mempool = m.newMempool(utilityCfg)
+
+	if servicerCfg := cfg.Servicer; servicerCfg != nil && servicerCfg.Enabled {
+		servicer, err := newServicer(servicerCfg)
+		if err != nil {
+			return nil, err
+		}
+		m.servicer = servicer
+	}
 
 	return m, nil
 }
 
+// newMempool creates the mempool of the type selected in the utility configuration
+func (u *utilityModule) newMempool(utilityCfg *configs.UtilityConfig) mempool.TXMempool {
+	switch utilityCfg.MempoolType {
+	case configTypes.MempoolType_FEE_PRIORITY:
+		return types.NewTxFeePriorityMempool(utilityCfg.MaxMempoolTransactionBytes, utilityCfg.MaxMempoolTransactions, u.getTxFee)
+	default:
+		return types.NewTxFIFOMempool(utilityCfg.MaxMempoolTransactionBytes, utilityCfg.MaxMempoolTransactions)
+	}
+}
+
 func (u *utilityModule) Start() error {
 	u.logger = logger.Global.CreateLoggerForModule(u.GetModuleName())
+
+	u.GetBus().
+		GetTelemetryModule().
+		GetTimeSeriesAgent().
+		CounterRegister(
+			utilityTelemetry.MEMPOOL_EVICTED_TXS_COUNTER_NAME,
+			utilityTelemetry.MEMPOOL_EVICTED_TXS_COUNTER_DESCRIPTION,
+		)
+	return nil
+}
+
+func (u *utilityModule) Stop() error {
+	if u.servicer != nil {
+		return u.servicer.Stop()
+	}
 	return nil
 }
 
@@ -0,0 +1,280 @@
+package service
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/modules"
+	"github.com/pokt-network/pocket/utility/types"
+)
+
+// VolumeTxSubmitter submits a claim or proof transaction signed by the servicer to the network
+type VolumeTxSubmitter func(txBz []byte) error
+
+// SubmitVolumeTxs submits the transactions, signed by the servicer, claiming the sessions that ended with the block
+// committed at `height`, and proving the claims of the servicer committed up to `height` that were not proven yet.
+//
+// The transaction of a claim or proof that cannot be created or submitted does not prevent the others from being
+// submitted: the errors are returned once all the transactions were handled, and the proofs that were not submitted
+// are retried at the following heights until their claim expires.
+func (s *Servicer) SubmitVolumeTxs(readCtx modules.PersistenceReadContext, height int64, submitTx VolumeTxSubmitter) []types.Error {
+	maxExpiryBlocks, er := readCtx.GetIntParam(types.MaxTransactionExpiryBlocksParamName, height)
+	if er != nil {
+		return []types.Error{types.ErrGetParam(types.MaxTransactionExpiryBlocksParamName, er)}
+	}
+	// the transactions are included in the blocks following `height`
+	maxExpiryHeight := height + 1 + int64(maxExpiryBlocks)
+
+	errs := s.submitClaimTxs(readCtx, height, maxExpiryHeight, submitTx)
+	return append(errs, s.submitProofTxs(readCtx, height, maxExpiryHeight, submitTx)...)
+}
+
+// submitClaimTxs claims the sessions that ended with the block committed at `height`, if any, until the end of the
+// session that follows them
+func (s *Servicer) submitClaimTxs(readCtx modules.PersistenceReadContext, height, maxExpiryHeight int64, submitTx VolumeTxSubmitter) []types.Error {
+	blocksPerSession, er := readCtx.GetIntParam(types.BlocksPerSessionParamName, height)
+	if er != nil {
+		return []types.Error{types.ErrGetParam(types.BlocksPerSessionParamName, er)}
+	}
+	if blocksPerSession <= 0 {
+		return []types.Error{types.ErrInvalidBlocksPerSession(blocksPerSession)}
+	}
+	nextSessionHeight := height + 1
+	if nextSessionHeight%int64(blocksPerSession) != 0 {
+		return nil
+	}
+
+	claims, err := s.CreateClaims(nextSessionHeight - int64(blocksPerSession))
+	if err != nil {
+		return []types.Error{err}
+	}
+	expiryHeight := minHeight(nextSessionHeight+int64(blocksPerSession)-1, maxExpiryHeight)
+	var errs []types.Error
+	for _, claim := range claims {
+		if err := s.submitTx(claim, expiryHeight, submitTx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// submitProofTxs proves the claims of the servicer committed up to `height`, until they expire. A proof is only
+// submitted once per claim, since the relay to prove does not change, but it is retried until it is submitted.
+func (s *Servicer) submitProofTxs(readCtx modules.PersistenceReadContext, height, maxExpiryHeight int64, submitTx VolumeTxSubmitter) []types.Error {
+	expirationBlocks, er := readCtx.GetIntParam(types.ClaimExpirationBlocksParamName, height)
+	if er != nil {
+		return []types.Error{types.ErrGetParam(types.ClaimExpirationBlocksParamName, er)}
+	}
+	claims, er := readCtx.GetAllClaims(height)
+	if er != nil {
+		return []types.Error{types.ErrGetClaim(er)}
+	}
+
+	servicerAddr := s.privateKey.Address().String()
+	pendingClaims := make(map[string]struct{})
+	var errs []types.Error
+	for _, claim := range claims {
+		if claim.GetServicerAddress() != servicerAddr {
+			continue
+		}
+		// proven claims are deleted, so only the claims still pending need to be remembered
+		pendingClaims[claim.GetSessionId()] = struct{}{}
+		if _, ok := s.provenClaims[claim.GetSessionId()]; ok {
+			continue
+		}
+
+		claimBlockHash, er := readCtx.GetBlockHash(claim.GetHeight())
+		if er != nil {
+			errs = append(errs, types.ErrGetBlockHash(er))
+			continue
+		}
+		proof, err := s.CreateProof(claim, claimBlockHash)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := s.submitTx(proof, minHeight(claim.GetHeight()+int64(expirationBlocks)-1, maxExpiryHeight), submitTx); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		s.provenClaims[claim.GetSessionId()] = struct{}{}
+	}
+
+	for sessionID := range s.provenClaims {
+		if _, ok := pendingClaims[sessionID]; !ok {
+			delete(s.provenClaims, sessionID)
+		}
+	}
+	return errs
+}
+
+// submitTx signs a transaction wrapping `msg` that can be included in a block until `expiryHeight`, and submits it
+func (s *Servicer) submitTx(msg types.Message, expiryHeight int64, submitTx VolumeTxSubmitter) types.Error {
+	txBz, err := s.signTx(msg, expiryHeight)
+	if err != nil {
+		return err
+	}
+	if err := submitTx(txBz); err != nil {
+		return types.ErrVolumeTxSubmission(err)
+	}
+	return nil
+}
+
+// signTx wraps `msg` in a transaction signed by the servicer, that can be included in a block until `expiryHeight`
+func (s *Servicer) signTx(msg types.Message, expiryHeight int64) ([]byte, types.Error) {
+	anyMsg, err := codec.GetCodec().ToAny(msg)
+	if err != nil {
+		return nil, types.ErrProtoMarshal(err)
+	}
+	tx := &coreTypes.Transaction{
+		Msg:          anyMsg,
+		Nonce:        fmt.Sprintf("%d", crypto.GetNonce()),
+		ExpiryHeight: expiryHeight,
+	}
+	if err := tx.Sign(s.privateKey); err != nil {
+		return nil, types.ErrTransactionSign(err)
+	}
+	txBz, err := tx.Bytes()
+	if err != nil {
+		return nil, types.ErrProtoMarshal(err)
+	}
+	return txBz, nil
+}
+
+// CreateClaims builds a claim for every session that started at `sessionHeight` in which relays were serviced.
+// The claims commit to the root of a Merkle sum tree of the relays of each session, so the servicer can later
+// prove any of them on chain without relying on a fisherman.
+func (s *Servicer) CreateClaims(sessionHeight int64) ([]*types.MessageClaim, types.Error) {
+	relays, err := s.relayStore.GetAll(sessionHeight)
+	if err != nil {
+		return nil, types.ErrRelayStore(err)
+	}
+
+	// All the sessions of an application starting at the same height are identified by their relay chain and geo zone
+	sessionRelays := make(map[string][]*coreTypes.Relay)
+	for _, relay := range relays {
+		key := claimSessionKey(relay.GetMeta())
+		sessionRelays[key] = append(sessionRelays[key], relay)
+	}
+	keys := make([]string, 0, len(sessionRelays))
+	for key := range sessionRelays {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	claims := make([]*types.MessageClaim, 0, len(keys))
+	for _, key := range keys {
+		tree, er := newRelayMerkleSumTree(sessionRelays[key])
+		if er != nil {
+			return nil, er
+		}
+		meta := sessionRelays[key][0].GetMeta()
+		appPubKey, err := hex.DecodeString(meta.GetToken().GetApplicationPublicKey())
+		if err != nil {
+			return nil, types.ErrHexDecodeFromString(err)
+		}
+		rootHash, totalRelays := tree.Root()
+		claims = append(claims, &types.MessageClaim{
+			ServicerAddress:      s.privateKey.Address(),
+			ApplicationPublicKey: appPubKey,
+			RelayChain:           meta.GetRelayChain(),
+			GeoZone:              meta.GetGeoZone(),
+			SessionHeight:        sessionHeight,
+			RootHash:             rootHash,
+			TotalRelays:          totalRelays,
+		})
+	}
+	return claims, nil
+}
+
+// CreateProof reveals the relay of `claim` selected by the hash of the block the claim was committed in, along with
+// its Merkle sum proof
+func (s *Servicer) CreateProof(claim *coreTypes.Claim, claimBlockHash string) (*types.MessageProof, types.Error) {
+	relays, err := s.relayStore.GetAll(claim.GetSessionHeight())
+	if err != nil {
+		return nil, types.ErrRelayStore(err)
+	}
+
+	claimedRelays := make([]*coreTypes.Relay, 0)
+	for _, relay := range relays {
+		meta := relay.GetMeta()
+		if meta.GetToken().GetApplicationPublicKey() == claim.GetApplicationPublicKey() &&
+			meta.GetRelayChain() == claim.GetRelayChain() &&
+			meta.GetGeoZone() == claim.GetGeoZone() {
+			claimedRelays = append(claimedRelays, relay)
+		}
+	}
+	if len(claimedRelays) == 0 {
+		return nil, types.ErrInvalidClaim("no relays stored for the claimed session")
+	}
+
+	tree, er := newRelayMerkleSumTree(claimedRelays)
+	if er != nil {
+		return nil, er
+	}
+	rootHash, totalRelays := tree.Root()
+	if hex.EncodeToString(rootHash) != claim.GetRootHash() || totalRelays != claim.GetTotalRelays() {
+		return nil, types.ErrInvalidClaim("the stored relays do not match the claimed root")
+	}
+
+	target, er := types.GetClaimProofTarget(claim, claimBlockHash)
+	if er != nil {
+		return nil, er
+	}
+	leaf, proof, er := tree.Prove(target)
+	if er != nil {
+		return nil, er
+	}
+
+	servicerAddr, err := hex.DecodeString(claim.GetServicerAddress())
+	if err != nil {
+		return nil, types.ErrHexDecodeFromString(err)
+	}
+	sessionId, err := hex.DecodeString(claim.GetSessionId())
+	if err != nil {
+		return nil, types.ErrHexDecodeFromString(err)
+	}
+	for _, relay := range claimedRelays {
+		relayHash, err := relay.Hash()
+		if err != nil {
+			return nil, types.ErrProtoMarshal(err)
+		}
+		if bytes.Equal(relayHash, leaf.Hash) {
+			return &types.MessageProof{
+				ServicerAddress: servicerAddr,
+				SessionId:       sessionId,
+				Relay:           relay,
+				Proof:           proof,
+			}, nil
+		}
+	}
+	return nil, types.ErrInvalidProof("the proven relay is not stored")
+}
+
+func newRelayMerkleSumTree(relays []*coreTypes.Relay) (*types.MerkleSumTree, types.Error) {
+	leaves := make([]types.MerkleSumLeaf, len(relays))
+	for i, relay := range relays {
+		leaf, err := types.NewRelayMerkleSumLeaf(relay)
+		if err != nil {
+			return nil, err
+		}
+		leaves[i] = leaf
+	}
+	return types.NewMerkleSumTree(leaves)
+}
+
+func minHeight(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func claimSessionKey(meta *coreTypes.RelayMeta) string {
+	return meta.GetToken().GetApplicationPublicKey() + "/" + meta.GetRelayChain() + "/" + meta.GetGeoZone()
+}
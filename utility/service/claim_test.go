@@ -0,0 +1,191 @@
+package service
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	mockModules "github.com/pokt-network/pocket/shared/modules/mocks"
+	"github.com/pokt-network/pocket/utility/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestServicer_CreateClaimsAndProof(t *testing.T) {
+	chain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer chain.Close()
+
+	env := newTestServicerEnv(t, chain.URL, 100)
+	numRelays := 5
+	for i := 0; i < numRelays; i++ {
+		relay := newTestRelay(t, env, testHeight)
+		relay.Payload.Data = fmt.Sprintf(`{"jsonrpc":"2.0","id":%d}`, i)
+		signTestRelay(t, env.clientKey, relay)
+		_, err := env.servicer.HandleRelay(env.readCtx, testHeight, relay)
+		require.NoError(t, err)
+	}
+
+	claims, err := env.servicer.CreateClaims(testSessionHeight)
+	require.NoError(t, err)
+	require.Len(t, claims, 1)
+	msgClaim := claims[0]
+	require.NoError(t, msgClaim.ValidateBasic())
+	require.Equal(t, []byte(env.servicerKey.Address()), msgClaim.ServicerAddress)
+	require.Equal(t, env.appKey.PublicKey().Bytes(), msgClaim.ApplicationPublicKey)
+	require.Equal(t, int64(testSessionHeight), msgClaim.SessionHeight)
+	require.Equal(t, uint64(numRelays), msgClaim.TotalRelays)
+
+	// No relays were serviced for the following session
+	claims, err = env.servicer.CreateClaims(testSessionHeight + testBlocksPerSession)
+	require.NoError(t, err)
+	require.Empty(t, claims)
+
+	claim := &coreTypes.Claim{
+		ServicerAddress:      hex.EncodeToString(msgClaim.ServicerAddress),
+		SessionId:            hex.EncodeToString(crypto.SHA3Hash(env.session.id)),
+		SessionHeight:        msgClaim.SessionHeight,
+		RelayChain:           msgClaim.RelayChain,
+		GeoZone:              msgClaim.GeoZone,
+		ApplicationPublicKey: hex.EncodeToString(msgClaim.ApplicationPublicKey),
+		RootHash:             hex.EncodeToString(msgClaim.RootHash),
+		TotalRelays:          msgClaim.TotalRelays,
+		Height:               testSessionHeight + testBlocksPerSession,
+	}
+	claimBlockHash := hex.EncodeToString(crypto.SHA3Hash([]byte("block")))
+
+	proof, err := env.servicer.CreateProof(claim, claimBlockHash)
+	require.NoError(t, err)
+	require.NoError(t, proof.ValidateBasic())
+	require.NoError(t, ValidateRelaySignatures(proof.Relay))
+
+	target, err := types.GetClaimProofTarget(claim, claimBlockHash)
+	require.NoError(t, err)
+	leaf, err := types.NewRelayMerkleSumLeaf(proof.Relay)
+	require.NoError(t, err)
+	require.True(t, types.VerifyMerkleSumProof(msgClaim.RootHash, msgClaim.TotalRelays, leaf, proof.Proof, target))
+
+	// A claim that does not match the stored relays cannot be proven
+	claim.TotalRelays++
+	_, err = env.servicer.CreateProof(claim, claimBlockHash)
+	require.Error(t, err)
+	require.Equal(t, types.CodeInvalidClaimError, err.Code())
+}
+
+func TestServicer_SubmitVolumeTxs(t *testing.T) {
+	chain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer chain.Close()
+
+	env := newTestServicerEnv(t, chain.URL, 100)
+	for i := 0; i < 3; i++ {
+		relay := newTestRelay(t, env, testHeight)
+		relay.Payload.Data = fmt.Sprintf(`{"jsonrpc":"2.0","id":%d}`, i)
+		signTestRelay(t, env.clientKey, relay)
+		_, err := env.servicer.HandleRelay(env.readCtx, testHeight, relay)
+		require.NoError(t, err)
+	}
+
+	lastSessionBlock := int64(testSessionHeight + testBlocksPerSession - 1)
+	claimHeight := lastSessionBlock + 1
+	claimExpirationBlocks := 4
+	// the claims committed at each height
+	committedClaims := make(map[int64][]*coreTypes.Claim)
+
+	readCtx := env.readCtx.(*mockModules.MockPersistenceReadContext)
+	readCtx.EXPECT().GetIntParam(types.MaxTransactionExpiryBlocksParamName, gomock.Any()).Return(10, nil).AnyTimes()
+	readCtx.EXPECT().GetIntParam(types.ClaimExpirationBlocksParamName, gomock.Any()).Return(claimExpirationBlocks, nil).AnyTimes()
+	readCtx.EXPECT().GetAllClaims(gomock.Any()).DoAndReturn(func(height int64) ([]*coreTypes.Claim, error) {
+		return committedClaims[height], nil
+	}).AnyTimes()
+	claimBlockHash := hex.EncodeToString(crypto.SHA3Hash([]byte("block")))
+	readCtx.EXPECT().GetBlockHash(claimHeight).Return(claimBlockHash, nil).AnyTimes()
+
+	var txs [][]byte
+	var submitErr error
+	submitTx := func(txBz []byte) error {
+		if submitErr != nil {
+			return submitErr
+		}
+		txs = append(txs, txBz)
+		return nil
+	}
+	submitVolumeTxs := func(height int64) []types.Error {
+		txs = nil
+		return env.servicer.SubmitVolumeTxs(env.readCtx, height, submitTx)
+	}
+
+	// Nothing is submitted during the session
+	require.Empty(t, submitVolumeTxs(lastSessionBlock-1))
+	require.Empty(t, txs)
+
+	// The session is claimed once it ends, until the end of the following session
+	require.Empty(t, submitVolumeTxs(lastSessionBlock))
+	require.Len(t, txs, 1)
+	claimTx := requireServicerTx(t, env, txs[0], lastSessionBlock+testBlocksPerSession)
+	msgClaim, ok := claimTx.(*types.MessageClaim)
+	require.True(t, ok)
+	require.Equal(t, uint64(3), msgClaim.TotalRelays)
+
+	// The claim is proven once committed, before it expires
+	committedClaims[claimHeight] = []*coreTypes.Claim{{
+		ServicerAddress:      hex.EncodeToString(msgClaim.ServicerAddress),
+		SessionId:            hex.EncodeToString(crypto.SHA3Hash(env.session.id)),
+		SessionHeight:        msgClaim.SessionHeight,
+		RelayChain:           msgClaim.RelayChain,
+		GeoZone:              msgClaim.GeoZone,
+		ApplicationPublicKey: hex.EncodeToString(msgClaim.ApplicationPublicKey),
+		RootHash:             hex.EncodeToString(msgClaim.RootHash),
+		TotalRelays:          msgClaim.TotalRelays,
+		Height:               claimHeight,
+	}}
+	// A claim that cannot be proven, e.g. because its relays were pruned, does not prevent the others from being proven
+	unprovableClaim := proto.Clone(committedClaims[claimHeight][0]).(*coreTypes.Claim)
+	unprovableClaim.SessionId = hex.EncodeToString(crypto.SHA3Hash([]byte("pruned session")))
+	unprovableClaim.SessionHeight -= testBlocksPerSession
+	committedClaims[claimHeight] = append([]*coreTypes.Claim{unprovableClaim}, committedClaims[claimHeight]...)
+
+	// The proof is retried until it is submitted
+	submitErr = fmt.Errorf("mempool is full")
+	errs := submitVolumeTxs(claimHeight)
+	require.Len(t, errs, 2)
+	require.Equal(t, types.CodeInvalidClaimError, errs[0].Code())
+	require.Equal(t, types.CodeVolumeTxSubmissionError, errs[1].Code())
+	submitErr = nil
+
+	committedClaims[claimHeight+1] = committedClaims[claimHeight]
+	errs = submitVolumeTxs(claimHeight + 1)
+	require.Len(t, errs, 1)
+	require.Len(t, txs, 1)
+	proofTx := requireServicerTx(t, env, txs[0], claimHeight+int64(claimExpirationBlocks)-1)
+	_, ok = proofTx.(*types.MessageProof)
+	require.True(t, ok)
+
+	// The claim is only proven once it was submitted
+	committedClaims[claimHeight+2] = committedClaims[claimHeight]
+	errs = submitVolumeTxs(claimHeight + 2)
+	require.Len(t, errs, 1)
+	require.Empty(t, txs)
+}
+
+// requireServicerTx checks that `txBz` is signed by the servicer and expires at `expiryHeight`, and returns its message
+func requireServicerTx(t *testing.T, env *testServicerEnv, txBz []byte, expiryHeight int64) proto.Message {
+	t.Helper()
+	tx, err := coreTypes.TxFromBytes(txBz)
+	require.NoError(t, err)
+	require.NoError(t, tx.ValidateBasic())
+	signer, err := tx.SignerAddress()
+	require.NoError(t, err)
+	require.Equal(t, env.servicerKey.Address(), signer)
+	require.Equal(t, expiryHeight, tx.ExpiryHeight)
+	msg, err := tx.GetMessage()
+	require.NoError(t, err)
+	return msg
+}
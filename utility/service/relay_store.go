@@ -0,0 +1,207 @@
+package service
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+const sessionHeightKeyLength = 8
+
+var _ RelayStore = &badgerRelayStore{}
+
+// RelayStore persists the relays serviced by a servicer, indexed by session height and relay hash, until the
+// volume of their session has been reported
+type RelayStore interface {
+	// Insert stores a serviced relay under the height of the session it belongs to
+	Insert(sessionHeight int64, relayHash []byte, relay *coreTypes.Relay) error
+	// Exists returns whether a relay with the same hash has already been stored for the session height
+	Exists(sessionHeight int64, relayHash []byte) (bool, error)
+	// GetAll returns all the relays of the sessions that started at `sessionHeight`, ordered by relay hash
+	GetAll(sessionHeight int64) ([]*coreTypes.Relay, error)
+	// ReapHashCollisions returns the relays of the sessions that started at `sessionHeight` whose hex encoded hash
+	// ends with `hashEndWith`, and deletes all the other relays of these sessions
+	ReapHashCollisions(sessionHeight int64, hashEndWith string) ([]*coreTypes.Relay, error)
+	// Prune deletes the relays of all the sessions that started before `sessionHeight`
+	Prune(sessionHeight int64) error
+
+	// Lifecycle methods
+	Stop() error
+}
+
+// badgerRelayStore implements the RelayStore interface using the BadgerDB backend.
+// Relays are keyed by the big endian encoded session height followed by the relay hash, so the
+// relays of a session height are contiguous and ordered before those of the following sessions.
+type badgerRelayStore struct {
+	db *badger.DB
+}
+
+// NewRelayStore creates/opens the relay store at the specified path
+func NewRelayStore(path string) (RelayStore, error) {
+	db, err := badger.Open(badgerOptions(path))
+	if err != nil {
+		return nil, err
+	}
+	return &badgerRelayStore{db: db}, nil
+}
+
+// NewMemRelayStore creates the relay store in memory
+func NewMemRelayStore() (RelayStore, error) {
+	db, err := badger.Open(badgerOptions("").WithInMemory(true))
+	if err != nil {
+		return nil, err
+	}
+	return &badgerRelayStore{db: db}, nil
+}
+
+func (store *badgerRelayStore) Insert(sessionHeight int64, relayHash []byte, relay *coreTypes.Relay) error {
+	relayBz, err := codec.GetCodec().Marshal(relay)
+	if err != nil {
+		return err
+	}
+	return store.db.Update(func(tx *badger.Txn) error {
+		return tx.Set(relayKey(sessionHeight, relayHash), relayBz)
+	})
+}
+
+func (store *badgerRelayStore) Exists(sessionHeight int64, relayHash []byte) (bool, error) {
+	err := store.db.View(func(tx *badger.Txn) error {
+		_, err := tx.Get(relayKey(sessionHeight, relayHash))
+		return err
+	})
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (store *badgerRelayStore) GetAll(sessionHeight int64) ([]*coreTypes.Relay, error) {
+	relays := make([]*coreTypes.Relay, 0)
+	err := store.db.View(func(tx *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = sessionHeightKey(sessionHeight)
+		it := tx.NewIterator(opts)
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			relay, err := unmarshalRelay(it.Item())
+			if err != nil {
+				return err
+			}
+			relays = append(relays, relay)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return relays, nil
+}
+
+func (store *badgerRelayStore) ReapHashCollisions(sessionHeight int64, hashEndWith string) ([]*coreTypes.Relay, error) {
+	relays := make([]*coreTypes.Relay, 0)
+	nonCollidingKeys := make([][]byte, 0)
+	err := store.db.View(func(tx *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = sessionHeightKey(sessionHeight)
+		it := tx.NewIterator(opts)
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			item := it.Item()
+			key := item.KeyCopy(nil)
+			if !strings.HasSuffix(hex.EncodeToString(key[sessionHeightKeyLength:]), hashEndWith) {
+				nonCollidingKeys = append(nonCollidingKeys, key)
+				continue
+			}
+			relay, err := unmarshalRelay(item)
+			if err != nil {
+				return err
+			}
+			relays = append(relays, relay)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The relays whose hash does not collide with the secret are not applicable for volume reporting
+	if err := store.deleteKeys(nonCollidingKeys); err != nil {
+		return nil, err
+	}
+	return relays, nil
+}
+
+func (store *badgerRelayStore) Prune(sessionHeight int64) error {
+	keys := make([][]byte, 0)
+	upperBound := sessionHeightKey(sessionHeight)
+	err := store.db.View(func(tx *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		it := tx.NewIterator(opts)
+		defer it.Close()
+		for it.Rewind(); it.Valid() && bytes.Compare(it.Item().Key(), upperBound) < 0; it.Next() {
+			keys = append(keys, it.Item().KeyCopy(nil))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return store.deleteKeys(keys)
+}
+
+func (store *badgerRelayStore) Stop() error {
+	return store.db.Close()
+}
+
+// deleteKeys deletes the keys in batches so that large sessions do not exceed the transaction size limits
+func (store *badgerRelayStore) deleteKeys(keys [][]byte) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	batch := store.db.NewWriteBatch()
+	defer batch.Cancel()
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+	return batch.Flush()
+}
+
+func unmarshalRelay(item *badger.Item) (*coreTypes.Relay, error) {
+	relayBz, err := item.ValueCopy(nil)
+	if err != nil {
+		return nil, err
+	}
+	relay := &coreTypes.Relay{}
+	if err := codec.GetCodec().Unmarshal(relayBz, relay); err != nil {
+		return nil, err
+	}
+	return relay, nil
+}
+
+func sessionHeightKey(sessionHeight int64) []byte {
+	key := make([]byte, sessionHeightKeyLength)
+	binary.BigEndian.PutUint64(key, uint64(sessionHeight))
+	return key
+}
+
+func relayKey(sessionHeight int64, relayHash []byte) []byte {
+	return append(sessionHeightKey(sessionHeight), relayHash...)
+}
+
+func badgerOptions(path string) badger.Options {
+	opts := badger.DefaultOptions(path)
+	opts.Logger = nil // disable badger's logger since it's very noisy
+	return opts
+}
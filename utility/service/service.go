@@ -1,133 +1,389 @@
 package service
 
 import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/modules"
 	"github.com/pokt-network/pocket/utility/types"
 )
 
-type Relay interface {
-	RelayPayload
-	RelayMeta
+const (
+	// AATVersion is the only version of the Application Authentication Token currently supported
+	AATVersion = "0.0.1"
+
+	defaultRelayTimeout = 30 * time.Second
+	// maxRelayResponseBytes bounds the size of the responses of the relay chains the servicer reads and signs
+	maxRelayResponseBytes = 4 << 20
+
+	// volumeSecretLength is the number of hex characters of the secret revealed at the end of a session: on average,
+	// one relay out of 16^volumeSecretLength collides with it and is applicable for volume reporting
+	volumeSecretLength = 1
+)
+
+// Session is the subset of the session protocol (see `session.Session`) needed to service relays
+type Session interface {
+	GetSessionID() []byte
+	GetSessionHeight() int64
+	GetServicers() []*coreTypes.Actor
+	GetMaxRelaysPerServicer() *big.Int
 }
 
-type RelayPayload interface {
-	GetData() string               // the actual data string for the external chain
-	GetMethod() string             // the http CRUD method
-	GetHTTPPath() string           // the HTTP Path
-	GetHeaders() map[string]string // http headers
+// SessionDispatcher returns the session `application` belongs to at `height` for `relayChain` and `geoZone`
+type SessionDispatcher func(
+	readCtx modules.PersistenceReadContext,
+	height int64,
+	relayChain, geoZone string,
+	application *coreTypes.Actor,
+) (Session, types.Error)
+
+// Servicer services the relays sent by clients on behalf of a staked servicer
+type Servicer struct {
+	privateKey      crypto.PrivateKey
+	chainURLs       map[string]string // relay chain identifier -> local URL of the relay chain
+	relayStore      RelayStore
+	dispatchSession SessionDispatcher
+	httpClient      *http.Client
+
+	// The number of relays serviced for each session (keyed by `relayCountKey`) that started at `relayCountsHeight`,
+	// loaded from the relay store when the sessions are first seen so restarting the servicer does not reset them
+	relayCountsMu     sync.Mutex
+	relayCountsHeight int64
+	relayCounts       map[string]int64
+
+	// The session IDs of the pending claims of the servicer a proof was already created for; only accessed when
+	// handling new heights
+	provenClaims map[string]struct{}
 }
 
-type RelayMeta interface {
-	GetBlockHeight() int64 // the block height when the request is made
-	GetServicerPublicKey() crypto.PublicKey
-	GetRelayChain() RelayChain
-	GetGeoZone() GeoZone
-	GetToken() AAT
-	GetSignature() string
+func NewServicer(privateKey crypto.PrivateKey, chainURLs map[string]string, relayStore RelayStore, dispatchSession SessionDispatcher) *Servicer {
+	return &Servicer{
+		privateKey:      privateKey,
+		chainURLs:       chainURLs,
+		relayStore:      relayStore,
+		dispatchSession: dispatchSession,
+		httpClient:      &http.Client{Timeout: defaultRelayTimeout},
+		provenClaims:    make(map[string]struct{}),
+	}
 }
 
-type RelayResponse interface {
-	Payload() string
-	ServicerSignature() string
+// HandleRelay validates, executes and stores a relay submitted by a client, using the world state at `height`
+func (s *Servicer) HandleRelay(readCtx modules.PersistenceReadContext, height int64, relayProto *coreTypes.Relay) (*coreTypes.RelayResponse, types.Error) {
+	r := &relay{
+		Relay:    relayProto,
+		servicer: s,
+		readCtx:  readCtx,
+		height:   height,
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	// the relay counts towards the limit of the session from its validation, and is released if it is not serviced
+	response, err := r.Execute()
+	if err != nil {
+		s.releaseRelay(r.session, r.GetMeta())
+		return nil, err
+	}
+	if err := r.Store(); err != nil {
+		s.releaseRelay(r.session, r.GetMeta())
+		return nil, err
+	}
+	return response, nil
 }
 
-type RelayChain Identifiable
-type GeoZone Identifiable
+// GetRelayBudget returns the number of relays the application with public key `appPublicKey` can send to the servicer
+// during its session at `height` for `relayChain` and `geoZone`, along with the number of relays it can still send
+func (s *Servicer) GetRelayBudget(
+	readCtx modules.PersistenceReadContext,
+	height int64,
+	appPublicKey, relayChain, geoZone string,
+) (maxRelays, remainingRelays *big.Int, err types.Error) {
+	session, err := s.dispatchAppSession(readCtx, height, appPublicKey, relayChain, geoZone)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !isActorInList(s.privateKey.PublicKey().String(), session.GetServicers()) {
+		return nil, nil, types.ErrServicerNotInSession()
+	}
+	relayCount, err := s.getRelayCount(session, relayCountKey(appPublicKey, relayChain, geoZone))
+	if err != nil {
+		return nil, nil, err
+	}
+	remainingRelays = new(big.Int).Sub(session.GetMaxRelaysPerServicer(), big.NewInt(relayCount))
+	if remainingRelays.Sign() < 0 {
+		remainingRelays.SetInt64(0)
+	}
+	return session.GetMaxRelaysPerServicer(), remainingRelays, nil
+}
+
+// HandleSessionEnd is called when a session ends with the block committed at `height`. It reaps the volume metric
+// applicable relays of the latest sessions whose claims can no longer be proven, and prunes the relays of the sessions
+// that ended before them.
+//
+// The claims of a session can be committed until the end of the session that follows it, and proven within
+// `claim_expiration_blocks` blocks, during which all the relays of the session must be kept (see `SubmitVolumeTxs`).
+func (s *Servicer) HandleSessionEnd(readCtx modules.PersistenceReadContext, height int64) ([]*coreTypes.Relay, types.Error) {
+	blocksPerSession, er := readCtx.GetIntParam(types.BlocksPerSessionParamName, height)
+	if er != nil {
+		return nil, types.ErrGetParam(types.BlocksPerSessionParamName, er)
+	}
+	if blocksPerSession <= 0 {
+		return nil, types.ErrInvalidBlocksPerSession(blocksPerSession)
+	}
+	nextSessionHeight := height + 1
+	if nextSessionHeight%int64(blocksPerSession) != 0 {
+		return nil, nil
+	}
+	expirationBlocks, er := readCtx.GetIntParam(types.ClaimExpirationBlocksParamName, height)
+	if er != nil {
+		return nil, types.ErrGetParam(types.ClaimExpirationBlocksParamName, er)
+	}
+
+	// The last proof of the session starting at `sessionHeight` can be included at height
+	// `sessionHeight + 2*blocksPerSession - 1 + expirationBlocks - 1`, which must be at most `height`
+	lastProvenSessionHeight := nextSessionHeight - 2*int64(blocksPerSession) - int64(expirationBlocks) + 1
+	if lastProvenSessionHeight < 0 {
+		return nil, nil
+	}
+	sessionHeight := lastProvenSessionHeight - lastProvenSessionHeight%int64(blocksPerSession)
+
+	// The secret is revealed by the hash of the last block of the session
+	blockHash, er := readCtx.GetBlockHash(sessionHeight + int64(blocksPerSession) - 1)
+	if er != nil {
+		return nil, types.ErrGetBlockHash(er)
+	}
+	volumeRelays, err := s.ReapStoreForHashCollision(sessionHeight, getVolumeSecret(blockHash))
+	if err != nil {
+		return nil, err
+	}
+
+	// The volume of the previous sessions was reported when they were reaped
+	if err := s.PruneRelayStore(sessionHeight); err != nil {
+		return nil, err
+	}
+	return volumeRelays, nil
+}
+
+// ReapStoreForHashCollision returns the volume metric applicable relays serviced during the sessions that started at `sessionBlockHeight`
+func (s *Servicer) ReapStoreForHashCollision(sessionBlockHeight int64, hashEndWith string) ([]*coreTypes.Relay, types.Error) {
+	// Pull all relays whose hash collides with the revealed secret key
+	// It's important to note, the secret key isn't revealed by the network until the session is over
+	// to prevent volume based bias. The secret key is usually a pseudorandom selection using the block hash as a seed.
+	// (See the session protocol)
+	//
+	// This function also signifies deleting the non-volume-applicable Relays
+	relays, err := s.relayStore.ReapHashCollisions(sessionBlockHeight, hashEndWith)
+	if err != nil {
+		return nil, types.ErrRelayStore(err)
+	}
+	return relays, nil
+}
 
-type AAT interface {
-	GetVersion() string              // confirm a valid AAT version
-	GetApplicationPublicKey() string // confirm the identity/signature of the app
-	GetClientPublicKey() string      // confirm the identity/signature of the client
-	GetApplicationSignature() string // confirm the application signed the token
+// PruneRelayStore deletes the relays of the sessions that started before `sessionBlockHeight`, once their volume has been reported
+func (s *Servicer) PruneRelayStore(sessionBlockHeight int64) types.Error {
+	if err := s.relayStore.Prune(sessionBlockHeight); err != nil {
+		return types.ErrRelayStore(err)
+	}
+	return nil
 }
 
-type Identifiable interface {
-	Name() string
-	ID() string
+// Stop releases the resources held by the servicer
+func (s *Servicer) Stop() error {
+	return s.relayStore.Stop()
 }
 
-var _ Relay = &relay{}
+type relay struct {
+	*coreTypes.Relay
 
-type relay struct{}
+	servicer *Servicer
+	readCtx  modules.PersistenceReadContext
+	height   int64
+
+	// The session the relay belongs to and the hash of the relay; hydrated during validation
+	session Session
+	hash    []byte
+}
 
 // Validate a submitted relay by a client before servicing
 func (r *relay) Validate() types.Error {
-
 	// validate payload
+	if r.GetPayload() == nil {
+		return types.ErrInvalidRelay("empty payload")
+	}
 
 	// validate the metadata
+	meta := r.GetMeta()
+	if meta == nil {
+		return types.ErrInvalidRelay("empty metadata")
+	}
+	if meta.GetServicerPublicKey() != r.servicer.privateKey.PublicKey().String() {
+		return types.ErrInvalidRelay("the relay is addressed to a different servicer")
+	}
 
 	// ensure the RelayChain is supported locally
+	if _, ok := r.servicer.chainURLs[meta.GetRelayChain()]; !ok {
+		return types.ErrUnsupportedRelayChain(meta.GetRelayChain())
+	}
 
-	// ensure session block height is current
-
-	// get the session context
-
-	// get the application object from the r.AAT()
-
-	// get session node count from that session height
+	// validate the token and the client's signature
+	if err := ValidateRelaySignatures(r.Relay); err != nil {
+		return err
+	}
 
-	// get maximum possible relays for the application
+	// generate the session of the application of the r.AAT() from seed data
+	session, err := r.servicer.dispatchAppSession(r.readCtx, r.height, meta.GetToken().GetApplicationPublicKey(), meta.GetRelayChain(), meta.GetGeoZone())
+	if err != nil {
+		return err
+	}
 
-	// ensure not over serviced
-
-	// generate the session from seed data
+	// ensure session block height is current
+	blocksPerSession, err := getBlocksPerSession(r.readCtx, r.height)
+	if err != nil {
+		return err
+	}
+	if relaySessionHeight := meta.GetBlockHeight() - meta.GetBlockHeight()%blocksPerSession; relaySessionHeight != session.GetSessionHeight() {
+		return types.ErrRelayOutOfSession(meta.GetBlockHeight(), session.GetSessionHeight())
+	}
 
 	// validate self against the session
-
+	if !isActorInList(meta.GetServicerPublicKey(), session.GetServicers()) {
+		return types.ErrServicerNotInSession()
+	}
+
+	// ensure the relay has not been serviced already
+	relayHash, er := r.Hash()
+	if er != nil {
+		return types.ErrProtoMarshal(er)
+	}
+	relayExists, er := r.servicer.relayStore.Exists(session.GetSessionHeight(), relayHash)
+	if er != nil {
+		return types.ErrRelayStore(er)
+	}
+	if relayExists {
+		return types.ErrInvalidRelay("the relay has already been serviced")
+	}
+
+	// ensure not over serviced, reserving the relay so concurrent relays cannot exceed the limit
+	if err := r.servicer.reserveRelay(session, meta); err != nil {
+		return err
+	}
+
+	r.session = session
+	r.hash = relayHash
 	return nil
 }
 
 // Store a submitted relay by a client for volume tracking
 func (r *relay) Store() types.Error {
-
-	// marshal relay object into protoBytes
-
-	// calculate the hashOf(protoBytes) <needed for volume tracking>
-
-	// persist relay object, indexing under session
-
+	if err := r.servicer.relayStore.Insert(r.session.GetSessionHeight(), r.hash, r.Relay); err != nil {
+		return types.ErrRelayStore(err)
+	}
 	return nil
 }
 
 // Execute a submitted relay by a client after validation
-func (r *relay) Execute() (RelayResponse, types.Error) {
-
+func (r *relay) Execute() (*coreTypes.RelayResponse, types.Error) {
 	// retrieve the RelayChain url from the servicer's local configuration file
+	chainURL := r.servicer.chainURLs[r.GetMeta().GetRelayChain()]
 
 	// execute http request with the relay payload
+	payload := r.GetPayload()
+	method := payload.GetMethod()
+	if method == "" {
+		method = http.MethodPost
+	}
+	relayURL, err := getRelayURL(chainURL, payload.GetHttpPath())
+	if err != nil {
+		return nil, types.ErrRelayExecution(err)
+	}
+	req, err := http.NewRequest(method, relayURL, strings.NewReader(payload.GetData()))
+	if err != nil {
+		return nil, types.ErrRelayExecution(err)
+	}
+	for key, value := range payload.GetHeaders() {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := r.servicer.httpClient.Do(req)
+	if err != nil {
+		return nil, types.ErrRelayExecution(err)
+	}
+	defer resp.Body.Close()
+
+	respBz, err := io.ReadAll(io.LimitReader(resp.Body, maxRelayResponseBytes+1))
+	if err != nil {
+		return nil, types.ErrRelayExecution(err)
+	}
+	if len(respBz) > maxRelayResponseBytes {
+		return nil, types.ErrRelayExecution(fmt.Errorf("the response exceeds %d bytes", maxRelayResponseBytes))
+	}
+	// failed executions are neither signed nor counted as serviced relays
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, types.ErrRelayExecution(fmt.Errorf("the relay chain responded with status %d", resp.StatusCode))
+	}
 
 	// format and digitally sign the response
-
-	return nil, nil
+	response := &coreTypes.RelayResponse{Payload: string(respBz)}
+	signableBz, err := response.SignableBytes()
+	if err != nil {
+		return nil, types.ErrProtoMarshal(err)
+	}
+	signature, err := r.servicer.privateKey.Sign(signableBz)
+	if err != nil {
+		return nil, types.ErrRelayResponseSign(err)
+	}
+	response.ServicerSignature = hex.EncodeToString(signature)
+	return response, nil
 }
 
-// Get volume metric applicable relays from store
-func (r *relay) ReapStoreForHashCollision(sessionBlockHeight int64, hashEndWith string) ([]Relay, types.Error) {
-
-	// Pull all relays whose hash collides with the revealed secret key
-	// It's important to note, the secret key isn't revealed by the network until the session is over
-	// to prevent volume based bias. The secret key is usually a pseudorandom selection using the block hash as a seed.
-	// (See the session protocol)
-	//
-	// Demonstrable pseudocode below:
-	//   `SELECT * from RELAY where HashOf(relay) ends with hashEndWith AND sessionBlockHeight=sessionBlockHeight`
-
-	// This function also signifies deleting the non-volume-applicable Relays
-
-	return nil, nil
+// getRelayURL returns the URL of `httpPath` on the relay chain at `chainURL`. The path is provided by the client, so it is
+// cleaned and joined to the path of the relay chain instead of being concatenated to its URL: a path such as
+// `@other.host/` or `.other.domain:8080/` must not change the host the relay is sent to.
+func getRelayURL(chainURL, httpPath string) (string, error) {
+	base, err := url.Parse(chainURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid relay chain URL: %w", err)
+	}
+	ref, err := url.Parse(httpPath)
+	if err != nil {
+		return "", fmt.Errorf("invalid relay path: %w", err)
+	}
+	if ref.Scheme != "" || ref.Host != "" || ref.User != nil || ref.Opaque != "" {
+		return "", fmt.Errorf("invalid relay path %q: only a path and a query are allowed", httpPath)
+	}
+
+	// cleaning the path as an absolute one also prevents it from escaping the path of the relay chain with `..`
+	relayPath := path.Join(base.Path, path.Clean("/"+ref.Path))
+	if strings.HasSuffix(ref.Path, "/") && !strings.HasSuffix(relayPath, "/") {
+		relayPath += "/"
+	}
+	relayURL := base.ResolveReference(&url.URL{Path: relayPath, RawQuery: ref.RawQuery})
+	if relayURL.Scheme != base.Scheme || relayURL.Host != base.Host {
+		return "", fmt.Errorf("invalid relay path %q: the relay chain host cannot be changed", httpPath)
+	}
+	return relayURL.String(), nil
 }
 
 // Report volume metric applicable relays to Fisherman
-func (r *relay) ReportVolumeMetrics(fishermanServiceURL string, volumeRelays []Relay) types.Error {
+func (r *relay) ReportVolumeMetrics(fishermanServiceURL string, volumeRelays []*coreTypes.Relay) types.Error {
 
 	// Send all volume applicable relays to the assigned trusted Fisherman for
 	// a proper verification of the volume completed. Send volumeRelays to fishermanServiceURL
 	// through http.
 
-	// NOTE: an alternative design is a 2 step, claim - proof lifecycle where the individual servicers
+	// NOTE: the alternative design is a 2 step, claim - proof lifecycle where the individual servicers
 	// build a merkle sum index tree from all the relays, submits a root and subsequent merkle proof to the
-	// network.
+	// network (see `Servicer.CreateClaims` and `Servicer.CreateProof`).
 	//
 	// Pros: Can report volume metrics directly to the chain in a trustless fashion
 	// Cons: Large chain bloat, non-trivial compute requirement for creation of claim/proof transactions and trees,
@@ -136,13 +392,213 @@ func (r *relay) ReportVolumeMetrics(fishermanServiceURL string, volumeRelays []R
 	return nil
 }
 
-func (r *relay) GetData() string                        { return "" }
-func (r *relay) GetMethod() string                      { return "" }
-func (r *relay) GetHTTPPath() string                    { return "" }
-func (r *relay) GetHeaders() map[string]string          { return nil }
-func (r *relay) GetBlockHeight() int64                  { return 0 }
-func (r *relay) GetServicerPublicKey() crypto.PublicKey { return nil }
-func (r *relay) GetRelayChain() RelayChain              { return nil }
-func (r *relay) GetGeoZone() GeoZone                    { return nil }
-func (r *relay) GetToken() AAT                          { return nil }
-func (r *relay) GetSignature() string                   { return "" }
+// ValidateRelaySignatures verifies that the token of `relay` was signed by the application and the relay by the client
+// the token was issued to
+func ValidateRelaySignatures(relay *coreTypes.Relay) types.Error {
+	token := relay.GetMeta().GetToken()
+	if token == nil {
+		return types.ErrInvalidAAT("empty token")
+	}
+	if token.GetVersion() != AATVersion {
+		return types.ErrInvalidAAT(fmt.Sprintf("unsupported version %s", token.GetVersion()))
+	}
+
+	appPubKey, err := crypto.NewPublicKey(token.GetApplicationPublicKey())
+	if err != nil {
+		return types.ErrInvalidAAT(fmt.Sprintf("invalid application public key: %s", err.Error()))
+	}
+	tokenBz, err := token.SignableBytes()
+	if err != nil {
+		return types.ErrProtoMarshal(err)
+	}
+	if !verifyHexSignature(appPubKey, tokenBz, token.GetApplicationSignature()) {
+		return types.ErrInvalidAAT("application signature verification failed")
+	}
+
+	clientPubKey, err := crypto.NewPublicKey(token.GetClientPublicKey())
+	if err != nil {
+		return types.ErrInvalidAAT(fmt.Sprintf("invalid client public key: %s", err.Error()))
+	}
+	relayBz, err := relay.SignableBytes()
+	if err != nil {
+		return types.ErrProtoMarshal(err)
+	}
+	if !verifyHexSignature(clientPubKey, relayBz, relay.GetMeta().GetSignature()) {
+		return types.ErrSignatureVerificationFailed()
+	}
+	return nil
+}
+
+// ValidateTestResultSignatures verifies that the test relay of `result` was signed by the fisherman that sent it and,
+// if the servicer answered it, that the response was signed by the servicer
+func ValidateTestResultSignatures(result *types.TestResult, fishermanPublicKey, servicerPublicKey string) types.Error {
+	fishPubKey, err := crypto.NewPublicKey(fishermanPublicKey)
+	if err != nil {
+		return types.ErrNewPublicKeyFromBytes(err)
+	}
+	relayBz, err := result.GetRelay().SignableBytes()
+	if err != nil {
+		return types.ErrProtoMarshal(err)
+	}
+	if !verifyHexSignature(fishPubKey, relayBz, result.GetRelay().GetMeta().GetSignature()) {
+		return types.ErrSignatureVerificationFailed()
+	}
+
+	// an empty response records that the servicer did not answer the test relay
+	response := result.GetResponse()
+	if response == nil {
+		return nil
+	}
+	servicerPubKey, err := crypto.NewPublicKey(servicerPublicKey)
+	if err != nil {
+		return types.ErrNewPublicKeyFromBytes(err)
+	}
+	responseBz, err := response.SignableBytes()
+	if err != nil {
+		return types.ErrProtoMarshal(err)
+	}
+	if !verifyHexSignature(servicerPubKey, responseBz, response.GetServicerSignature()) {
+		return types.ErrSignatureVerificationFailed()
+	}
+	return nil
+}
+
+// dispatchAppSession returns the session of the application with public key `appPublicKey` at `height`. The application
+// is loaded as it was when the session started, so editing its stake does not change the relays it can send during the session.
+func (s *Servicer) dispatchAppSession(readCtx modules.PersistenceReadContext, height int64, appPublicKey, relayChain, geoZone string) (Session, types.Error) {
+	appPubKey, err := crypto.NewPublicKey(appPublicKey)
+	if err != nil {
+		return nil, types.ErrNewPublicKeyFromBytes(err)
+	}
+	blocksPerSession, er := getBlocksPerSession(readCtx, height)
+	if er != nil {
+		return nil, er
+	}
+	sessionHeight := height - height%blocksPerSession
+	application, err := readCtx.GetActor(coreTypes.ActorType_ACTOR_TYPE_APP, appPubKey.Address(), sessionHeight)
+	if err != nil {
+		return nil, types.ErrInvalidAAT(fmt.Sprintf("the application is not staked: %s", err.Error()))
+	}
+	return s.dispatchSession(readCtx, height, relayChain, geoZone, application)
+}
+
+// getBlocksPerSession returns the number of blocks of the session containing `height`
+func getBlocksPerSession(readCtx modules.PersistenceReadContext, height int64) (int64, types.Error) {
+	blocksPerSession, err := readCtx.GetIntParam(types.BlocksPerSessionParamName, height)
+	if err != nil {
+		return 0, types.ErrGetParam(types.BlocksPerSessionParamName, err)
+	}
+	if blocksPerSession <= 0 {
+		return 0, types.ErrInvalidBlocksPerSession(blocksPerSession)
+	}
+	return int64(blocksPerSession), nil
+}
+
+// relayCountKey identifies the session of an application for a relay chain and a geo zone among the sessions that
+// start at the same height
+func relayCountKey(appPublicKey, relayChain, geoZone string) string {
+	return strings.Join([]string{strings.ToLower(appPublicKey), relayChain, geoZone}, "/")
+}
+
+// getRelayCount returns the number of relays serviced during `session`, identified by `key` (see `relayCountKey`)
+func (s *Servicer) getRelayCount(session Session, key string) (int64, types.Error) {
+	s.relayCountsMu.Lock()
+	defer s.relayCountsMu.Unlock()
+	if session.GetSessionHeight() < s.relayCountsHeight {
+		relayCounts, err := s.countStoredRelays(session.GetSessionHeight())
+		if err != nil {
+			return 0, err
+		}
+		return relayCounts[key], nil
+	}
+	if err := s.loadRelayCounts(session.GetSessionHeight()); err != nil {
+		return 0, err
+	}
+	return s.relayCounts[key], nil
+}
+
+// reserveRelay counts the relay with `meta` towards the relays serviced during `session`, unless the servicer already
+// serviced, or is servicing, as many relays as the session allows
+func (s *Servicer) reserveRelay(session Session, meta *coreTypes.RelayMeta) types.Error {
+	s.relayCountsMu.Lock()
+	defer s.relayCountsMu.Unlock()
+	// The counts of the previous sessions were discarded when the current sessions started
+	if session.GetSessionHeight() < s.relayCountsHeight {
+		return types.ErrRelayOutOfSession(meta.GetBlockHeight(), s.relayCountsHeight)
+	}
+	if err := s.loadRelayCounts(session.GetSessionHeight()); err != nil {
+		return err
+	}
+	key := relayCountKey(meta.GetToken().GetApplicationPublicKey(), meta.GetRelayChain(), meta.GetGeoZone())
+	if big.NewInt(s.relayCounts[key]).Cmp(session.GetMaxRelaysPerServicer()) >= 0 {
+		return types.ErrRelayLimitExceeded(session.GetMaxRelaysPerServicer().String())
+	}
+	s.relayCounts[key]++
+	return nil
+}
+
+// releaseRelay stops counting the relay with `meta` reserved with `reserveRelay`, as it could not be serviced
+func (s *Servicer) releaseRelay(session Session, meta *coreTypes.RelayMeta) {
+	s.relayCountsMu.Lock()
+	defer s.relayCountsMu.Unlock()
+	key := relayCountKey(meta.GetToken().GetApplicationPublicKey(), meta.GetRelayChain(), meta.GetGeoZone())
+	if session.GetSessionHeight() == s.relayCountsHeight && s.relayCounts[key] > 0 {
+		s.relayCounts[key]--
+	}
+}
+
+// loadRelayCounts replaces the relay counts with those of the sessions that started at `sessionHeight` the first time
+// they are seen. All sessions start and end at the same heights, so the counts of the previous sessions are discarded.
+// Must be called with `relayCountsMu` held.
+func (s *Servicer) loadRelayCounts(sessionHeight int64) types.Error {
+	if s.relayCounts != nil && sessionHeight == s.relayCountsHeight {
+		return nil
+	}
+	relayCounts, err := s.countStoredRelays(sessionHeight)
+	if err != nil {
+		return err
+	}
+	s.relayCountsHeight = sessionHeight
+	s.relayCounts = relayCounts
+	return nil
+}
+
+// countStoredRelays returns the number of relays stored for each session that started at `sessionHeight`
+func (s *Servicer) countStoredRelays(sessionHeight int64) (map[string]int64, types.Error) {
+	relays, err := s.relayStore.GetAll(sessionHeight)
+	if err != nil {
+		return nil, types.ErrRelayStore(err)
+	}
+	relayCounts := make(map[string]int64)
+	for _, relay := range relays {
+		meta := relay.GetMeta()
+		relayCounts[relayCountKey(meta.GetToken().GetApplicationPublicKey(), meta.GetRelayChain(), meta.GetGeoZone())]++
+	}
+	return relayCounts, nil
+}
+
+// getVolumeSecret returns the secret the hash of the volume metric applicable relays of a session must end with,
+// selected pseudorandomly by the hash of the last block of the session
+func getVolumeSecret(lastBlockHash string) string {
+	if len(lastBlockHash) < volumeSecretLength {
+		return lastBlockHash
+	}
+	return lastBlockHash[len(lastBlockHash)-volumeSecretLength:]
+}
+
+func verifyHexSignature(publicKey crypto.PublicKey, msg []byte, signatureHex string) bool {
+	signature, err := hex.DecodeString(signatureHex)
+	if err != nil {
+		return false
+	}
+	return publicKey.Verify(msg, signature)
+}
+
+func isActorInList(publicKey string, actors []*coreTypes.Actor) bool {
+	for _, actor := range actors {
+		if actor.GetPublicKey() == publicKey {
+			return true
+		}
+	}
+	return false
+}
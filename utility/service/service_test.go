@@ -0,0 +1,555 @@
+package service
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/modules"
+	mockModules "github.com/pokt-network/pocket/shared/modules/mocks"
+	"github.com/pokt-network/pocket/utility/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	testRelayChain       = "0001"
+	testGeoZone          = "0"
+	testBlocksPerSession = 4
+	testSessionHeight    = 8
+	testHeight           = 10
+)
+
+type testSession struct {
+	id        []byte
+	height    int64
+	servicers []*coreTypes.Actor
+	maxRelays int64
+}
+
+func (s *testSession) GetSessionID() []byte              { return s.id }
+func (s *testSession) GetSessionHeight() int64           { return s.height }
+func (s *testSession) GetServicers() []*coreTypes.Actor  { return s.servicers }
+func (s *testSession) GetMaxRelaysPerServicer() *big.Int { return big.NewInt(s.maxRelays) }
+
+type testServicerEnv struct {
+	servicerKey crypto.PrivateKey
+	appKey      crypto.PrivateKey
+	clientKey   crypto.PrivateKey
+	servicer    *Servicer
+	readCtx     modules.PersistenceReadContext
+	session     *testSession
+}
+
+func TestServicer_HandleRelay(t *testing.T) {
+	chain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		require.Equal(t, http.MethodPost, req.Method)
+		require.Equal(t, "/v1/query/height", req.URL.Path)
+		require.Equal(t, "application/json", req.Header.Get("Content-Type"))
+		require.Equal(t, `{"jsonrpc":"2.0"}`, string(body))
+		_, _ = w.Write([]byte(`{"height":10}`))
+	}))
+	defer chain.Close()
+
+	env := newTestServicerEnv(t, chain.URL, 100)
+	relay := newTestRelay(t, env, testHeight)
+
+	response, err := env.servicer.HandleRelay(env.readCtx, testHeight, relay)
+	require.NoError(t, err)
+	require.Equal(t, `{"height":10}`, response.GetPayload())
+
+	signableBz, er := response.SignableBytes()
+	require.NoError(t, er)
+	signature, er := hex.DecodeString(response.GetServicerSignature())
+	require.NoError(t, er)
+	require.True(t, env.servicerKey.PublicKey().Verify(signableBz, signature))
+}
+
+func TestServicer_HandleRelay_Validation(t *testing.T) {
+	chain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer chain.Close()
+
+	tests := []struct {
+		name         string
+		modifyRelay  func(t *testing.T, env *testServicerEnv, relay *coreTypes.Relay)
+		modifyEnv    func(env *testServicerEnv)
+		expectedCode types.Code
+	}{
+		{
+			name: "empty payload",
+			modifyRelay: func(t *testing.T, env *testServicerEnv, relay *coreTypes.Relay) {
+				relay.Payload = nil
+			},
+			expectedCode: types.CodeInvalidRelayError,
+		},
+		{
+			name: "relay addressed to another servicer",
+			modifyRelay: func(t *testing.T, env *testServicerEnv, relay *coreTypes.Relay) {
+				relay.Meta.ServicerPublicKey = env.appKey.PublicKey().String()
+				signTestRelay(t, env.clientKey, relay)
+			},
+			expectedCode: types.CodeInvalidRelayError,
+		},
+		{
+			name: "relay chain not supported locally",
+			modifyRelay: func(t *testing.T, env *testServicerEnv, relay *coreTypes.Relay) {
+				relay.Meta.RelayChain = "0002"
+				signTestRelay(t, env.clientKey, relay)
+			},
+			expectedCode: types.CodeUnsupportedRelayChainError,
+		},
+		{
+			name: "token not signed by the application",
+			modifyRelay: func(t *testing.T, env *testServicerEnv, relay *coreTypes.Relay) {
+				signTestAAT(t, env.clientKey, relay.Meta.Token)
+				signTestRelay(t, env.clientKey, relay)
+			},
+			expectedCode: types.CodeInvalidAATError,
+		},
+		{
+			name: "relay not signed by the client",
+			modifyRelay: func(t *testing.T, env *testServicerEnv, relay *coreTypes.Relay) {
+				signTestRelay(t, env.appKey, relay)
+			},
+			expectedCode: types.CodeSignatureVerificationFailedError,
+		},
+		{
+			name: "relay from a previous session",
+			modifyRelay: func(t *testing.T, env *testServicerEnv, relay *coreTypes.Relay) {
+				relay.Meta.BlockHeight = testSessionHeight - 1
+				signTestRelay(t, env.clientKey, relay)
+			},
+			expectedCode: types.CodeRelayOutOfSessionError,
+		},
+		{
+			name: "servicer not in the session",
+			modifyEnv: func(env *testServicerEnv) {
+				env.session.servicers = nil
+			},
+			expectedCode: types.CodeServicerNotInSessionError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestServicerEnv(t, chain.URL, 100)
+			relay := newTestRelay(t, env, testHeight)
+			if tt.modifyRelay != nil {
+				tt.modifyRelay(t, env, relay)
+			}
+			if tt.modifyEnv != nil {
+				tt.modifyEnv(env)
+			}
+
+			_, err := env.servicer.HandleRelay(env.readCtx, testHeight, relay)
+			require.Error(t, err)
+			require.Equal(t, tt.expectedCode, err.Code())
+		})
+	}
+}
+
+func TestServicer_HandleRelay_RelayLimit(t *testing.T) {
+	chain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer chain.Close()
+
+	// Relays must be unique to be serviced, so each one carries a different payload
+	nonce := 0
+	newUniqueRelay := func(env *testServicerEnv, height int64) *coreTypes.Relay {
+		nonce++
+		relay := newTestRelay(t, env, height)
+		relay.Payload.Data = fmt.Sprintf(`{"jsonrpc":"2.0","id":%d}`, nonce)
+		signTestRelay(t, env.clientKey, relay)
+		return relay
+	}
+
+	// The session of the application allows it to send 2 relays to the servicer
+	env := newTestServicerEnv(t, chain.URL, 2)
+	requireRelayBudget(t, env, 2, 2)
+	for i := 0; i < 2; i++ {
+		_, err := env.servicer.HandleRelay(env.readCtx, testHeight, newUniqueRelay(env, testHeight))
+		require.NoError(t, err)
+	}
+	requireRelayBudget(t, env, 2, 0)
+	_, err := env.servicer.HandleRelay(env.readCtx, testHeight, newUniqueRelay(env, testHeight))
+	require.Error(t, err)
+	require.Equal(t, types.CodeRelayLimitExceededError, err.Code())
+
+	// The count is reset once the next session starts
+	env.session.height += testBlocksPerSession
+	_, err = env.servicer.HandleRelay(env.readCtx, testHeight+testBlocksPerSession, newUniqueRelay(env, testHeight+testBlocksPerSession))
+	require.NoError(t, err)
+}
+
+func TestServicer_HandleRelay_RelayLimit_Concurrent(t *testing.T) {
+	chain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer chain.Close()
+
+	// The relays are sent concurrently, so all of them are validated before any of them is stored
+	env := newTestServicerEnv(t, chain.URL, 2)
+	relays := make([]*coreTypes.Relay, 10)
+	for i := range relays {
+		relays[i] = newTestRelay(t, env, testHeight)
+		relays[i].Payload.Data = fmt.Sprintf(`{"jsonrpc":"2.0","id":%d}`, i)
+		signTestRelay(t, env.clientKey, relays[i])
+	}
+
+	var wg sync.WaitGroup
+	var serviced int32
+	for _, relay := range relays {
+		wg.Add(1)
+		go func(relay *coreTypes.Relay) {
+			defer wg.Done()
+			if _, err := env.servicer.HandleRelay(env.readCtx, testHeight, relay); err == nil {
+				atomic.AddInt32(&serviced, 1)
+			}
+		}(relay)
+	}
+	wg.Wait()
+
+	require.Equal(t, int32(2), serviced)
+	requireRelayBudget(t, env, 2, 0)
+}
+
+func TestServicer_HandleRelay_RelayLimit_Restart(t *testing.T) {
+	chain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer chain.Close()
+
+	env := newTestServicerEnv(t, chain.URL, 2)
+	for i := 0; i < 2; i++ {
+		relay := newTestRelay(t, env, testHeight)
+		relay.Payload.Data = fmt.Sprintf(`{"jsonrpc":"2.0","id":%d}`, i)
+		signTestRelay(t, env.clientKey, relay)
+		_, err := env.servicer.HandleRelay(env.readCtx, testHeight, relay)
+		require.NoError(t, err)
+	}
+
+	// A servicer restarted mid-session counts the relays it already stored for the session
+	env.servicer = NewServicer(env.servicerKey, env.servicer.chainURLs, env.servicer.relayStore, env.servicer.dispatchSession)
+	requireRelayBudget(t, env, 2, 0)
+	_, err := env.servicer.HandleRelay(env.readCtx, testHeight, newTestRelay(t, env, testHeight))
+	require.Error(t, err)
+	require.Equal(t, types.CodeRelayLimitExceededError, err.Code())
+}
+
+func TestServicer_HandleRelay_ExecutionFailure(t *testing.T) {
+	chain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(make([]byte, maxRelayResponseBytes+1))
+	}))
+	defer chain.Close()
+
+	// Responses exceeding the size limit are not serviced, and do not count towards the limit of the session
+	env := newTestServicerEnv(t, chain.URL, 2)
+	_, err := env.servicer.HandleRelay(env.readCtx, testHeight, newTestRelay(t, env, testHeight))
+	require.Error(t, err)
+	require.Equal(t, types.CodeRelayExecutionError, err.Code())
+	requireRelayBudget(t, env, 2, 2)
+}
+
+func TestServicer_HandleRelay_ChainErrorStatus(t *testing.T) {
+	chain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer chain.Close()
+
+	// Failed executions are not stored, and do not count towards the limit of the session
+	env := newTestServicerEnv(t, chain.URL, 2)
+	relay := newTestRelay(t, env, testHeight)
+	_, err := env.servicer.HandleRelay(env.readCtx, testHeight, relay)
+	require.Error(t, err)
+	require.Equal(t, types.CodeRelayExecutionError, err.Code())
+	requireRelayBudget(t, env, 2, 2)
+
+	relayHash, er := relay.Hash()
+	require.NoError(t, er)
+	exists, er := env.servicer.relayStore.Exists(testSessionHeight, relayHash)
+	require.NoError(t, er)
+	require.False(t, exists)
+}
+
+func TestServicer_HandleRelay_RelayPath(t *testing.T) {
+	var requestedPaths []string
+	chain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requestedPaths = append(requestedPaths, req.URL.RequestURI())
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer chain.Close()
+
+	// The client controls the path of the relay, but not the host it is sent to
+	env := newTestServicerEnv(t, chain.URL+"/v1", 100)
+	for _, httpPath := range []string{"@evil.host/", "/../../admin", "/query?height=1"} {
+		relay := newTestRelay(t, env, testHeight)
+		relay.Payload.HttpPath = httpPath
+		signTestRelay(t, env.clientKey, relay)
+		_, err := env.servicer.HandleRelay(env.readCtx, testHeight, relay)
+		require.NoError(t, err)
+	}
+	require.Equal(t, []string{"/v1/@evil.host/", "/v1/admin", "/v1/query?height=1"}, requestedPaths)
+
+	for _, httpPath := range []string{".internal:8080/", "//evil.host/", "http://evil.host/", "https://user@evil.host"} {
+		relay := newTestRelay(t, env, testHeight)
+		relay.Payload.HttpPath = httpPath
+		signTestRelay(t, env.clientKey, relay)
+		_, err := env.servicer.HandleRelay(env.readCtx, testHeight, relay)
+		require.Error(t, err)
+		require.Equal(t, types.CodeRelayExecutionError, err.Code())
+	}
+	require.Len(t, requestedPaths, 3)
+}
+
+func TestServicer_HandleRelay_Duplicate(t *testing.T) {
+	chain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer chain.Close()
+
+	env := newTestServicerEnv(t, chain.URL, 100)
+	relay := newTestRelay(t, env, testHeight)
+
+	_, err := env.servicer.HandleRelay(env.readCtx, testHeight, relay)
+	require.NoError(t, err)
+
+	_, err = env.servicer.HandleRelay(env.readCtx, testHeight, relay)
+	require.Error(t, err)
+	require.Equal(t, types.CodeInvalidRelayError, err.Code())
+}
+
+func TestServicer_ReapStoreForHashCollision(t *testing.T) {
+	chain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer chain.Close()
+
+	env := newTestServicerEnv(t, chain.URL, 100)
+	relay := newTestRelay(t, env, testHeight)
+	_, err := env.servicer.HandleRelay(env.readCtx, testHeight, relay)
+	require.NoError(t, err)
+
+	relayHash, er := relay.Hash()
+	require.NoError(t, er)
+	hashEndWith := hex.EncodeToString(relayHash)[2*len(relayHash)-2:]
+
+	reaped, err := env.servicer.ReapStoreForHashCollision(testSessionHeight, hashEndWith)
+	require.NoError(t, err)
+	require.Len(t, reaped, 1)
+	require.True(t, proto.Equal(relay, reaped[0]))
+
+	require.NoError(t, env.servicer.PruneRelayStore(testSessionHeight+testBlocksPerSession))
+	reaped, err = env.servicer.ReapStoreForHashCollision(testSessionHeight, hashEndWith)
+	require.NoError(t, err)
+	require.Empty(t, reaped)
+}
+
+func TestServicer_HandleSessionEnd(t *testing.T) {
+	chain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer chain.Close()
+
+	env := newTestServicerEnv(t, chain.URL, 100)
+	serviceUniqueRelays := func(height int64, count int) []*coreTypes.Relay {
+		relays := make([]*coreTypes.Relay, count)
+		for i := range relays {
+			relays[i] = newTestRelay(t, env, height)
+			relays[i].Payload.Data = fmt.Sprintf(`{"jsonrpc":"2.0","id":%d}`, i)
+			signTestRelay(t, env.clientKey, relays[i])
+			_, err := env.servicer.HandleRelay(env.readCtx, height, relays[i])
+			require.NoError(t, err)
+		}
+		return relays
+	}
+
+	// Relays are serviced during the previous session and the session ending with the block at `lastSessionBlock`
+	env.session.height = testSessionHeight - testBlocksPerSession
+	serviceUniqueRelays(testSessionHeight-testBlocksPerSession, 3)
+	env.session.height = testSessionHeight
+	relays := serviceUniqueRelays(testSessionHeight, 20)
+	lastSessionBlock := int64(testSessionHeight + testBlocksPerSession - 1)
+
+	// The session is reaped at the end of the first session starting after its claims can no longer be proven:
+	// claims are committed until the end of the following session and proven within `claimExpirationBlocks`
+	claimExpirationBlocks := 4
+	reapHeight := int64(testSessionHeight + 3*testBlocksPerSession - 1)
+
+	// The secret is the last hex character of the hash of the last block of the session
+	blockHash := "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcde7"
+	readCtx := env.readCtx.(*mockModules.MockPersistenceReadContext)
+	readCtx.EXPECT().GetIntParam(types.ClaimExpirationBlocksParamName, gomock.Any()).Return(claimExpirationBlocks, nil).AnyTimes()
+	readCtx.EXPECT().GetBlockHash(gomock.Any()).Return(blockHash, nil).AnyTimes()
+	expectedVolumeRelays := make([]*coreTypes.Relay, 0)
+	for _, relay := range relays {
+		relayHash, er := relay.Hash()
+		require.NoError(t, er)
+		if strings.HasSuffix(hex.EncodeToString(relayHash), "7") {
+			expectedVolumeRelays = append(expectedVolumeRelays, relay)
+		}
+	}
+
+	// The session is not reaped while its claims can still be proven
+	for height := lastSessionBlock; height < reapHeight; height++ {
+		_, err := env.servicer.HandleSessionEnd(env.readCtx, height)
+		require.NoError(t, err)
+		sessionRelays, err := env.servicer.ReapStoreForHashCollision(testSessionHeight, "")
+		require.NoError(t, err)
+		require.Len(t, sessionRelays, len(relays))
+	}
+
+	volumeRelays, err := env.servicer.HandleSessionEnd(env.readCtx, reapHeight)
+	require.NoError(t, err)
+	require.Len(t, volumeRelays, len(expectedVolumeRelays))
+	for _, relay := range expectedVolumeRelays {
+		require.True(t, containsRelay(volumeRelays, relay))
+	}
+
+	// Only the volume applicable relays of the ended session are kept, and the previous session is pruned
+	remainingRelays, err := env.servicer.ReapStoreForHashCollision(testSessionHeight, "")
+	require.NoError(t, err)
+	require.Len(t, remainingRelays, len(expectedVolumeRelays))
+	remainingRelays, err = env.servicer.ReapStoreForHashCollision(testSessionHeight-testBlocksPerSession, "")
+	require.NoError(t, err)
+	require.Empty(t, remainingRelays)
+}
+
+func containsRelay(relays []*coreTypes.Relay, relay *coreTypes.Relay) bool {
+	for _, r := range relays {
+		if proto.Equal(r, relay) {
+			return true
+		}
+	}
+	return false
+}
+
+func requireRelayBudget(t *testing.T, env *testServicerEnv, expectedMaxRelays, expectedRemainingRelays int64) {
+	t.Helper()
+	maxRelays, remainingRelays, err := env.servicer.GetRelayBudget(env.readCtx, testHeight, env.appKey.PublicKey().String(), testRelayChain, testGeoZone)
+	require.NoError(t, err)
+	require.Equal(t, expectedMaxRelays, maxRelays.Int64())
+	require.Equal(t, expectedRemainingRelays, remainingRelays.Int64())
+}
+
+func newTestServicerEnv(t *testing.T, chainURL string, maxRelays int64) *testServicerEnv {
+	t.Helper()
+	env := &testServicerEnv{
+		servicerKey: newTestPrivateKey(t),
+		appKey:      newTestPrivateKey(t),
+		clientKey:   newTestPrivateKey(t),
+	}
+
+	application := &coreTypes.Actor{
+		ActorType: coreTypes.ActorType_ACTOR_TYPE_APP,
+		Address:   env.appKey.Address().String(),
+		PublicKey: env.appKey.PublicKey().String(),
+		Chains:    []string{testRelayChain},
+	}
+	env.session = &testSession{
+		id:     []byte("session"),
+		height: testSessionHeight,
+		servicers: []*coreTypes.Actor{{
+			ActorType: coreTypes.ActorType_ACTOR_TYPE_SERVICER,
+			Address:   env.servicerKey.Address().String(),
+			PublicKey: env.servicerKey.PublicKey().String(),
+			Chains:    []string{testRelayChain},
+		}},
+		maxRelays: maxRelays,
+	}
+
+	ctrl := gomock.NewController(t)
+	readCtx := mockModules.NewMockPersistenceReadContext(ctrl)
+	// The application edits its stake during the sessions, which must only be dispatched with the stake they started with
+	editedApplication := proto.Clone(application).(*coreTypes.Actor)
+	editedApplication.StakedAmount = "1000000"
+	readCtx.EXPECT().GetActor(coreTypes.ActorType_ACTOR_TYPE_APP, env.appKey.Address().Bytes(), gomock.Any()).DoAndReturn(func(_ coreTypes.ActorType, _ []byte, height int64) (*coreTypes.Actor, error) {
+		if height%testBlocksPerSession == 0 {
+			return application, nil
+		}
+		return editedApplication, nil
+	}).AnyTimes()
+	readCtx.EXPECT().GetIntParam(types.BlocksPerSessionParamName, gomock.Any()).Return(testBlocksPerSession, nil).AnyTimes()
+	env.readCtx = readCtx
+
+	dispatchSession := func(_ modules.PersistenceReadContext, _ int64, relayChain, geoZone string, app *coreTypes.Actor) (Session, types.Error) {
+		require.Equal(t, testRelayChain, relayChain)
+		require.Equal(t, testGeoZone, geoZone)
+		require.Equal(t, application, app)
+		return env.session, nil
+	}
+	relayStore, err := NewMemRelayStore()
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, relayStore.Stop()) })
+
+	env.servicer = NewServicer(env.servicerKey, map[string]string{testRelayChain: chainURL}, relayStore, dispatchSession)
+	return env
+}
+
+func newTestRelay(t *testing.T, env *testServicerEnv, height int64) *coreTypes.Relay {
+	t.Helper()
+	token := &coreTypes.AAT{
+		Version:              AATVersion,
+		ApplicationPublicKey: env.appKey.PublicKey().String(),
+		ClientPublicKey:      env.clientKey.PublicKey().String(),
+	}
+	signTestAAT(t, env.appKey, token)
+
+	relay := &coreTypes.Relay{
+		Payload: &coreTypes.RelayPayload{
+			Data:     `{"jsonrpc":"2.0"}`,
+			Method:   http.MethodPost,
+			HttpPath: "/v1/query/height",
+			Headers:  map[string]string{"Content-Type": "application/json"},
+		},
+		Meta: &coreTypes.RelayMeta{
+			BlockHeight:       height,
+			ServicerPublicKey: env.servicerKey.PublicKey().String(),
+			RelayChain:        testRelayChain,
+			GeoZone:           testGeoZone,
+			Token:             token,
+		},
+	}
+	signTestRelay(t, env.clientKey, relay)
+	return relay
+}
+
+func signTestAAT(t *testing.T, privateKey crypto.PrivateKey, token *coreTypes.AAT) {
+	t.Helper()
+	signableBz, err := token.SignableBytes()
+	require.NoError(t, err)
+	signature, err := privateKey.Sign(signableBz)
+	require.NoError(t, err)
+	token.ApplicationSignature = hex.EncodeToString(signature)
+}
+
+func signTestRelay(t *testing.T, privateKey crypto.PrivateKey, relay *coreTypes.Relay) {
+	t.Helper()
+	signableBz, err := relay.SignableBytes()
+	require.NoError(t, err)
+	signature, err := privateKey.Sign(signableBz)
+	require.NoError(t, err)
+	relay.Meta.Signature = hex.EncodeToString(signature)
+}
+
+func newTestPrivateKey(t *testing.T) crypto.PrivateKey {
+	t.Helper()
+	privateKey, err := crypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	return privateKey
+}
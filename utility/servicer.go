@@ -0,0 +1,134 @@
+package utility
+
+import (
+	"math/big"
+
+	"github.com/pokt-network/pocket/runtime/configs"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/modules"
+	"github.com/pokt-network/pocket/utility/service"
+	"github.com/pokt-network/pocket/utility/session"
+	"github.com/pokt-network/pocket/utility/types"
+)
+
+// HandleRelay implements the exposed functionality of the shared utilityModule interface.
+func (u *utilityModule) HandleRelay(relay *coreTypes.Relay) (*coreTypes.RelayResponse, error) {
+	if u.servicer == nil {
+		return nil, types.ErrServicerDisabled()
+	}
+
+	height := u.getLatestCommittedHeight()
+	readCtx, err := u.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return nil, err
+	}
+	defer readCtx.Release()
+
+	response, er := u.servicer.HandleRelay(readCtx, height, relay)
+	if er != nil {
+		return nil, er
+	}
+	return response, nil
+}
+
+// GetRelayBudget implements the exposed functionality of the shared utilityModule interface.
+func (u *utilityModule) GetRelayBudget(appPublicKey, relayChain, geoZone string) (maxRelays, remainingRelays *big.Int, err error) {
+	if u.servicer == nil {
+		return nil, nil, types.ErrServicerDisabled()
+	}
+
+	height := u.getLatestCommittedHeight()
+	readCtx, err := u.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer readCtx.Release()
+
+	maxRelays, remainingRelays, er := u.servicer.GetRelayBudget(readCtx, height, appPublicKey, relayChain, geoZone)
+	if er != nil {
+		return nil, nil, er
+	}
+	return maxRelays, remainingRelays, nil
+}
+
+// handleServicerNewHeight submits the claims of the sessions that ended with the block preceding `height` and the
+// proofs of the claims committed so far, then reaps and prunes the relays of the sessions that can no longer be proven
+func (u *utilityModule) handleServicerNewHeight(height uint64) error {
+	if u.servicer == nil || height == 0 {
+		return nil
+	}
+
+	committedHeight := int64(height) - 1
+	readCtx, err := u.GetBus().GetPersistenceModule().NewReadContext(committedHeight)
+	if err != nil {
+		return err
+	}
+	defer readCtx.Release()
+
+	// the relays are reaped even if some claims or proofs could not be submitted, which are retried while possible
+	for _, err := range u.servicer.SubmitVolumeTxs(readCtx, committedHeight, u.submitServicerTx) {
+		u.logger.Error().Err(err).Int64("height", committedHeight).Msg("Failed to submit a claim or proof transaction")
+	}
+
+	volumeRelays, er := u.servicer.HandleSessionEnd(readCtx, committedHeight)
+	if er != nil {
+		return er
+	}
+	if volumeRelays != nil {
+		u.logger.Info().
+			Int64("height", committedHeight).
+			Int("volume_relays", len(volumeRelays)).
+			Msg("Reaped the volume applicable relays of the ended sessions")
+	}
+	return nil
+}
+
+// submitServicerTx adds a transaction signed by the servicer to the mempool and gossips it to the network
+func (u *utilityModule) submitServicerTx(txBz []byte) error {
+	if err := u.HandleTransaction(txBz); err != nil {
+		return err
+	}
+	txGossipMsg, err := PrepareTxGossipMessage(txBz)
+	if err != nil {
+		return err
+	}
+	return u.GetBus().GetP2PModule().Broadcast(txGossipMsg)
+}
+
+// getLatestCommittedHeight returns the height relays are validated against, i.e. the last committed block, since the
+// block hash of the height consensus is currently working on is still unknown
+func (u *utilityModule) getLatestCommittedHeight() int64 {
+	height := int64(u.GetBus().GetConsensusModule().CurrentHeight())
+	if height > 0 {
+		height--
+	}
+	return height
+}
+
+// newServicer creates the servicer handling the relays sent to this node, using the node's servicer configuration
+func newServicer(servicerCfg *configs.ServicerConfig) (*service.Servicer, error) {
+	privateKey, err := crypto.NewPrivateKey(servicerCfg.GetPrivateKey())
+	if err != nil {
+		return nil, err
+	}
+	relayStore, err := service.NewRelayStore(servicerCfg.GetRelayStorePath())
+	if err != nil {
+		return nil, err
+	}
+	return service.NewServicer(privateKey, servicerCfg.GetChains(), relayStore, dispatchServicerSession), nil
+}
+
+// dispatchServicerSession adapts `NewSession` to the session dispatcher used by the servicer
+func dispatchServicerSession(
+	readCtx modules.PersistenceReadContext,
+	height int64,
+	relayChain, geoZone string,
+	application *coreTypes.Actor,
+) (service.Session, types.Error) {
+	s, err := session.NewSession(readCtx, height, session.RelayChain(relayChain), session.GeoZone(geoZone), application)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
@@ -0,0 +1,305 @@
+package session
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+	"sort"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/modules"
+	"github.com/pokt-network/pocket/shared/utils"
+	"github.com/pokt-network/pocket/utility/types"
+)
+
+// TODO: When implementing please review if block height tolerance (+,-1) is included in the session protocol: pokt-network/pocket-core#1464 CC @Olshansk
+
+// REFACTOR: Move these into `utility/types` and consider creating an enum
+type RelayChain string
+type GeoZone string
+
+type Session interface {
+	GetSessionID() []byte              // the identifier of the dispatched session
+	GetSessionHeight() int64           // the block height when the session started
+	GetRelayChain() RelayChain         // the web3 chain identifier
+	GetGeoZone() GeoZone               // the geo-location zone where the application is intending to operate during the session
+	GetApplication() *coreTypes.Actor  // the Application consuming the web3 access
+	GetServicers() []*coreTypes.Actor  // the Servicers providing Web3 to the application
+	GetFishermen() []*coreTypes.Actor  // the Fishermen monitoring the servicers
+	GetMaxRelaysPerServicer() *big.Int // the number of relays the application can send to each of the servicers
+}
+
+var _ Session = &session{}
+
+type session struct {
+	sessionId   []byte
+	height      int64
+	relayChain  RelayChain
+	geoZone     GeoZone
+	application *coreTypes.Actor
+	servicers   []*coreTypes.Actor
+	fishermen   []*coreTypes.Actor
+
+	maxRelaysPerServicer *big.Int
+}
+
+// NewSession dispatches the session that `application` belongs to at `height` for the provided `relayChain`
+// and `geoZone`. The session starts at the closest preceding height that is a multiple of the
+// `blocks_per_session` governance parameter, and its actors and parameters are selected deterministically from
+// the world state at that height so every node dispatching the same session arrives at the same result.
+// The session tokens of the application are split evenly between its servicers, each token being worth a relay.
+func NewSession(
+	readCtx modules.PersistenceReadContext,
+	height int64,
+	relayChain RelayChain,
+	geoZone GeoZone,
+	application *coreTypes.Actor,
+) (Session, types.Error) {
+	sessionHeight, er := GetSessionHeight(readCtx, height)
+	if er != nil {
+		return nil, er
+	}
+	if !isStakedForRelayChain(application, relayChain) {
+		return nil, types.ErrAppNotStakedForRelayChain(string(relayChain))
+	}
+
+	s := &session{
+		height:      sessionHeight,
+		relayChain:  relayChain,
+		geoZone:     geoZone,
+		application: application,
+	}
+
+	// the parameters of the session are those it started with, so changing them does not affect the ongoing sessions
+	numServicers, err := readCtx.GetIntParam(types.ServicersPerSessionParamName, s.height)
+	if err != nil {
+		return nil, types.ErrGetServicersPerSessionAt(s.height, err)
+	}
+	if numServicers < 0 {
+		return nil, types.ErrInvalidActorsPerSession(types.ServicersPerSessionParamName, numServicers)
+	}
+	numFishermen, err := readCtx.GetIntParam(types.FishermenPerSessionParamName, s.height)
+	if err != nil {
+		return nil, types.ErrGetParam(types.FishermenPerSessionParamName, err)
+	}
+	if numFishermen < 0 {
+		return nil, types.ErrInvalidActorsPerSession(types.FishermenPerSessionParamName, numFishermen)
+	}
+	stakeToSessionTokensMultiplier, err := readCtx.GetIntParam(types.AppSessionTokensMultiplierParamName, s.height)
+	if err != nil {
+		return nil, types.ErrGetParam(types.AppSessionTokensMultiplierParamName, err)
+	}
+
+	blockHash, err := readCtx.GetBlockHash(s.height)
+	if err != nil {
+		return nil, types.ErrGetBlockHash(err)
+	}
+
+	if s.sessionId, er = s.getSessionId(blockHash); er != nil {
+		return nil, er
+	}
+	if s.servicers, er = s.selectSessionServicers(readCtx, numServicers); er != nil {
+		return nil, er
+	}
+	if s.fishermen, er = s.selectSessionFishermen(readCtx, numFishermen); er != nil {
+		return nil, er
+	}
+	if s.maxRelaysPerServicer, er = s.getMaxRelaysPerServicer(stakeToSessionTokensMultiplier); er != nil {
+		return nil, er
+	}
+	return s, nil
+}
+
+// GetSessionHeight returns the height at which the session containing `height` started. The actors of the session,
+// such as its application, must be read at that height.
+func GetSessionHeight(readCtx modules.PersistenceReadContext, height int64) (int64, types.Error) {
+	blocksPerSession, err := readCtx.GetIntParam(types.BlocksPerSessionParamName, height)
+	if err != nil {
+		return 0, types.ErrGetParam(types.BlocksPerSessionParamName, err)
+	}
+	if blocksPerSession <= 0 {
+		return 0, types.ErrInvalidBlocksPerSession(blocksPerSession)
+	}
+	return getSessionHeight(height, int64(blocksPerSession)), nil
+}
+
+// CalculateAppSessionTokens determines the number of "session tokens" an application staking `appStakeStr` gets at
+// the beginning of every session, where 1 session token equates to a quota of 1 relay
+func CalculateAppSessionTokens(appStakeStr string, stakeToSessionTokensMultiplier int) (*big.Int, types.Error) {
+	appStake, err := utils.StringToBigInt(appStakeStr)
+	if err != nil {
+		return nil, types.ErrStringToBigInt(err)
+	}
+	return appStake.Mul(appStake, big.NewInt(int64(stakeToSessionTokensMultiplier))), nil
+}
+
+func (s *session) GetSessionID() []byte {
+	return s.sessionId
+}
+
+func (s *session) GetSessionHeight() int64 {
+	return s.height
+}
+
+func (s *session) GetRelayChain() RelayChain {
+	return s.relayChain
+}
+
+func (s *session) GetGeoZone() GeoZone {
+	return s.geoZone
+}
+
+func (s *session) GetApplication() *coreTypes.Actor {
+	return s.application
+}
+
+func (s *session) GetFishermen() []*coreTypes.Actor {
+	return s.fishermen
+}
+
+func (s *session) GetServicers() []*coreTypes.Actor {
+	return s.servicers
+}
+
+func (s *session) GetMaxRelaysPerServicer() *big.Int {
+	return new(big.Int).Set(s.maxRelaysPerServicer)
+}
+
+// getMaxRelaysPerServicer splits the session tokens of the application evenly between the servicers of the session
+func (s *session) getMaxRelaysPerServicer(stakeToSessionTokensMultiplier int) (*big.Int, types.Error) {
+	sessionTokens, err := CalculateAppSessionTokens(s.application.GetStakedAmount(), stakeToSessionTokensMultiplier)
+	if err != nil {
+		return nil, err
+	}
+	if len(s.servicers) == 0 {
+		return big.NewInt(0), nil
+	}
+	return sessionTokens.Div(sessionTokens, big.NewInt(int64(len(s.servicers)))), nil
+}
+
+// getSessionHeight returns the height at which the session containing `height` started
+func getSessionHeight(height, blocksPerSession int64) int64 {
+	return height - height%blocksPerSession
+}
+
+// use the seed information to determine a SHA3Hash that is used to find the closest N actors based
+// by comparing the sessionKey with the actors' public key
+func (s *session) getSessionId(blockHash string) ([]byte, types.Error) {
+	sessionHeightBz := make([]byte, 8)
+	binary.LittleEndian.PutUint64(sessionHeightBz, uint64(s.height))
+
+	blockHashBz, err := hex.DecodeString(blockHash)
+	if err != nil {
+		return nil, types.ErrHexDecodeFromString(err)
+	}
+
+	appPubKey, err := crypto.NewPublicKey(s.application.GetPublicKey())
+	if err != nil {
+		return nil, types.ErrNewPublicKeyFromBytes(err)
+	}
+
+	return crypto.SHA3Hash(concat(sessionHeightBz, blockHashBz, []byte(s.geoZone), []byte(s.relayChain), appPubKey.Bytes())), nil
+}
+
+// uses the current 'world state' to determine the servicers in the session
+// 1) get an ordered list of the public keys of servicers who are:
+//   - actively staked
+//   - staked within geo-zone, or without any geo-zone
+//   - staked for relay-chain
+//
+// 2) calls `pseudoRandomSelection(servicers, numberOfNodesPerSession)`
+func (s *session) selectSessionServicers(readCtx modules.PersistenceReadContext, numServicers int) ([]*coreTypes.Actor, types.Error) {
+	servicers, err := readCtx.GetAllServicers(s.height)
+	if err != nil {
+		return nil, types.ErrGetAllServicers(err)
+	}
+	return s.pseudoRandomSelection(s.filterSessionActors(servicers), numServicers)
+}
+
+// uses the current 'world state' to determine the fishermen in the session
+// 1) get an ordered list of the public keys of fishermen who are:
+//   - actively staked
+//   - staked within geo-zone, or without any geo-zone
+//   - staked for relay-chain
+//
+// 2) calls `pseudoRandomSelection(fishermen, numberOfFishPerSession)`
+func (s *session) selectSessionFishermen(readCtx modules.PersistenceReadContext, numFishermen int) ([]*coreTypes.Actor, types.Error) {
+	fishermen, err := readCtx.GetAllFishermen(s.height)
+	if err != nil {
+		return nil, types.ErrGetAllFishermen(err)
+	}
+	return s.pseudoRandomSelection(s.filterSessionActors(fishermen), numFishermen)
+}
+
+// filterSessionActors returns the subset of `actors` that are eligible to take part in the session:
+// they must be actively staked (i.e. neither paused nor unstaking), staked within the session's geo zone or without
+// any geo zone, and staked for the session's relay chain.
+func (s *session) filterSessionActors(actors []*coreTypes.Actor) []*coreTypes.Actor {
+	candidates := make([]*coreTypes.Actor, 0, len(actors))
+	for _, actor := range actors {
+		if actor.GetPausedHeight() != types.HeightNotUsed || actor.GetUnstakingHeight() != types.HeightNotUsed {
+			continue
+		}
+		if actor.GetGeoZone() != "" && actor.GetGeoZone() != string(s.geoZone) {
+			continue
+		}
+		if isStakedForRelayChain(actor, s.relayChain) {
+			candidates = append(candidates, actor)
+		}
+	}
+	return candidates
+}
+
+// isStakedForRelayChain returns whether `actor` is staked for `relayChain`
+func isStakedForRelayChain(actor *coreTypes.Actor, relayChain RelayChain) bool {
+	for _, chain := range actor.GetChains() {
+		if chain == string(relayChain) {
+			return true
+		}
+	}
+	return false
+}
+
+// 1) passed an ordered list of the public keys of actors and number of nodes
+// 2) pseudo-insert the session `key` string into the list and find the first actor directly below
+// 3) newKey = Hash( key + actor1PublicKey )
+// 4) repeat steps 2 and 3 until all N actor are found
+// FAQ:
+// Q) why do we hash to find a newKey between every actor selection?
+// A) pseudo-random selection only works if each iteration is re-randomized
+//
+//	or it would be subject to lexicographical proximity bias attacks
+func (s *session) pseudoRandomSelection(candidates []*coreTypes.Actor, numActorsToSelect int) ([]*coreTypes.Actor, types.Error) {
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].GetPublicKey() < candidates[j].GetPublicKey()
+	})
+
+	key := s.sessionId
+	selected := make([]*coreTypes.Actor, 0, numActorsToSelect)
+	for len(selected) < numActorsToSelect && len(candidates) > 0 {
+		keyHex := hex.EncodeToString(key)
+		// The first actor whose public key sorts after the key; wraps around to the start of the list
+		idx := sort.Search(len(candidates), func(i int) bool {
+			return candidates[i].GetPublicKey() > keyHex
+		}) % len(candidates)
+
+		actor := candidates[idx]
+		selected = append(selected, actor)
+		candidates = append(candidates[:idx], candidates[idx+1:]...)
+
+		actorPubKey, err := hex.DecodeString(actor.GetPublicKey())
+		if err != nil {
+			return nil, types.ErrHexDecodeFromString(err)
+		}
+		key = crypto.SHA3Hash(concat(key, actorPubKey))
+	}
+	return selected, nil
+}
+
+func concat(b ...[]byte) (result []byte) {
+	for _, bz := range b {
+		result = append(result, bz...)
+	}
+	return result
+}
@@ -0,0 +1,224 @@
+package session
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	mockModules "github.com/pokt-network/pocket/shared/modules/mocks"
+	"github.com/pokt-network/pocket/utility/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRelayChain       = RelayChain("0001")
+	testGeoZone          = GeoZone("0")
+	testBlocksPerSession = 4
+	testAppStake         = "1000"
+	// the number of session tokens an application gets for every token it stakes
+	testAppSessionTokensMultiplier = 3
+)
+
+func TestSession_NewSession(t *testing.T) {
+	app := newTestActor(t, coreTypes.ActorType_ACTOR_TYPE_APP, string(testRelayChain))
+	servicers := newTestActors(t, coreTypes.ActorType_ACTOR_TYPE_SERVICER, 10)
+	fishermen := newTestActors(t, coreTypes.ActorType_ACTOR_TYPE_FISH, 5)
+
+	readCtx := newTestSessionReadContext(t, 6, 2, servicers, fishermen)
+	s, err := NewSession(readCtx, 10, testRelayChain, testGeoZone, app)
+	require.NoError(t, err)
+
+	require.Equal(t, int64(8), s.GetSessionHeight())
+	require.Equal(t, testRelayChain, s.GetRelayChain())
+	require.Equal(t, testGeoZone, s.GetGeoZone())
+	require.Equal(t, app, s.GetApplication())
+	require.Len(t, s.GetSessionID(), 32)
+	require.Len(t, s.GetServicers(), 6)
+	require.Len(t, s.GetFishermen(), 2)
+	require.Len(t, uniqueAddresses(s.GetServicers()), 6)
+	require.Len(t, uniqueAddresses(s.GetFishermen()), 2)
+
+	// The 3000 session tokens of the application are split evenly between its 6 servicers
+	require.Equal(t, int64(500), s.GetMaxRelaysPerServicer().Int64())
+}
+
+func TestSession_CalculateAppSessionTokens(t *testing.T) {
+	sessionTokens, err := CalculateAppSessionTokens(testAppStake, testAppSessionTokensMultiplier)
+	require.NoError(t, err)
+	require.Equal(t, int64(3000), sessionTokens.Int64())
+
+	_, err = CalculateAppSessionTokens("not a number", testAppSessionTokensMultiplier)
+	require.Equal(t, types.CodeStringToBigIntError, err.Code())
+}
+
+func TestSession_NewSession_Deterministic(t *testing.T) {
+	app := newTestActor(t, coreTypes.ActorType_ACTOR_TYPE_APP, string(testRelayChain))
+	servicers := newTestActors(t, coreTypes.ActorType_ACTOR_TYPE_SERVICER, 10)
+	fishermen := newTestActors(t, coreTypes.ActorType_ACTOR_TYPE_FISH, 5)
+
+	// Shuffle the order in which persistence returns the actors to make sure it does not affect the selection
+	reversed := make([]*coreTypes.Actor, len(servicers))
+	for i, servicer := range servicers {
+		reversed[len(servicers)-1-i] = servicer
+	}
+
+	s1, err := NewSession(newTestSessionReadContext(t, 3, 3, servicers, fishermen), 9, testRelayChain, testGeoZone, app)
+	require.NoError(t, err)
+	s2, err := NewSession(newTestSessionReadContext(t, 3, 3, reversed, fishermen), 11, testRelayChain, testGeoZone, app)
+	require.NoError(t, err)
+
+	require.Equal(t, s1.GetSessionID(), s2.GetSessionID())
+	require.Equal(t, s1.GetServicers(), s2.GetServicers())
+	require.Equal(t, s1.GetFishermen(), s2.GetFishermen())
+
+	// A different application is dispatched to a different session
+	otherApp := newTestActor(t, coreTypes.ActorType_ACTOR_TYPE_APP, string(testRelayChain))
+	s3, err := NewSession(newTestSessionReadContext(t, 3, 3, servicers, fishermen), 9, testRelayChain, testGeoZone, otherApp)
+	require.NoError(t, err)
+	require.NotEqual(t, s1.GetSessionID(), s3.GetSessionID())
+}
+
+func TestSession_NewSession_FiltersIneligibleActors(t *testing.T) {
+	app := newTestActor(t, coreTypes.ActorType_ACTOR_TYPE_APP, string(testRelayChain))
+
+	eligible := newTestActor(t, coreTypes.ActorType_ACTOR_TYPE_SERVICER, string(testRelayChain))
+	paused := newTestActor(t, coreTypes.ActorType_ACTOR_TYPE_SERVICER, string(testRelayChain))
+	paused.PausedHeight = 2
+	unstaking := newTestActor(t, coreTypes.ActorType_ACTOR_TYPE_SERVICER, string(testRelayChain))
+	unstaking.UnstakingHeight = 100
+	otherChain := newTestActor(t, coreTypes.ActorType_ACTOR_TYPE_SERVICER, "0002")
+	// Actors staked without a geo zone take part in the sessions of every geo zone
+	sameGeoZone := newTestActor(t, coreTypes.ActorType_ACTOR_TYPE_SERVICER, string(testRelayChain))
+	sameGeoZone.GeoZone = string(testGeoZone)
+	otherGeoZone := newTestActor(t, coreTypes.ActorType_ACTOR_TYPE_SERVICER, string(testRelayChain))
+	otherGeoZone.GeoZone = "1"
+
+	servicers := []*coreTypes.Actor{paused, unstaking, eligible, otherChain, sameGeoZone, otherGeoZone}
+	s, err := NewSession(newTestSessionReadContext(t, 4, 1, servicers, nil), 5, testRelayChain, testGeoZone, app)
+	require.NoError(t, err)
+
+	require.ElementsMatch(t, []*coreTypes.Actor{eligible, sameGeoZone}, s.GetServicers())
+	require.Empty(t, s.GetFishermen())
+}
+
+func TestSession_NewSession_InvalidBlocksPerSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	readCtx := mockModules.NewMockPersistenceReadContext(ctrl)
+	readCtx.EXPECT().GetIntParam(types.BlocksPerSessionParamName, gomock.Any()).Return(0, nil)
+
+	app := newTestActor(t, coreTypes.ActorType_ACTOR_TYPE_APP, string(testRelayChain))
+	_, err := NewSession(readCtx, 5, testRelayChain, testGeoZone, app)
+	require.Equal(t, types.CodeInvalidBlocksPerSessionError, err.Code())
+}
+
+func TestSession_NewSession_InvalidActorsPerSession(t *testing.T) {
+	tests := []struct {
+		name         string
+		numServicers int
+		numFishermen int
+	}{
+		{"negative servicers per session", -1, 1},
+		{"negative fishermen per session", 1, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			readCtx := newTestSessionReadContext(t, tt.numServicers, tt.numFishermen, nil, nil)
+			app := newTestActor(t, coreTypes.ActorType_ACTOR_TYPE_APP, string(testRelayChain))
+			_, err := NewSession(readCtx, 5, testRelayChain, testGeoZone, app)
+			require.Error(t, err)
+			require.Equal(t, types.CodeInvalidActorsPerSessionError, err.Code())
+		})
+	}
+}
+
+func TestSession_NewSession_ParamsAtSessionHeight(t *testing.T) {
+	app := newTestActor(t, coreTypes.ActorType_ACTOR_TYPE_APP, string(testRelayChain))
+	servicers := newTestActors(t, coreTypes.ActorType_ACTOR_TYPE_SERVICER, 10)
+	fishermen := newTestActors(t, coreTypes.ActorType_ACTOR_TYPE_FISH, 5)
+
+	// The parameters changed at height 9, during the session that started at height 8
+	paramAt := func(atSessionHeight, afterChange int) func(string, int64) (int, error) {
+		return func(_ string, height int64) (int, error) {
+			if height < 9 {
+				return atSessionHeight, nil
+			}
+			return afterChange, nil
+		}
+	}
+	ctrl := gomock.NewController(t)
+	readCtx := mockModules.NewMockPersistenceReadContext(ctrl)
+	readCtx.EXPECT().GetIntParam(types.BlocksPerSessionParamName, gomock.Any()).Return(testBlocksPerSession, nil).AnyTimes()
+	readCtx.EXPECT().GetIntParam(types.ServicersPerSessionParamName, gomock.Any()).DoAndReturn(paramAt(6, 2)).AnyTimes()
+	readCtx.EXPECT().GetIntParam(types.FishermenPerSessionParamName, gomock.Any()).DoAndReturn(paramAt(2, 4)).AnyTimes()
+	readCtx.EXPECT().GetIntParam(types.AppSessionTokensMultiplierParamName, gomock.Any()).DoAndReturn(paramAt(testAppSessionTokensMultiplier, 1)).AnyTimes()
+	readCtx.EXPECT().GetBlockHash(gomock.Any()).Return(hex.EncodeToString(crypto.SHA3Hash([]byte("block"))), nil).AnyTimes()
+	readCtx.EXPECT().GetAllServicers(gomock.Any()).Return(servicers, nil).AnyTimes()
+	readCtx.EXPECT().GetAllFishermen(gomock.Any()).Return(fishermen, nil).AnyTimes()
+
+	// The session is dispatched the same way at every height, with the parameters it started with
+	for _, height := range []int64{8, 10, 11} {
+		s, err := NewSession(readCtx, height, testRelayChain, testGeoZone, app)
+		require.NoError(t, err)
+		require.Len(t, s.GetServicers(), 6)
+		require.Len(t, s.GetFishermen(), 2)
+		require.Equal(t, int64(500), s.GetMaxRelaysPerServicer().Int64())
+	}
+}
+
+func TestSession_NewSession_AppNotStakedForRelayChain(t *testing.T) {
+	app := newTestActor(t, coreTypes.ActorType_ACTOR_TYPE_APP, "0002")
+	readCtx := newTestSessionReadContext(t, 1, 1, newTestActors(t, coreTypes.ActorType_ACTOR_TYPE_SERVICER, 1), nil)
+	_, err := NewSession(readCtx, 5, testRelayChain, testGeoZone, app)
+	require.Error(t, err)
+	require.Equal(t, types.CodeAppNotStakedForRelayChainError, err.Code())
+}
+
+func newTestSessionReadContext(t *testing.T, numServicers, numFishermen int, servicers, fishermen []*coreTypes.Actor) *mockModules.MockPersistenceReadContext {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	readCtx := mockModules.NewMockPersistenceReadContext(ctrl)
+	readCtx.EXPECT().GetIntParam(types.BlocksPerSessionParamName, gomock.Any()).Return(testBlocksPerSession, nil).AnyTimes()
+	readCtx.EXPECT().GetIntParam(types.ServicersPerSessionParamName, gomock.Any()).Return(numServicers, nil).AnyTimes()
+	readCtx.EXPECT().GetIntParam(types.FishermenPerSessionParamName, gomock.Any()).Return(numFishermen, nil).AnyTimes()
+	readCtx.EXPECT().GetIntParam(types.AppSessionTokensMultiplierParamName, gomock.Any()).Return(testAppSessionTokensMultiplier, nil).AnyTimes()
+	readCtx.EXPECT().GetBlockHash(gomock.Any()).DoAndReturn(func(height int64) (string, error) {
+		return hex.EncodeToString(crypto.SHA3Hash([]byte{byte(height)})), nil
+	}).AnyTimes()
+	readCtx.EXPECT().GetAllServicers(gomock.Any()).Return(servicers, nil).AnyTimes()
+	readCtx.EXPECT().GetAllFishermen(gomock.Any()).Return(fishermen, nil).AnyTimes()
+	return readCtx
+}
+
+func newTestActors(t *testing.T, actorType coreTypes.ActorType, numActors int) []*coreTypes.Actor {
+	t.Helper()
+	actors := make([]*coreTypes.Actor, numActors)
+	for i := range actors {
+		actors[i] = newTestActor(t, actorType, string(testRelayChain))
+	}
+	return actors
+}
+
+func newTestActor(t *testing.T, actorType coreTypes.ActorType, chain string) *coreTypes.Actor {
+	t.Helper()
+	privKey, err := crypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	return &coreTypes.Actor{
+		ActorType:       actorType,
+		Address:         privKey.Address().String(),
+		PublicKey:       privKey.PublicKey().String(),
+		Chains:          []string{chain},
+		StakedAmount:    testAppStake,
+		PausedHeight:    types.HeightNotUsed,
+		UnstakingHeight: types.HeightNotUsed,
+	}
+}
+
+func uniqueAddresses(actors []*coreTypes.Actor) map[string]struct{} {
+	addresses := make(map[string]struct{}, len(actors))
+	for _, actor := range actors {
+		addresses[actor.GetAddress()] = struct{}{}
+	}
+	return addresses
+}
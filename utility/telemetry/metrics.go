@@ -0,0 +1,20 @@
+package utility_telemetry
+
+const (
+	// Please refer to shared/telemetry/README.md, Defining your own metrics section, to understand the convention we are using to define metrics.
+	// Time Series Metrics
+	MEMPOOL_EVICTED_TXS_COUNTER_NAME        = "utility_mempool_evicted_txs_counter"
+	MEMPOOL_EVICTED_TXS_COUNTER_DESCRIPTION = "the counter to track the number of transactions evicted from the mempool when rechecked after a new block"
+
+	// Event Metrics
+	UTILITY_EVENT_METRICS_NAMESPACE = "event_metrics_namespace_utility"
+
+	MEMPOOL_RECHECK_EVENT_METRIC_NAME                       = "mempool_recheck_event_metric"
+	MEMPOOL_RECHECK_EVENT_METRIC_LABEL_HEIGHT               = "HEIGHT"
+	MEMPOOL_RECHECK_EVENT_METRIC_LABEL_RECHECKED            = "RECHECKED"
+	MEMPOOL_RECHECK_EVENT_METRIC_LABEL_EVICTED_COMMITTED    = "EVICTED_COMMITTED"
+	MEMPOOL_RECHECK_EVENT_METRIC_LABEL_EVICTED_EXPIRED      = "EVICTED_EXPIRED"
+	MEMPOOL_RECHECK_EVENT_METRIC_LABEL_EVICTED_INSUFFICIENT = "EVICTED_INSUFFICIENT_FUNDS"
+	MEMPOOL_RECHECK_EVENT_METRIC_LABEL_EVICTED_NONCE_REUSED = "EVICTED_NONCE_REUSED"
+	MEMPOOL_RECHECK_EVENT_METRIC_LABEL_EVICTED_INVALID      = "EVICTED_INVALID"
+)
@@ -1,9 +1,14 @@
 package utility
 
 import (
+	"math/big"
+
 	"github.com/pokt-network/pocket/shared/codec"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/modules"
+	"github.com/pokt-network/pocket/shared/utils"
 	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"github.com/pokt-network/pocket/utility/unit_of_work"
 )
 
 // HandleTransaction implements the exposed functionality of the shared utilityModule interface.
@@ -33,6 +38,58 @@ func (u *utilityModule) HandleTransaction(txProtoBytes []byte) error {
 		return err
 	}
 
+	// Can the tx still be included in a block?
+	if height := int64(u.GetBus().GetConsensusModule().CurrentHeight()); tx.ExpiryHeight < height {
+		return typesUtil.ErrTransactionExpired(tx.ExpiryHeight, height)
+	}
+
 	// Store the tx in the mempool
 	return u.mempool.AddTx(txProtoBytes)
 }
+
+// SimulateTransaction implements the exposed functionality of the shared utilityModule interface.
+func (u *utilityModule) SimulateTransaction(txProtoBytes []byte) (modules.TxResult, string, error) {
+	tx, err := coreTypes.TxFromBytes(txProtoBytes)
+	if err != nil {
+		return nil, "", typesUtil.ErrProtoUnmarshal(err)
+	}
+	if err := tx.ValidateBasic(); err != nil {
+		return nil, "", err
+	}
+
+	// The tx is simulated as if it was included in the block being built on top of the last committed one
+	height := int64(u.GetBus().GetConsensusModule().CurrentHeight())
+	simulationCtx, err := u.GetBus().GetPersistenceModule().NewSimulationContext(height)
+	if err != nil {
+		return nil, "", err
+	}
+	simulationUOW := unit_of_work.NewSimulationUOW(height, simulationCtx)
+	simulationUOW.SetBus(u.GetBus())
+	defer simulationUOW.Release()
+
+	txResult, fee, er := simulationUOW.SimulateTransaction(tx)
+	if er != nil {
+		return nil, "", er
+	}
+	return txResult, utils.BigIntToString(fee), nil
+}
+
+// getTxFee returns the fee paid by `tx` according to the governance parameters of the last committed block
+func (u *utilityModule) getTxFee(tx *coreTypes.Transaction) (*big.Int, error) {
+	height := int64(u.GetBus().GetConsensusModule().CurrentHeight())
+	if height > 0 {
+		height--
+	}
+
+	readCtx, err := u.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return nil, err
+	}
+	defer readCtx.Release()
+
+	fee, er := unit_of_work.GetTxFee(readCtx, height, tx)
+	if er != nil {
+		return nil, er
+	}
+	return fee, nil
+}
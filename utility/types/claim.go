@@ -0,0 +1,42 @@
+package types
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+)
+
+// NewRelayMerkleSumLeaf returns the leaf committing to `relay` in the Merkle sum tree of a claim.
+// Every relay accounts for a single unit of volume.
+func NewRelayMerkleSumLeaf(relay *coreTypes.Relay) (MerkleSumLeaf, Error) {
+	relayHash, err := relay.Hash()
+	if err != nil {
+		return MerkleSumLeaf{}, ErrProtoMarshal(err)
+	}
+	return MerkleSumLeaf{Hash: relayHash, Sum: 1}, nil
+}
+
+// GetClaimProofTarget returns the position, in the sum space of the claimed Merkle sum tree, of the relay the
+// servicer has to prove. It is seeded by the hash of the block the claim was committed in, so it cannot be
+// known by the servicer when building its claim.
+func GetClaimProofTarget(claim *coreTypes.Claim, claimBlockHash string) (uint64, Error) {
+	if claim.GetTotalRelays() == 0 {
+		return 0, ErrInvalidClaim("no relays claimed")
+	}
+	return getProofTarget(claim.GetTotalRelays(), claimBlockHash, claim.GetSessionId(), claim.GetServicerAddress())
+}
+
+// getProofTarget selects a position in [0, total) from the SHA3 hash of the concatenation of the hex encoded `seeds`
+func getProofTarget(total uint64, seeds ...string) (uint64, Error) {
+	seed := make([]byte, 0)
+	for _, hexSeed := range seeds {
+		seedBz, err := hex.DecodeString(hexSeed)
+		if err != nil {
+			return 0, ErrHexDecodeFromString(err)
+		}
+		seed = append(seed, seedBz...)
+	}
+	return binary.BigEndian.Uint64(crypto.SHA3Hash(seed)[:8]) % total, nil
+}
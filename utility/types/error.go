@@ -40,7 +40,7 @@ func NewError(code Code, msg string) Error {
 	}
 }
 
-// NextCode: 133
+// NextCode: 194
 type Code float64 // CONSIDERATION: Should these be a proto enum or a golang iota?
 
 //nolint:gosec // G101 - Not hard-coded credentials
@@ -106,204 +106,326 @@ const (
 	CodeGetExistsError                   Code = 61
 	CodeGetLatestHeightError             Code = 62
 	// DEPRECATED                         Code = 63
-	CodeGetPauseHeightError               Code = 64
-	CodeAlreadyPausedError                Code = 65
-	CodeSetPauseHeightError               Code = 66
-	CodeNotPausedError                    Code = 67
-	CodeNotReadyToUnpauseError            Code = 68
-	CodeSetStatusPausedBeforeError        Code = 69
-	CodeInvalidServiceURLError            Code = 70
-	CodeNotExistsError                    Code = 71
-	CodeGetMissedBlocksError              Code = 72
-	CodeEmptyHashError                    Code = 73
-	CodeInvalidBlockHeightError           Code = 74
-	CodeUnequalPublicKeysError            Code = 75
-	CodeUnequalVoteTypesError             Code = 76
-	CodeEqualVotesError                   Code = 77
-	CodeUnequalRoundsError                Code = 78
-	CodeMaxEvidenceAgeError               Code = 79
-	CodeGetStakedAmountError              Code = 80
-	CodeSetValidatorStakedAmountError     Code = 81
-	CodeSetPoolAmountError                Code = 82
-	CodeGetPoolAmountError                Code = 83
-	CodeInvalidProposerCutPercentageError Code = 84
-	CodeUnknownParamError                 Code = 85
-	CodeUnauthorizedParamChangeError      Code = 86
-	CodeInvalidParamValueError            Code = 87
-	CodeUpdateParamError                  Code = 88
-	CodeGetServicersPerSessionAtError     Code = 89
-	CodeGetBlockHashError                 Code = 90
-	CodeGetServicerCountError             Code = 91
-	CodeEmptyParamKeyError                Code = 92
-	CodeEmptyParamValueError              Code = 93
-	CodeGetOutputAddressError             Code = 94
-	CodeTransactionAlreadyCommittedError  Code = 95
-	CodeInitGenesisParamsError            Code = 96
-	CodeGetAllFishermenError              Code = 97
-	CodeGetAllServicersError              Code = 98
-	CodeGetAllAppsError                   Code = 99
-	CodeNewPersistenceContextError        Code = 100
-	CodeGetAppHashError                   Code = 101
-	CodeNewSavePointError                 Code = 102
-	CodeRollbackSavePointError            Code = 103
-	CodeResetContextError                 Code = 104
-	CodeCommitContextError                Code = 105
-	CodeReleaseContextError               Code = 106
-	CodeGetAllPoolsError                  Code = 107
-	CodeGetAllAccountsError               Code = 108
-	CodeGetAllParamsError                 Code = 109
-	CodeSetPoolError                      Code = 110
-	CodeDuplicateSavePointError           Code = 111
-	CodeSavePointNotFoundError            Code = 112
-	CodeEmptySavePointsError              Code = 113
-	CodeInvalidEvidenceTypeError          Code = 114
-	CodeExportStateError                  Code = 115
-	CodeUnequalHeightsError               Code = 116
-	CodeSetMissedBlocksError              Code = 117
-	CodeNegativeAmountError               Code = 118
-	CodeNilQuorumCertificateError         Code = 119
-	CodeMissingRequiredArgError           Code = 120
-	CodeSocketRequestTimedOutError        Code = 121
-	CodeUndefinedSocketTypeError          Code = 122
-	CodePeerHangUpError                   Code = 123
-	CodeUnexpectedSocketError             Code = 124
-	CodePayloadTooBigError                Code = 125
-	CodeSocketIOStartFailedError          Code = 126
-	CodeGetStakeAmountError               Code = 127
-	CodeStakeLessError                    Code = 128
-	CodeGetHeightError                    Code = 129
-	CodeUnknownActorType                  Code = 130
-	CodeUnknownMessageType                Code = 131
+	CodeGetPauseHeightError                            Code = 64
+	CodeAlreadyPausedError                             Code = 65
+	CodeSetPauseHeightError                            Code = 66
+	CodeNotPausedError                                 Code = 67
+	CodeNotReadyToUnpauseError                         Code = 68
+	CodeSetStatusPausedBeforeError                     Code = 69
+	CodeInvalidServiceURLError                         Code = 70
+	CodeNotExistsError                                 Code = 71
+	CodeGetMissedBlocksError                           Code = 72
+	CodeEmptyHashError                                 Code = 73
+	CodeInvalidBlockHeightError                        Code = 74
+	CodeUnequalPublicKeysError                         Code = 75
+	CodeUnequalVoteTypesError                          Code = 76
+	CodeEqualVotesError                                Code = 77
+	CodeUnequalRoundsError                             Code = 78
+	CodeMaxEvidenceAgeError                            Code = 79
+	CodeGetStakedAmountError                           Code = 80
+	CodeSetValidatorStakedAmountError                  Code = 81
+	CodeSetPoolAmountError                             Code = 82
+	CodeGetPoolAmountError                             Code = 83
+	CodeInvalidProposerCutPercentageError              Code = 84
+	CodeUnknownParamError                              Code = 85
+	CodeUnauthorizedParamChangeError                   Code = 86
+	CodeInvalidParamValueError                         Code = 87
+	CodeUpdateParamError                               Code = 88
+	CodeGetServicersPerSessionAtError                  Code = 89
+	CodeGetBlockHashError                              Code = 90
+	CodeGetServicerCountError                          Code = 91
+	CodeEmptyParamKeyError                             Code = 92
+	CodeEmptyParamValueError                           Code = 93
+	CodeGetOutputAddressError                          Code = 94
+	CodeTransactionAlreadyCommittedError               Code = 95
+	CodeInitGenesisParamsError                         Code = 96
+	CodeGetAllFishermenError                           Code = 97
+	CodeGetAllServicersError                           Code = 98
+	CodeGetAllAppsError                                Code = 99
+	CodeNewPersistenceContextError                     Code = 100
+	CodeGetAppHashError                                Code = 101
+	CodeNewSavePointError                              Code = 102
+	CodeRollbackSavePointError                         Code = 103
+	CodeResetContextError                              Code = 104
+	CodeCommitContextError                             Code = 105
+	CodeReleaseContextError                            Code = 106
+	CodeGetAllPoolsError                               Code = 107
+	CodeGetAllAccountsError                            Code = 108
+	CodeGetAllParamsError                              Code = 109
+	CodeSetPoolError                                   Code = 110
+	CodeDuplicateSavePointError                        Code = 111
+	CodeSavePointNotFoundError                         Code = 112
+	CodeEmptySavePointsError                           Code = 113
+	CodeInvalidEvidenceTypeError                       Code = 114
+	CodeExportStateError                               Code = 115
+	CodeUnequalHeightsError                            Code = 116
+	CodeSetMissedBlocksError                           Code = 117
+	CodeNegativeAmountError                            Code = 118
+	CodeNilQuorumCertificateError                      Code = 119
+	CodeMissingRequiredArgError                        Code = 120
+	CodeSocketRequestTimedOutError                     Code = 121
+	CodeUndefinedSocketTypeError                       Code = 122
+	CodePeerHangUpError                                Code = 123
+	CodeUnexpectedSocketError                          Code = 124
+	CodePayloadTooBigError                             Code = 125
+	CodeSocketIOStartFailedError                       Code = 126
+	CodeGetStakeAmountError                            Code = 127
+	CodeStakeLessError                                 Code = 128
+	CodeGetHeightError                                 Code = 129
+	CodeUnknownActorType                               Code = 130
+	CodeUnknownMessageType                             Code = 131
+	CodeInvalidBlocksPerSessionError                   Code = 133
+	CodeInvalidRelayError                              Code = 134
+	CodeUnsupportedRelayChainError                     Code = 135
+	CodeInvalidAATError                                Code = 136
+	CodeRelayOutOfSessionError                         Code = 137
+	CodeServicerNotInSessionError                      Code = 138
+	CodeRelayLimitExceededError                        Code = 139
+	CodeRelayExecutionError                            Code = 140
+	CodeRelayResponseSignError                         Code = 141
+	CodeServicerDisabledError                          Code = 142
+	CodeRelayStoreError                                Code = 143
+	CodeInvalidMerkleSumTreeError                      Code = 144
+	CodeInvalidClaimError                              Code = 145
+	CodeClaimNotFoundError                             Code = 146
+	CodeInvalidProofError                              Code = 147
+	CodeGetClaimError                                  Code = 148
+	CodeDeleteClaimError                               Code = 149
+	CodeInvalidTestScoreError                          Code = 150
+	CodeTestScoreNotFoundError                         Code = 151
+	CodeGetTestScoreError                              Code = 152
+	CodeDeleteTestScoreError                           Code = 153
+	CodeInvalidDAOPercentageOfRelayRewardsError        Code = 154
+	CodeDuplicateDoubleSignEvidenceError               Code = 155
+	CodeGetDoubleSignEvidenceError                     Code = 156
+	CodeInvalidDoubleSignReporterRewardPercentageError Code = 157
+	CodeGetBlockError                                  Code = 158
+	CodeInvalidQuorumCertificateError                  Code = 159
+	CodeMempoolFullError                               Code = 160
+	CodeEmptyMempoolError                              Code = 161
+	CodeGetTxFeeError                                  Code = 162
+	CodeTransactionExpiredError                        Code = 163
+	CodeTransactionExpiryTooFarError                   Code = 164
+	CodeDelegationNotFoundError                        Code = 165
+	CodeAlreadyUndelegatingError                       Code = 166
+	CodeGetDelegationError                             Code = 167
+	CodeSetDelegationError                             Code = 168
+	CodeDeleteDelegationError                          Code = 169
+	CodeEmptyParameterChangesError                     Code = 170
+	CodeDuplicateParameterChangeError                  Code = 171
+	CodeInvalidVoteOptionError                         Code = 172
+	CodeProposalNotFoundError                          Code = 173
+	CodeProposalNotVotingError                         Code = 174
+	CodeGetProposalError                               Code = 175
+	CodeSetProposalError                               Code = 176
+	CodeGetVotesError                                  Code = 177
+	CodeSetVoteError                                   Code = 178
+	CodeInvalidUpgradeHeightError                      Code = 179
+	CodeSetUpgradePlanError                            Code = 180
+	CodeInsufficientVestedAmountError                  Code = 181
+	CodeGetVestingScheduleError                        Code = 182
+	CodeRotateOperatorKeyError                         Code = 183
+	CodeInvalidGeoZoneError                            Code = 184
+	CodeGeoZoneNotAllowedError                         Code = 185
+	CodeInvalidRelayChainsError                        Code = 186
+	CodeRelayChainNotRegisteredError                   Code = 187
+	CodeGetCurrentOperatorError                        Code = 188
+	CodeGetUpgradePlanError                            Code = 189
+	CodeInvalidActorsPerSessionError                   Code = 190
+	CodeVolumeTxSubmissionError                        Code = 191
+	CodeAppNotStakedForRelayChainError                 Code = 192
+	CodeNonPositiveParamError                          Code = 193
 )
 
 const (
-	GetStakedAmountsError             = "an error occurred getting the validator's amount staked"
-	SetValidatorStakedAmountError     = "an error occurred setting the validator' amount staked"
-	EqualVotesError                   = "the votes are identical and not equivocating"
-	UnequalRoundsError                = "the round numbers are not equal"
-	UnequalVoteTypesError             = "the vote types are not equal"
-	UnequalPublicKeysError            = "the two public keys are not equal"
-	GetMissedBlocksError              = "an error occurred getting the missed blocks field"
-	DecodeMessageError                = "unable to decode the message"
-	NotExistsError                    = "the actor does not exist in the state"
-	InvalidServiceURLError            = "the service url is not valid"
-	NotReadyToUnpauseError            = "the actor isn't ready to unpause as the minimum number of blocks hasn't passed since pausing"
-	NotPausedError                    = "the actor is not paused"
-	SetPauseHeightError               = "an error occurred setting the pause height"
-	AlreadyPausedError                = "the actor is already paused"
-	GetPauseHeightError               = "an error occurred getting the pause height"
-	UnmarshalTransactionError         = "an error occurred decoding the transaction"
-	AlreadyExistsError                = "the actor already exists in the state"
-	GetExistsError                    = "an error occurred when checking if already exists"
-	GetStakeAmountError               = "an error occurred getting the stake amount"
-	StakeLessError                    = "the stake amount cannot be less than current amount"
-	GetReadyToUnstakeError            = "an error occurred getting the 'ready to unstake' group"
-	GetLatestHeightError              = "an error occurred getting the latest height"
-	SetUnstakingHeightAndStatus       = "an error occurred setting the unstaking height and status"
-	GetStatusError                    = "an error occurred getting the staking status"
-	InvalidStatusError                = "the staking status is not valid"
-	InsertError                       = "an error occurred inserting into persistence"
-	MaxChainsError                    = "the amount chains exceeds the maximum value"
-	InvalidPublicKeyLenError          = "the public key length is not valid"
-	EmptyAmountError                  = "the amount field is empty"
-	NilOutputAddressError             = "the output address is nil"
-	InvalidRelayChainLengthError      = "the relay chain id length is invalid"
-	EmptyRelayChainError              = "the relay chain id is empty"
-	EmptyRelayChainsError             = "the relay chains are nil or empty"
-	MinimumStakeError                 = "an error occurred because the amount specified is less than the minimum stake"
-	GetParamError                     = "an error occurred getting the parameter"
-	SetAccountError                   = "an error occurred setting the account"
-	AddAccountAmountError             = "an error occurred adding the amount to the account balance"
-	AddPoolAmountError                = "an error occurred adding to the pool"
-	SubPoolAmountError                = "an error occurred subtracting from the pool"
-	SetPoolAmountError                = "an error occurred setting the pool amount"
-	GetPoolAmountError                = "an error occurred getting the pool amount"
-	InvalidSignerError                = "the signer of the message is not a proper candidate"
-	GetAccountAmountError             = "an error occurred getting the account amount"
-	UnknownMessageError               = "the message type is unrecognized"
-	AppHashError                      = "an error occurred generating the apphash"
-	InvalidNonceError                 = "the nonce field is invalid; cannot be converted to big.Int"
-	NewPublicKeyFromBytesError        = "unable to convert the raw bytes to a valid public key"
-	SignatureVerificationFailedError  = "the public key / signature combination is not valid for the msg"
-	ProtoFromAnyError                 = "an error occurred getting the structure from the protobuf any"
-	NewFeeFromStringError             = "the fee string is unable to be converted to a valid base 10 number"
-	EmptyNonceError                   = "the nonce in the transaction is empty"
-	EmptyPublicKeyError               = "the public key field is empty"
-	EmptySignatureError               = "the signature field is empty"
-	TransactionSignError              = "an error occurred signing the transaction"
-	InterfaceConversionError          = "an error occurred converting the interface to an expected type: "
-	SetStatusPausedBeforeError        = "an error occurred setting the actor status that were paused before"
-	EmptyHashError                    = "the hash is empty"
-	InvalidBlockHeightError           = "the block height field is not valid"
-	MaxEvidenceAgeError               = "the evidence is too old to be processed"
-	InvalidProposerCutPercentageError = "the proposer cut percentage is larger than 100"
-	UnknownParamError                 = "the param name is not found in the acl"
-	UnauthorizedParamChangeError      = "unauthorized param change, the signer must be address: "
-	InvalidParamValueError            = "the param value is not the expected type"
-	GetBlockHashError                 = "an error occurred getting the block hash"
-	GetServicersPerSessionAtError     = "an error occurred getting the servicers per session for height"
-	GetServicerCountError             = "an error occurred getting the servicer count"
-	EmptyParamKeyError                = "the parameter key is empty"
-	EmptyParamValueError              = "the parameter value is empty"
-	GetOutputAddressError             = "an error occurred getting the output address using operator"
-	GetHeightError                    = "an error occurred when getting the height from the store"
-	TransactionAlreadyCommittedError  = "the transaction is already committed"
-	NewSavePointError                 = "an error occurred creating the save point"
-	RollbackSavePointError            = "an error occurred rolling back to save point"
-	NewPersistenceContextError        = "an error occurred creating the persistence context"
-	GetAppHashError                   = "an error occurred getting the appHash"
-	ResetContextError                 = "an error occurred resetting the context"
-	CommitContextError                = "an error occurred committing the context"
-	ReleaseContextError               = "an error occurred releasing the context"
-	SetPoolError                      = "an error occurred setting the pool"
-	DuplicateSavePointError           = "the save point is duplicated"
-	SavePointNotFoundError            = "the save point is not found"
-	EmptySavePointsError              = "the save points list in context is empty"
-	InvalidEvidenceTypeError          = "the evidence type is not valid"
-	ExportStateError                  = "an error occurred exporting the state"
-	UnequalHeightsError               = "the heights are not equal"
-	SetMissedBlocksError              = "an error occurred setting missed blocks"
-	MissingRequiredArgError           = "socket error: missing required argument."
-	SocketRequestTimedOutError        = "socket error: request timed out while waiting on ACK."
-	UndefinedSocketTypeError          = "socket error: undefined given socket type."
-	PeerHangUpError                   = "socket error: Peer hang up."
-	UnexpectedSocketError             = "socket error: Unexpected peer error."
-	PayloadTooBigError                = "socket error: payload size is too big. "
-	SocketIOStartFailedError          = "socket error: failed to start socket reading/writing (io)"
-	EmptyTransactionError             = "the transaction is empty"
-	StringToBigIntError               = "error converting string to big int"
-	StringToBigFloatError             = "error converting string to big float"
-	GetAllValidatorsError             = "an error occurred getting all validators from the state"
-	InvalidAmountError                = "the amount field is invalid; cannot be converted to big.Int"
-	InvalidAddressLenError            = "the length of the address is not valid"
-	EmptyAddressError                 = "the address field is empty"
-	EmptyNameError                    = "the name field is empty"
-	NilPoolError                      = "the pool is nil"
-	EmptyAccountError                 = "the account is nil"
-	NewAddressFromBytesError          = "unable to convert the raw bytes to a valid address"
-	InvalidTransactionCountError      = "the total transactions are less than the block transactions"
-	EmptyTimestampError               = "the timestamp field is empty"
-	EmptyProposerError                = "the proposer field is empty"
-	EmptyNetworkIDError               = "the network id field is empty"
-	InvalidHashLengthError            = "the length of the hash is not the correct size"
-	NilQuorumCertificateError         = "the quorum certificate is nil"
-	HexDecodeFromStringError          = "an error occurred decoding the string into hex bytes"
-	ProtoMarshalError                 = "an error occurred marshalling the structure in protobuf"
-	ProtoUnmarshalError               = "an error occurred unmarshalling the structure in protobuf"
-	ProtoNewAnyError                  = "an error occurred creating the protobuf any"
-	UpdateParamError                  = "an error occurred updating the parameter"
-	InitGenesisParamError             = "an error occurred initializing the params in genesis"
-	GetAllFishermenError              = "an error occurred getting all of the fishermen¬"
-	GetAllAppsError                   = "an error occurred getting all of the apps"
-	GetAllServicersError              = "an error occurred getting all of the servicers"
-	GetAllPoolsError                  = "an error occurred getting all of the pools"
-	GetAllAccountsError               = "an error occurred getting all of the accounts"
-	GetAllParamsError                 = "an error occurred getting all of the params"
-	DuplicateTransactionError         = "the transaction is already found in the mempool"
-	InsufficientAmountError           = "the account has insufficient funds to complete the operation"
-	NegativeAmountError               = "the amount is negative"
-	UnknownActorTypeError             = "the actor type is not recognized"
-	UnknownMessageTypeError           = "the message being by the utility message is not recognized"
+	GetStakedAmountsError                          = "an error occurred getting the validator's amount staked"
+	SetValidatorStakedAmountError                  = "an error occurred setting the validator' amount staked"
+	EqualVotesError                                = "the votes are identical and not equivocating"
+	UnequalRoundsError                             = "the round numbers are not equal"
+	UnequalVoteTypesError                          = "the vote types are not equal"
+	UnequalPublicKeysError                         = "the two public keys are not equal"
+	GetMissedBlocksError                           = "an error occurred getting the missed blocks field"
+	DecodeMessageError                             = "unable to decode the message"
+	NotExistsError                                 = "the actor does not exist in the state"
+	InvalidServiceURLError                         = "the service url is not valid"
+	NotReadyToUnpauseError                         = "the actor isn't ready to unpause as the minimum number of blocks hasn't passed since pausing"
+	NotPausedError                                 = "the actor is not paused"
+	SetPauseHeightError                            = "an error occurred setting the pause height"
+	AlreadyPausedError                             = "the actor is already paused"
+	GetPauseHeightError                            = "an error occurred getting the pause height"
+	UnmarshalTransactionError                      = "an error occurred decoding the transaction"
+	AlreadyExistsError                             = "the actor already exists in the state"
+	GetExistsError                                 = "an error occurred when checking if already exists"
+	GetStakeAmountError                            = "an error occurred getting the stake amount"
+	StakeLessError                                 = "the stake amount cannot be less than current amount"
+	GetReadyToUnstakeError                         = "an error occurred getting the 'ready to unstake' group"
+	GetLatestHeightError                           = "an error occurred getting the latest height"
+	SetUnstakingHeightAndStatus                    = "an error occurred setting the unstaking height and status"
+	GetStatusError                                 = "an error occurred getting the staking status"
+	InvalidStatusError                             = "the staking status is not valid"
+	InsertError                                    = "an error occurred inserting into persistence"
+	MaxChainsError                                 = "the amount chains exceeds the maximum value"
+	InvalidPublicKeyLenError                       = "the public key length is not valid"
+	EmptyAmountError                               = "the amount field is empty"
+	NilOutputAddressError                          = "the output address is nil"
+	InvalidRelayChainLengthError                   = "the relay chain id length is invalid"
+	EmptyRelayChainError                           = "the relay chain id is empty"
+	EmptyRelayChainsError                          = "the relay chains are nil or empty"
+	MinimumStakeError                              = "an error occurred because the amount specified is less than the minimum stake"
+	GetParamError                                  = "an error occurred getting the parameter"
+	SetAccountError                                = "an error occurred setting the account"
+	AddAccountAmountError                          = "an error occurred adding the amount to the account balance"
+	AddPoolAmountError                             = "an error occurred adding to the pool"
+	SubPoolAmountError                             = "an error occurred subtracting from the pool"
+	SetPoolAmountError                             = "an error occurred setting the pool amount"
+	GetPoolAmountError                             = "an error occurred getting the pool amount"
+	InvalidSignerError                             = "the signer of the message is not a proper candidate"
+	GetAccountAmountError                          = "an error occurred getting the account amount"
+	UnknownMessageError                            = "the message type is unrecognized"
+	AppHashError                                   = "an error occurred generating the apphash"
+	InvalidNonceError                              = "the nonce field is invalid; cannot be converted to big.Int"
+	NewPublicKeyFromBytesError                     = "unable to convert the raw bytes to a valid public key"
+	SignatureVerificationFailedError               = "the public key / signature combination is not valid for the msg"
+	ProtoFromAnyError                              = "an error occurred getting the structure from the protobuf any"
+	NewFeeFromStringError                          = "the fee string is unable to be converted to a valid base 10 number"
+	EmptyNonceError                                = "the nonce in the transaction is empty"
+	EmptyPublicKeyError                            = "the public key field is empty"
+	EmptySignatureError                            = "the signature field is empty"
+	TransactionSignError                           = "an error occurred signing the transaction"
+	InterfaceConversionError                       = "an error occurred converting the interface to an expected type: "
+	SetStatusPausedBeforeError                     = "an error occurred setting the actor status that were paused before"
+	EmptyHashError                                 = "the hash is empty"
+	InvalidBlockHeightError                        = "the block height field is not valid"
+	MaxEvidenceAgeError                            = "the evidence is too old to be processed"
+	InvalidProposerCutPercentageError              = "the proposer cut percentage is larger than 100"
+	UnknownParamError                              = "the param name is not found in the acl"
+	UnauthorizedParamChangeError                   = "unauthorized param change, the signer must be address: "
+	InvalidParamValueError                         = "the param value is not the expected type"
+	GetBlockHashError                              = "an error occurred getting the block hash"
+	GetServicersPerSessionAtError                  = "an error occurred getting the servicers per session for height"
+	GetServicerCountError                          = "an error occurred getting the servicer count"
+	EmptyParamKeyError                             = "the parameter key is empty"
+	EmptyParamValueError                           = "the parameter value is empty"
+	GetOutputAddressError                          = "an error occurred getting the output address using operator"
+	GetHeightError                                 = "an error occurred when getting the height from the store"
+	TransactionAlreadyCommittedError               = "the transaction is already committed"
+	NewSavePointError                              = "an error occurred creating the save point"
+	RollbackSavePointError                         = "an error occurred rolling back to save point"
+	NewPersistenceContextError                     = "an error occurred creating the persistence context"
+	GetAppHashError                                = "an error occurred getting the appHash"
+	ResetContextError                              = "an error occurred resetting the context"
+	CommitContextError                             = "an error occurred committing the context"
+	ReleaseContextError                            = "an error occurred releasing the context"
+	SetPoolError                                   = "an error occurred setting the pool"
+	DuplicateSavePointError                        = "the save point is duplicated"
+	SavePointNotFoundError                         = "the save point is not found"
+	EmptySavePointsError                           = "the save points list in context is empty"
+	InvalidEvidenceTypeError                       = "the evidence type is not valid"
+	ExportStateError                               = "an error occurred exporting the state"
+	UnequalHeightsError                            = "the heights are not equal"
+	SetMissedBlocksError                           = "an error occurred setting missed blocks"
+	MissingRequiredArgError                        = "socket error: missing required argument."
+	SocketRequestTimedOutError                     = "socket error: request timed out while waiting on ACK."
+	UndefinedSocketTypeError                       = "socket error: undefined given socket type."
+	PeerHangUpError                                = "socket error: Peer hang up."
+	UnexpectedSocketError                          = "socket error: Unexpected peer error."
+	PayloadTooBigError                             = "socket error: payload size is too big. "
+	SocketIOStartFailedError                       = "socket error: failed to start socket reading/writing (io)"
+	EmptyTransactionError                          = "the transaction is empty"
+	StringToBigIntError                            = "error converting string to big int"
+	StringToBigFloatError                          = "error converting string to big float"
+	GetAllValidatorsError                          = "an error occurred getting all validators from the state"
+	InvalidAmountError                             = "the amount field is invalid; cannot be converted to big.Int"
+	InvalidAddressLenError                         = "the length of the address is not valid"
+	EmptyAddressError                              = "the address field is empty"
+	EmptyNameError                                 = "the name field is empty"
+	NilPoolError                                   = "the pool is nil"
+	EmptyAccountError                              = "the account is nil"
+	NewAddressFromBytesError                       = "unable to convert the raw bytes to a valid address"
+	InvalidTransactionCountError                   = "the total transactions are less than the block transactions"
+	EmptyTimestampError                            = "the timestamp field is empty"
+	EmptyProposerError                             = "the proposer field is empty"
+	EmptyNetworkIDError                            = "the network id field is empty"
+	InvalidHashLengthError                         = "the length of the hash is not the correct size"
+	NilQuorumCertificateError                      = "the quorum certificate is nil"
+	HexDecodeFromStringError                       = "an error occurred decoding the string into hex bytes"
+	ProtoMarshalError                              = "an error occurred marshalling the structure in protobuf"
+	ProtoUnmarshalError                            = "an error occurred unmarshalling the structure in protobuf"
+	ProtoNewAnyError                               = "an error occurred creating the protobuf any"
+	UpdateParamError                               = "an error occurred updating the parameter"
+	InitGenesisParamError                          = "an error occurred initializing the params in genesis"
+	GetAllFishermenError                           = "an error occurred getting all of the fishermen¬"
+	GetAllAppsError                                = "an error occurred getting all of the apps"
+	GetAllServicersError                           = "an error occurred getting all of the servicers"
+	GetAllPoolsError                               = "an error occurred getting all of the pools"
+	GetAllAccountsError                            = "an error occurred getting all of the accounts"
+	GetAllParamsError                              = "an error occurred getting all of the params"
+	DuplicateTransactionError                      = "the transaction is already found in the mempool"
+	InsufficientAmountError                        = "the account has insufficient funds to complete the operation"
+	NegativeAmountError                            = "the amount is negative"
+	UnknownActorTypeError                          = "the actor type is not recognized"
+	UnknownMessageTypeError                        = "the message being by the utility message is not recognized"
+	InvalidBlocksPerSessionError                   = "the number of blocks per session must be greater than zero"
+	InvalidRelayError                              = "the relay is invalid"
+	UnsupportedRelayChainError                     = "the relay chain is not supported by the servicer"
+	InvalidAATError                                = "the application authentication token is invalid"
+	RelayOutOfSessionError                         = "the relay block height is not within the current session"
+	ServicerNotInSessionError                      = "the servicer is not part of the session"
+	RelayLimitExceededError                        = "the application has exhausted the relays it can send to the servicer during the session"
+	RelayExecutionError                            = "an error occurred executing the relay"
+	RelayResponseSignError                         = "an error occurred signing the relay response"
+	ServicerDisabledError                          = "the node is not configured to service relays"
+	RelayStoreError                                = "an error occurred accessing the relay store"
+	InvalidMerkleSumTreeError                      = "the merkle sum tree is invalid"
+	InvalidClaimError                              = "the claim is invalid"
+	ClaimNotFoundError                             = "the claim was not found"
+	InvalidProofError                              = "the proof is invalid"
+	GetClaimError                                  = "an error occurred getting the claim"
+	DeleteClaimError                               = "an error occurred deleting the claim"
+	InvalidTestScoreError                          = "the test score is invalid"
+	TestScoreNotFoundError                         = "the test score was not found"
+	GetTestScoreError                              = "an error occurred getting the test score"
+	DeleteTestScoreError                           = "an error occurred deleting the test score"
+	InvalidDAOPercentageOfRelayRewardsError        = "the DAO percentage of relay rewards is not between 0 and 100"
+	DuplicateDoubleSignEvidenceError               = "the validator was already punished for double signing at this height"
+	GetDoubleSignEvidenceError                     = "an error occurred getting the double sign evidence"
+	InvalidDoubleSignReporterRewardPercentageError = "the double sign reporter reward percentage is not between 0 and 100"
+	GetBlockError                                  = "an error occurred getting the block"
+	InvalidQuorumCertificateError                  = "the quorum certificate is invalid"
+	MempoolFullError                               = "the mempool is full and the fee per byte of the transaction is too low to evict other transactions"
+	EmptyMempoolError                              = "the mempool is empty"
+	GetTxFeeError                                  = "an error occurred getting the fee of the transaction"
+	TransactionExpiredError                        = "the transaction expired"
+	TransactionExpiryTooFarError                   = "the expiry height of the transaction is too far in the future"
+	DelegationNotFoundError                        = "the delegation was not found"
+	AlreadyUndelegatingError                       = "the delegation is already unbonding"
+	GetDelegationError                             = "an error occurred getting the delegation"
+	SetDelegationError                             = "an error occurred setting the delegation"
+	DeleteDelegationError                          = "an error occurred deleting the delegation"
+	EmptyParameterChangesError                     = "the proposal does not change any parameter"
+	DuplicateParameterChangeError                  = "the proposal changes the same parameter more than once"
+	InvalidVoteOptionError                         = "the vote option is not valid"
+	ProposalNotFoundError                          = "the proposal was not found"
+	ProposalNotVotingError                         = "the proposal is not being voted on"
+	GetProposalError                               = "an error occurred getting the proposal"
+	SetProposalError                               = "an error occurred setting the proposal"
+	GetVotesError                                  = "an error occurred getting the votes"
+	SetVoteError                                   = "an error occurred setting the vote"
+	InvalidUpgradeHeightError                      = "the activation height of the upgrade must be in the future"
+	SetUpgradePlanError                            = "an error occurred setting the upgrade plan"
+	InsufficientVestedAmountError                  = "the account does not have enough vested tokens, the rest of its balance is locked by its vesting schedule"
+	GetVestingScheduleError                        = "an error occurred getting the vesting schedule"
+	RotateOperatorKeyError                         = "an error occurred rotating the operator key of the actor"
+	InvalidGeoZoneError                            = "the geo zone is invalid"
+	GeoZoneNotAllowedError                         = "actors cannot stake in the geo zone"
+	InvalidRelayChainsError                        = "the relay chain registry is invalid"
+	RelayChainNotRegisteredError                   = "the relay chain is not in the relay chain registry"
+	GetCurrentOperatorError                        = "an error occurred getting the current operator of the actor"
+	GetUpgradePlanError                            = "an error occurred getting the upgrade plan"
+	InvalidActorsPerSessionError                   = "the number of actors per session cannot be negative"
+	VolumeTxSubmissionError                        = "an error occurred submitting a claim or proof transaction"
+	AppNotStakedForRelayChainError                 = "the application is not staked for the relay chain"
+	NonPositiveParamError                          = "the parameter must be positive"
 )
 
 func ErrUnknownParam(paramName string) Error {
@@ -819,3 +941,247 @@ func ErrUnknownActorType(actorType string) Error {
 func ErrUnknownMessageType(messageType any) Error {
 	return NewError(CodeUnknownMessageType, fmt.Sprintf("%s: %v", UnknownMessageTypeError, messageType))
 }
+
+func ErrInvalidBlocksPerSession(blocksPerSession int) Error {
+	return NewError(CodeInvalidBlocksPerSessionError, fmt.Sprintf("%s: %d", InvalidBlocksPerSessionError, blocksPerSession))
+}
+
+func ErrInvalidRelay(reason string) Error {
+	return NewError(CodeInvalidRelayError, fmt.Sprintf("%s: %s", InvalidRelayError, reason))
+}
+
+func ErrUnsupportedRelayChain(relayChain string) Error {
+	return NewError(CodeUnsupportedRelayChainError, fmt.Sprintf("%s: %s", UnsupportedRelayChainError, relayChain))
+}
+
+func ErrInvalidAAT(reason string) Error {
+	return NewError(CodeInvalidAATError, fmt.Sprintf("%s: %s", InvalidAATError, reason))
+}
+
+func ErrRelayOutOfSession(relayHeight, sessionHeight int64) Error {
+	return NewError(CodeRelayOutOfSessionError, fmt.Sprintf("%s: relay height %d, session height %d", RelayOutOfSessionError, relayHeight, sessionHeight))
+}
+
+func ErrServicerNotInSession() Error {
+	return NewError(CodeServicerNotInSessionError, ServicerNotInSessionError)
+}
+
+func ErrRelayLimitExceeded(maxRelays string) Error {
+	return NewError(CodeRelayLimitExceededError, fmt.Sprintf("%s: %s", RelayLimitExceededError, maxRelays))
+}
+
+func ErrRelayExecution(err error) Error {
+	return NewError(CodeRelayExecutionError, fmt.Sprintf("%s: %s", RelayExecutionError, err.Error()))
+}
+
+func ErrRelayResponseSign(err error) Error {
+	return NewError(CodeRelayResponseSignError, fmt.Sprintf("%s: %s", RelayResponseSignError, err.Error()))
+}
+
+func ErrServicerDisabled() Error {
+	return NewError(CodeServicerDisabledError, ServicerDisabledError)
+}
+
+func ErrRelayStore(err error) Error {
+	return NewError(CodeRelayStoreError, fmt.Sprintf("%s: %s", RelayStoreError, err.Error()))
+}
+
+func ErrInvalidMerkleSumTree(reason string) Error {
+	return NewError(CodeInvalidMerkleSumTreeError, fmt.Sprintf("%s: %s", InvalidMerkleSumTreeError, reason))
+}
+
+func ErrInvalidClaim(reason string) Error {
+	return NewError(CodeInvalidClaimError, fmt.Sprintf("%s: %s", InvalidClaimError, reason))
+}
+
+func ErrClaimNotFound() Error {
+	return NewError(CodeClaimNotFoundError, ClaimNotFoundError)
+}
+
+func ErrInvalidProof(reason string) Error {
+	return NewError(CodeInvalidProofError, fmt.Sprintf("%s: %s", InvalidProofError, reason))
+}
+
+func ErrGetClaim(err error) Error {
+	return NewError(CodeGetClaimError, fmt.Sprintf("%s: %s", GetClaimError, err.Error()))
+}
+
+func ErrDeleteClaim(err error) Error {
+	return NewError(CodeDeleteClaimError, fmt.Sprintf("%s: %s", DeleteClaimError, err.Error()))
+}
+
+func ErrInvalidTestScore(reason string) Error {
+	return NewError(CodeInvalidTestScoreError, fmt.Sprintf("%s: %s", InvalidTestScoreError, reason))
+}
+
+func ErrTestScoreNotFound() Error {
+	return NewError(CodeTestScoreNotFoundError, TestScoreNotFoundError)
+}
+
+func ErrGetTestScore(err error) Error {
+	return NewError(CodeGetTestScoreError, fmt.Sprintf("%s: %s", GetTestScoreError, err.Error()))
+}
+
+func ErrDeleteTestScore(err error) Error {
+	return NewError(CodeDeleteTestScoreError, fmt.Sprintf("%s: %s", DeleteTestScoreError, err.Error()))
+}
+
+func ErrInvalidDAOPercentageOfRelayRewards() Error {
+	return NewError(CodeInvalidDAOPercentageOfRelayRewardsError, InvalidDAOPercentageOfRelayRewardsError)
+}
+
+func ErrDuplicateDoubleSignEvidence() Error {
+	return NewError(CodeDuplicateDoubleSignEvidenceError, DuplicateDoubleSignEvidenceError)
+}
+
+func ErrGetDoubleSignEvidence(err error) Error {
+	return NewError(CodeGetDoubleSignEvidenceError, fmt.Sprintf("%s: %s", GetDoubleSignEvidenceError, err.Error()))
+}
+
+func ErrInvalidDoubleSignReporterRewardPercentage() Error {
+	return NewError(CodeInvalidDoubleSignReporterRewardPercentageError, InvalidDoubleSignReporterRewardPercentageError)
+}
+
+func ErrGetBlock(err error) Error {
+	return NewError(CodeGetBlockError, fmt.Sprintf("%s: %s", GetBlockError, err.Error()))
+}
+
+func ErrInvalidQuorumCertificate(err error) Error {
+	return NewError(CodeInvalidQuorumCertificateError, fmt.Sprintf("%s: %s", InvalidQuorumCertificateError, err.Error()))
+}
+
+func ErrMempoolFull() Error {
+	return NewError(CodeMempoolFullError, MempoolFullError)
+}
+
+func ErrEmptyMempool() Error {
+	return NewError(CodeEmptyMempoolError, EmptyMempoolError)
+}
+
+func ErrGetTxFee(err error) Error {
+	return NewError(CodeGetTxFeeError, fmt.Sprintf("%s: %s", GetTxFeeError, err.Error()))
+}
+
+func ErrTransactionExpired(expiryHeight, height int64) Error {
+	return NewError(CodeTransactionExpiredError, fmt.Sprintf("%s: expiry height %d, height %d", TransactionExpiredError, expiryHeight, height))
+}
+
+func ErrTransactionExpiryTooFar(expiryHeight, maxExpiryHeight int64) Error {
+	return NewError(CodeTransactionExpiryTooFarError, fmt.Sprintf("%s: expiry height %d, max expiry height %d", TransactionExpiryTooFarError, expiryHeight, maxExpiryHeight))
+}
+
+func ErrDelegationNotFound() Error {
+	return NewError(CodeDelegationNotFoundError, DelegationNotFoundError)
+}
+
+func ErrAlreadyUndelegating() Error {
+	return NewError(CodeAlreadyUndelegatingError, AlreadyUndelegatingError)
+}
+
+func ErrGetDelegation(err error) Error {
+	return NewError(CodeGetDelegationError, fmt.Sprintf("%s: %s", GetDelegationError, err.Error()))
+}
+
+func ErrSetDelegation(err error) Error {
+	return NewError(CodeSetDelegationError, fmt.Sprintf("%s: %s", SetDelegationError, err.Error()))
+}
+
+func ErrDeleteDelegation(err error) Error {
+	return NewError(CodeDeleteDelegationError, fmt.Sprintf("%s: %s", DeleteDelegationError, err.Error()))
+}
+
+func ErrEmptyParameterChanges() Error {
+	return NewError(CodeEmptyParameterChangesError, EmptyParameterChangesError)
+}
+
+func ErrDuplicateParameterChange(paramName string) Error {
+	return NewError(CodeDuplicateParameterChangeError, fmt.Sprintf("%s: %s", DuplicateParameterChangeError, paramName))
+}
+
+func ErrInvalidVoteOption(option int32) Error {
+	return NewError(CodeInvalidVoteOptionError, fmt.Sprintf("%s: %d", InvalidVoteOptionError, option))
+}
+
+func ErrProposalNotFound(id uint64) Error {
+	return NewError(CodeProposalNotFoundError, fmt.Sprintf("%s: %d", ProposalNotFoundError, id))
+}
+
+func ErrProposalNotVoting(id uint64) Error {
+	return NewError(CodeProposalNotVotingError, fmt.Sprintf("%s: %d", ProposalNotVotingError, id))
+}
+
+func ErrGetProposal(err error) Error {
+	return NewError(CodeGetProposalError, fmt.Sprintf("%s: %s", GetProposalError, err.Error()))
+}
+
+func ErrSetProposal(err error) Error {
+	return NewError(CodeSetProposalError, fmt.Sprintf("%s: %s", SetProposalError, err.Error()))
+}
+
+func ErrGetVotes(err error) Error {
+	return NewError(CodeGetVotesError, fmt.Sprintf("%s: %s", GetVotesError, err.Error()))
+}
+
+func ErrSetVote(err error) Error {
+	return NewError(CodeSetVoteError, fmt.Sprintf("%s: %s", SetVoteError, err.Error()))
+}
+
+func ErrInvalidUpgradeHeight(activationHeight, height int64) Error {
+	return NewError(CodeInvalidUpgradeHeightError, fmt.Sprintf("%s: activation height %d, current height %d", InvalidUpgradeHeightError, activationHeight, height))
+}
+
+func ErrSetUpgradePlan(err error) Error {
+	return NewError(CodeSetUpgradePlanError, fmt.Sprintf("%s: %s", SetUpgradePlanError, err.Error()))
+}
+
+func ErrInsufficientVestedAmount(address string, lockedAmount string) Error {
+	return NewError(CodeInsufficientVestedAmountError, fmt.Sprintf("%s: address %s, locked amount %s", InsufficientVestedAmountError, address, lockedAmount))
+}
+
+func ErrGetVestingSchedule(err error) Error {
+	return NewError(CodeGetVestingScheduleError, fmt.Sprintf("%s: %s", GetVestingScheduleError, err.Error()))
+}
+
+func ErrRotateOperatorKey(err error) Error {
+	return NewError(CodeRotateOperatorKeyError, fmt.Sprintf("%s: %s", RotateOperatorKeyError, err.Error()))
+}
+
+func ErrInvalidGeoZone(geoZone string) Error {
+	return NewError(CodeInvalidGeoZoneError, fmt.Sprintf("%s: %s", InvalidGeoZoneError, geoZone))
+}
+
+func ErrGeoZoneNotAllowed(geoZone string) Error {
+	return NewError(CodeGeoZoneNotAllowedError, fmt.Sprintf("%s: %s", GeoZoneNotAllowedError, geoZone))
+}
+
+func ErrInvalidRelayChains(reason string) Error {
+	return NewError(CodeInvalidRelayChainsError, fmt.Sprintf("%s: %s", InvalidRelayChainsError, reason))
+}
+
+func ErrRelayChainNotRegistered(chain string) Error {
+	return NewError(CodeRelayChainNotRegisteredError, fmt.Sprintf("%s: %s", RelayChainNotRegisteredError, chain))
+}
+
+func ErrGetCurrentOperator(err error) Error {
+	return NewError(CodeGetCurrentOperatorError, fmt.Sprintf("%s: %s", GetCurrentOperatorError, err.Error()))
+}
+
+func ErrGetUpgradePlan(err error) Error {
+	return NewError(CodeGetUpgradePlanError, fmt.Sprintf("%s: %s", GetUpgradePlanError, err.Error()))
+}
+
+func ErrInvalidActorsPerSession(paramName string, actorsPerSession int) Error {
+	return NewError(CodeInvalidActorsPerSessionError, fmt.Sprintf("%s: %s is %d", InvalidActorsPerSessionError, paramName, actorsPerSession))
+}
+
+func ErrVolumeTxSubmission(err error) Error {
+	return NewError(CodeVolumeTxSubmissionError, fmt.Sprintf("%s: %s", VolumeTxSubmissionError, err.Error()))
+}
+
+func ErrAppNotStakedForRelayChain(relayChain string) Error {
+	return NewError(CodeAppNotStakedForRelayChainError, fmt.Sprintf("%s: %s", AppNotStakedForRelayChainError, relayChain))
+}
+
+func ErrNonPositiveParam(paramName string, value int32) Error {
+	return NewError(CodeNonPositiveParamError, fmt.Sprintf("%s: %s is %d", NonPositiveParamError, paramName, value))
+}
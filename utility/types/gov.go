@@ -6,6 +6,23 @@ package types
 const (
 	// Session gov params
 	BlocksPerSessionParamName = "blocks_per_session"
+	// The comma separated list of the geo zones actors can stake in. Actors staked without a geo zone take part in the
+	// sessions of every geo zone.
+	AllowedGeoZonesParamName = "allowed_geo_zones"
+	// The registry of the relay chains actors can stake for, as a comma separated list of `<id>:<name>` entries (e.g.
+	// "0001:Pocket Network,0021:Ethereum Mainnet"). An empty registry does not restrict the chains actors can stake for.
+	RelayChainsParamName = "relay_chains"
+
+	// Transaction gov params
+	// The maximum number of blocks a transaction can be included in after the block it is submitted for, bounding
+	// the window in which it can be replayed.
+	MaxTransactionExpiryBlocksParamName = "max_transaction_expiry_blocks"
+
+	// Governance gov params
+	// The number of blocks proposals can be voted on, and the percentage of the total validator stake that must vote
+	// on a proposal for its votes to be counted.
+	GovernanceVotingPeriodBlocksParamName = "governance_voting_period_blocks"
+	GovernanceQuorumPercentageParamName   = "governance_quorum_percentage"
 
 	// Application actor gov params
 	AppMinimumStakeParamName       = "app_minimum_stake"
@@ -20,12 +37,16 @@ const (
 	AppSessionTokensMultiplierParamName = "app_session_tokens_multiplier"
 
 	// Servicer actor gov params
-	ServicerMinimumStakeParamName       = "servicer_minimum_stake"
-	ServicerMaxChainsParamName          = "servicer_max_chains"
-	ServicerUnstakingBlocksParamName    = "servicer_unstaking_blocks"
-	ServicerMinimumPauseBlocksParamName = "servicer_minimum_pause_blocks"
-	ServicerMaxPauseBlocksParamName     = "servicer_max_pause_blocks"
-	ServicersPerSessionParamName        = "servicers_per_session"
+	ServicerMinimumStakeParamName        = "servicer_minimum_stake"
+	ServicerMaxChainsParamName           = "servicer_max_chains"
+	ServicerUnstakingBlocksParamName     = "servicer_unstaking_blocks"
+	ServicerMinimumPauseBlocksParamName  = "servicer_minimum_pause_blocks"
+	ServicerMaxPauseBlocksParamName      = "servicer_max_pause_blocks"
+	ServicersPerSessionParamName         = "servicers_per_session"
+	ClaimExpirationBlocksParamName       = "claim_expiration_blocks"
+	TestScoreExpirationBlocksParamName   = "test_score_expiration_blocks"
+	RelaysToTokensMultiplierParamName    = "relays_to_tokens_multiplier"
+	DAOPercentageOfRelayRewardsParamName = "dao_percentage_of_relay_rewards"
 
 	// Fisherman actor gov params
 	FishermanMinimumStakeParamName       = "fisherman_minimum_stake"
@@ -33,6 +54,7 @@ const (
 	FishermanUnstakingBlocksParamName    = "fisherman_unstaking_blocks"
 	FishermanMinimumPauseBlocksParamName = "fisherman_minimum_pause_blocks"
 	FishermanMaxPauseBlocksParamName     = "fisherman_max_pause_blocks"
+	FishermenPerSessionParamName         = "fishermen_per_session"
 
 	// Validator actor gov params
 	ValidatorMinimumStakeParamName        = "validator_minimum_stake"
@@ -42,10 +64,11 @@ const (
 	ValidatorMaximumMissedBlocksParamName = "validator_maximum_missed_blocks"
 
 	// Validator (complex) actor gov params
-	ValidatorMaxEvidenceAgeInBlocksParamName = "validator_max_evidence_age_in_blocks"
-	ProposerPercentageOfFeesParamName        = "proposer_percentage_of_fees"
-	MissedBlocksBurnPercentageParamName      = "missed_blocks_burn_percentage"
-	DoubleSignBurnPercentageParamName        = "double_sign_burn_percentage"
+	ValidatorMaxEvidenceAgeInBlocksParamName    = "validator_max_evidence_age_in_blocks"
+	ProposerPercentageOfFeesParamName           = "proposer_percentage_of_fees"
+	MissedBlocksBurnPercentageParamName         = "missed_blocks_burn_percentage"
+	DoubleSignBurnPercentageParamName           = "double_sign_burn_percentage"
+	DoubleSignReporterRewardPercentageParamName = "double_sign_reporter_reward_percentage"
 
 	// Pocket specific message gov params
 	MessageStakeFishermanFee         = "message_stake_fisherman_fee"
@@ -58,13 +81,19 @@ const (
 	MessageProveTestScoreFee         = "message_prove_test_score_fee"
 
 	// Proof-of-stake message gov params
-	MessageDoubleSignFee   = "message_double_sign_fee"
-	MessageSendFee         = "message_send_fee"
-	MessageStakeAppFee     = "message_stake_app_fee"
-	MessageEditStakeAppFee = "message_edit_stake_app_fee"
-	MessageUnstakeAppFee   = "message_unstake_app_fee"
-	MessagePauseAppFee     = "message_pause_app_fee"
-	MessageUnpauseAppFee   = "message_unpause_app_fee"
+	MessageDoubleSignFee        = "message_double_sign_fee"
+	MessageDelegateFee          = "message_delegate_fee"
+	MessageUndelegateFee        = "message_undelegate_fee"
+	MessageSubmitProposalFee    = "message_submit_proposal_fee"
+	MessageVoteFee              = "message_vote_fee"
+	MessageScheduleUpgradeFee   = "message_schedule_upgrade_fee"
+	MessageRotateOperatorKeyFee = "message_rotate_operator_key_fee"
+	MessageSendFee              = "message_send_fee"
+	MessageStakeAppFee          = "message_stake_app_fee"
+	MessageEditStakeAppFee      = "message_edit_stake_app_fee"
+	MessageUnstakeAppFee        = "message_unstake_app_fee"
+	MessagePauseAppFee          = "message_pause_app_fee"
+	MessageUnpauseAppFee        = "message_unpause_app_fee"
 
 	// Validator message gov params
 	MessageStakeValidatorFee     = "message_stake_validator_fee"
@@ -82,6 +111,8 @@ const (
 
 	// Parameter / flags gov params
 	MessageChangeParameterFee = "message_change_parameter_fee"
+	MessageClaimFee           = "message_claim_fee"
+	MessageProofFee           = "message_proof_fee"
 )
 
 // TECHDEBT: The parameters below are equivalent to the list above with the suffix `_owner`. There
@@ -92,6 +123,13 @@ const (
 	AclOwner = "acl_owner"
 
 	BlocksPerSessionOwner = "blocks_per_session_owner"
+	AllowedGeoZonesOwner  = "allowed_geo_zones_owner"
+	RelayChainsOwner      = "relay_chains_owner"
+
+	MaxTransactionExpiryBlocksOwner = "max_transaction_expiry_blocks_owner"
+
+	GovernanceVotingPeriodBlocksOwner = "governance_voting_period_blocks_owner"
+	GovernanceQuorumPercentageOwner   = "governance_quorum_percentage_owner"
 
 	AppMinimumStakeOwner = "app_minimum_stake_owner"
 	AppMaxChainsOwner    = "app_max_chains_owner"
@@ -101,18 +139,23 @@ const (
 	AppMinimumPauseBlocksOwner      = "app_minimum_pause_blocks_owner"
 	AppMaxPausedBlocksOwner         = "app_max_paused_blocks_owner"
 
-	ServicerMinimumStakeOwner       = "servicer_minimum_stake_owner"
-	ServicerMaxChainsOwner          = "servicer_max_chains_owner"
-	ServicerUnstakingBlocksOwner    = "servicer_unstaking_blocks_owner"
-	ServicerMinimumPauseBlocksOwner = "servicer_minimum_pause_blocks_owner"
-	ServicerMaxPausedBlocksOwner    = "servicer_max_paused_blocks_owner"
-	ServicersPerSessionOwner        = "servicers_per_session_owner"
+	ServicerMinimumStakeOwner        = "servicer_minimum_stake_owner"
+	ServicerMaxChainsOwner           = "servicer_max_chains_owner"
+	ServicerUnstakingBlocksOwner     = "servicer_unstaking_blocks_owner"
+	ServicerMinimumPauseBlocksOwner  = "servicer_minimum_pause_blocks_owner"
+	ServicerMaxPausedBlocksOwner     = "servicer_max_paused_blocks_owner"
+	ServicersPerSessionOwner         = "servicers_per_session_owner"
+	ClaimExpirationBlocksOwner       = "claim_expiration_blocks_owner"
+	TestScoreExpirationBlocksOwner   = "test_score_expiration_blocks_owner"
+	RelaysToTokensMultiplierOwner    = "relays_to_tokens_multiplier_owner"
+	DAOPercentageOfRelayRewardsOwner = "dao_percentage_of_relay_rewards_owner"
 
 	FishermanMinimumStakeOwner       = "fisherman_minimum_stake_owner"
 	FishermanMaxChainsOwner          = "fisherman_max_chains_owner"
 	FishermanUnstakingBlocksOwner    = "fisherman_unstaking_blocks_owner"
 	FishermanMinimumPauseBlocksOwner = "fisherman_minimum_pause_blocks_owner"
 	FishermanMaxPausedBlocksOwner    = "fisherman_max_paused_blocks_owner"
+	FishermenPerSessionOwner         = "fishermen_per_session_owner"
 
 	ValidatorMinimumStakeOwner           = "validator_minimum_stake_owner"
 	ValidatorUnstakingBlocksOwner        = "validator_unstaking_blocks_owner"
@@ -121,11 +164,18 @@ const (
 	ValidatorMaximumMissedBlocksOwner    = "validator_maximum_missed_blocks_owner"
 	ValidatorMaxEvidenceAgeInBlocksOwner = "validator_max_evidence_age_in_blocks_owner"
 
-	ProposerPercentageOfFeesOwner   = "proposer_percentage_of_fees_owner"
-	MissedBlocksBurnPercentageOwner = "missed_blocks_burn_percentage_owner"
-	DoubleSignBurnPercentageOwner   = "double_sign_burn_percentage_owner"
-	MessageDoubleSignFeeOwner       = "message_double_sign_fee_owner"
-	MessageSendFeeOwner             = "message_send_fee_owner"
+	ProposerPercentageOfFeesOwner           = "proposer_percentage_of_fees_owner"
+	MissedBlocksBurnPercentageOwner         = "missed_blocks_burn_percentage_owner"
+	DoubleSignBurnPercentageOwner           = "double_sign_burn_percentage_owner"
+	DoubleSignReporterRewardPercentageOwner = "double_sign_reporter_reward_percentage_owner"
+	MessageDoubleSignFeeOwner               = "message_double_sign_fee_owner"
+	MessageDelegateFeeOwner                 = "message_delegate_fee_owner"
+	MessageUndelegateFeeOwner               = "message_undelegate_fee_owner"
+	MessageSubmitProposalFeeOwner           = "message_submit_proposal_fee_owner"
+	MessageVoteFeeOwner                     = "message_vote_fee_owner"
+	MessageScheduleUpgradeFeeOwner          = "message_schedule_upgrade_fee_owner"
+	MessageRotateOperatorKeyFeeOwner        = "message_rotate_operator_key_fee_owner"
+	MessageSendFeeOwner                     = "message_send_fee_owner"
 
 	MessageStakeFishermanFeeOwner         = "message_stake_fisherman_fee_owner"
 	MessageEditStakeFishermanFeeOwner     = "message_edit_stake_fisherman_fee_owner"
@@ -152,4 +202,6 @@ const (
 	MessageUnpauseServicerFeeOwner        = "message_unpause_servicer_fee_owner"
 
 	MessageChangeParameterFeeOwner = "message_change_parameter_fee_owner"
+	MessageClaimFeeOwner           = "message_claim_fee_owner"
+	MessageProofFeeOwner           = "message_proof_fee_owner"
 )
@@ -0,0 +1,193 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"sort"
+
+	"github.com/pokt-network/pocket/shared/crypto"
+)
+
+// Domain separation prefixes so a leaf can never be presented as an inner node and vice versa
+const (
+	merkleSumLeafPrefix  byte = 0x00
+	merkleSumInnerPrefix byte = 0x01
+)
+
+// MerkleSumLeaf is a single element committed to by a Merkle sum tree, such as a serviced relay
+type MerkleSumLeaf struct {
+	Hash []byte
+	Sum  uint64
+}
+
+// MerkleSumTree is a binary Merkle tree where every node commits to the sum of the leaves below it.
+// The leaves are sorted by hash so the tree does not depend on the order in which they were collected,
+// and the last node of a level with an odd number of nodes is promoted to the next level unchanged.
+//
+// Every inner node also commits to the range of leaf hashes below each of its children, so the verifier
+// can check that the leaves on both sides of a proof are strictly ordered. A leaf repeated in the tree
+// breaks the ordering of one of the nodes above it, so its proof cannot be verified, and sampling a leaf
+// in sum space only succeeds with the probability that the sum of the tree is made of distinct leaves.
+type MerkleSumTree struct {
+	leaves []MerkleSumLeaf
+	levels [][]merkleSumNode // levels[0] are the leaves and levels[len(levels)-1] is the root
+}
+
+type merkleSumNode struct {
+	hash    []byte
+	sum     uint64
+	minHash []byte // the smallest leaf hash below the node
+	maxHash []byte // the largest leaf hash below the node
+}
+
+func NewMerkleSumTree(leaves []MerkleSumLeaf) (*MerkleSumTree, Error) {
+	if len(leaves) == 0 {
+		return nil, ErrInvalidMerkleSumTree("no leaves")
+	}
+
+	sorted := make([]MerkleSumLeaf, len(leaves))
+	copy(sorted, leaves)
+	sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(sorted[i].Hash, sorted[j].Hash) < 0 })
+
+	var total uint64
+	for i, leaf := range sorted {
+		if leaf.Sum == 0 {
+			return nil, ErrInvalidMerkleSumTree("leaf with a zero sum")
+		}
+		if i > 0 && bytes.Equal(leaf.Hash, sorted[i-1].Hash) {
+			return nil, ErrInvalidMerkleSumTree("duplicate leaf")
+		}
+		if leaf.Sum > math.MaxUint64-total {
+			return nil, ErrInvalidMerkleSumTree("sum overflow")
+		}
+		total += leaf.Sum
+	}
+	return newMerkleSumTree(sorted), nil
+}
+
+// newMerkleSumTree builds the tree of `leaves` in the order provided, without validating them
+func newMerkleSumTree(leaves []MerkleSumLeaf) *MerkleSumTree {
+	level := make([]merkleSumNode, len(leaves))
+	for i, leaf := range leaves {
+		level[i] = newMerkleSumLeafNode(leaf)
+	}
+
+	levels := [][]merkleSumNode{level}
+	for len(level) > 1 {
+		next := make([]merkleSumNode, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				continue
+			}
+			next = append(next, merkleSumInnerNode(level[i], level[i+1]))
+		}
+		levels = append(levels, next)
+		level = next
+	}
+
+	return &MerkleSumTree{leaves: leaves, levels: levels}
+}
+
+// Root returns the root hash of the tree and the sum of all its leaves
+func (t *MerkleSumTree) Root() ([]byte, uint64) {
+	root := t.levels[len(t.levels)-1][0]
+	return root.hash, root.sum
+}
+
+// Prove returns the leaf covering `target` when the leaves are laid out one after the other in sum space
+// (i.e. leaf i covers [sum of leaves before i, sum of leaves before i + its sum)), along with its proof
+func (t *MerkleSumTree) Prove(target uint64) (MerkleSumLeaf, []*MerkleSumProofNode, Error) {
+	if _, rootSum := t.Root(); target >= rootSum {
+		return MerkleSumLeaf{}, nil, ErrInvalidMerkleSumTree("proof target exceeds the sum of the tree")
+	}
+
+	leafIndex := 0
+	for offset := uint64(0); target >= offset+t.leaves[leafIndex].Sum; leafIndex++ {
+		offset += t.leaves[leafIndex].Sum
+	}
+
+	proof := make([]*MerkleSumProofNode, 0, len(t.levels)-1)
+	index := leafIndex
+	for _, level := range t.levels[:len(t.levels)-1] {
+		siblingIndex := index ^ 1
+		// The node was promoted to the next level, so there is no sibling to hash it with
+		if siblingIndex < len(level) {
+			sibling := level[siblingIndex]
+			proof = append(proof, &MerkleSumProofNode{
+				Hash:    sibling.hash,
+				Sum:     sibling.sum,
+				IsLeft:  siblingIndex < index,
+				MinHash: sibling.minHash,
+				MaxHash: sibling.maxHash,
+			})
+		}
+		index /= 2
+	}
+	return t.leaves[leafIndex], proof, nil
+}
+
+// VerifyMerkleSumProof verifies that `leaf` is part of the tree with the provided root, that it covers
+// `target` in the sum space of the tree (see `MerkleSumTree.Prove`), and that the leaves on both sides of
+// every node on its path are strictly ordered by hash, so the leaf is not repeated anywhere in the tree
+func VerifyMerkleSumProof(rootHash []byte, rootSum uint64, leaf MerkleSumLeaf, proof []*MerkleSumProofNode, target uint64) bool {
+	if leaf.Sum == 0 {
+		return false
+	}
+
+	node := newMerkleSumLeafNode(leaf)
+	// The sum of the leaves to the left of the proven leaf
+	var offset uint64
+	for _, sibling := range proof {
+		if sibling == nil || sibling.Sum > math.MaxUint64-node.sum || bytes.Compare(sibling.MinHash, sibling.MaxHash) > 0 {
+			return false
+		}
+		siblingNode := merkleSumNode{hash: sibling.Hash, sum: sibling.Sum, minHash: sibling.MinHash, maxHash: sibling.MaxHash}
+		if sibling.IsLeft {
+			if bytes.Compare(siblingNode.maxHash, node.minHash) >= 0 {
+				return false
+			}
+			offset += sibling.Sum
+			node = merkleSumInnerNode(siblingNode, node)
+		} else {
+			if bytes.Compare(node.maxHash, siblingNode.minHash) >= 0 {
+				return false
+			}
+			node = merkleSumInnerNode(node, siblingNode)
+		}
+	}
+
+	if !bytes.Equal(node.hash, rootHash) || node.sum != rootSum {
+		return false
+	}
+	return offset <= target && target-offset < leaf.Sum
+}
+
+func newMerkleSumLeafNode(leaf MerkleSumLeaf) merkleSumNode {
+	bz := make([]byte, 0, 1+len(leaf.Hash)+8)
+	bz = append(bz, merkleSumLeafPrefix)
+	bz = append(bz, leaf.Hash...)
+	bz = append(bz, uint64ToBytes(leaf.Sum)...)
+	return merkleSumNode{hash: crypto.SHA3Hash(bz), sum: leaf.Sum, minHash: leaf.Hash, maxHash: leaf.Hash}
+}
+
+func merkleSumInnerNode(left, right merkleSumNode) merkleSumNode {
+	bz := []byte{merkleSumInnerPrefix}
+	for _, child := range []merkleSumNode{left, right} {
+		bz = append(bz, child.hash...)
+		bz = append(bz, uint64ToBytes(child.sum)...)
+		// The leaf hash ranges are length prefixed so their bytes cannot be shifted from one to the other
+		bz = append(bz, uint64ToBytes(uint64(len(child.minHash)))...)
+		bz = append(bz, child.minHash...)
+		bz = append(bz, uint64ToBytes(uint64(len(child.maxHash)))...)
+		bz = append(bz, child.maxHash...)
+	}
+	return merkleSumNode{hash: crypto.SHA3Hash(bz), sum: left.sum + right.sum, minHash: left.minHash, maxHash: right.maxHash}
+}
+
+func uint64ToBytes(i uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, i)
+	return bz
+}
@@ -0,0 +1,161 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerkleSumTree_ProveAndVerify(t *testing.T) {
+	for _, numLeaves := range []int{1, 2, 3, 4, 5, 7, 8, 13} {
+		t.Run(fmt.Sprintf("%d leaves", numLeaves), func(t *testing.T) {
+			leaves := newTestMerkleSumLeaves(numLeaves)
+			var expectedSum uint64
+			for _, leaf := range leaves {
+				expectedSum += leaf.Sum
+			}
+
+			tree, err := NewMerkleSumTree(leaves)
+			require.NoError(t, err)
+			rootHash, rootSum := tree.Root()
+			require.Equal(t, expectedSum, rootSum)
+
+			// Every target is covered by a single leaf, whose proof is only valid for the targets it covers
+			for target := uint64(0); target < rootSum; target++ {
+				leaf, proof, err := tree.Prove(target)
+				require.NoError(t, err)
+				require.True(t, VerifyMerkleSumProof(rootHash, rootSum, leaf, proof, target))
+
+				for otherTarget := uint64(0); otherTarget < rootSum; otherTarget++ {
+					otherLeaf, _, err := tree.Prove(otherTarget)
+					require.NoError(t, err)
+					coversOtherTarget := string(otherLeaf.Hash) == string(leaf.Hash)
+					require.Equal(t, coversOtherTarget, VerifyMerkleSumProof(rootHash, rootSum, leaf, proof, otherTarget))
+				}
+			}
+
+			_, _, err = tree.Prove(rootSum)
+			require.Equal(t, CodeInvalidMerkleSumTreeError, err.Code())
+		})
+	}
+}
+
+func TestMerkleSumTree_OrderIndependent(t *testing.T) {
+	leaves := newTestMerkleSumLeaves(5)
+	reversed := make([]MerkleSumLeaf, len(leaves))
+	for i, leaf := range leaves {
+		reversed[len(leaves)-1-i] = leaf
+	}
+
+	tree, err := NewMerkleSumTree(leaves)
+	require.NoError(t, err)
+	reversedTree, err := NewMerkleSumTree(reversed)
+	require.NoError(t, err)
+
+	rootHash, rootSum := tree.Root()
+	reversedRootHash, reversedRootSum := reversedTree.Root()
+	require.Equal(t, rootHash, reversedRootHash)
+	require.Equal(t, rootSum, reversedRootSum)
+}
+
+func TestMerkleSumTree_InvalidLeaves(t *testing.T) {
+	_, err := NewMerkleSumTree(nil)
+	require.Equal(t, CodeInvalidMerkleSumTreeError, err.Code())
+
+	leaves := newTestMerkleSumLeaves(3)
+	_, err = NewMerkleSumTree(append(leaves, leaves[0]))
+	require.Equal(t, CodeInvalidMerkleSumTreeError, err.Code())
+
+	leaves = newTestMerkleSumLeaves(3)
+	leaves[1].Sum = 0
+	_, err = NewMerkleSumTree(leaves)
+	require.Equal(t, CodeInvalidMerkleSumTreeError, err.Code())
+}
+
+func TestMerkleSumTree_VerifyTamperedProof(t *testing.T) {
+	tree, err := NewMerkleSumTree(newTestMerkleSumLeaves(6))
+	require.NoError(t, err)
+	rootHash, rootSum := tree.Root()
+
+	target := rootSum / 2
+	leaf, proof, err := tree.Prove(target)
+	require.NoError(t, err)
+	require.True(t, VerifyMerkleSumProof(rootHash, rootSum, leaf, proof, target))
+
+	// inflating the sum of the proven leaf
+	inflatedLeaf := MerkleSumLeaf{Hash: leaf.Hash, Sum: leaf.Sum + 1}
+	require.False(t, VerifyMerkleSumProof(rootHash, rootSum, inflatedLeaf, proof, target))
+
+	// claiming a larger total than committed to
+	require.False(t, VerifyMerkleSumProof(rootHash, rootSum+1, leaf, proof, target))
+
+	// moving the leaf to the other side of a sibling
+	proof[0].IsLeft = !proof[0].IsLeft
+	require.False(t, VerifyMerkleSumProof(rootHash, rootSum, leaf, proof, target))
+	proof[0].IsLeft = !proof[0].IsLeft
+
+	// tampering with the sum of a sibling
+	proof[0].Sum++
+	require.False(t, VerifyMerkleSumProof(rootHash, rootSum, leaf, proof, target))
+	proof[0].Sum--
+
+	// omitting a sibling
+	require.False(t, VerifyMerkleSumProof(rootHash, rootSum, leaf, proof[1:], target))
+
+	// tampering with the leaf hash range of a sibling, while keeping it ordered with the proven leaf
+	tamperedHash := []byte{0xff}
+	if proof[0].IsLeft {
+		tamperedHash = []byte{0x00}
+	}
+	proof[0].MinHash, proof[0].MaxHash = tamperedHash, tamperedHash
+	require.False(t, VerifyMerkleSumProof(rootHash, rootSum, leaf, proof, target))
+}
+
+func TestMerkleSumTree_VerifyDuplicateLeaves(t *testing.T) {
+	// a tree committing to the same leaf repeated, as a servicer inflating its claim would build it
+	leaf := newTestMerkleSumLeaves(1)[0]
+	for _, numLeaves := range []int{2, 3, 5, 8} {
+		t.Run(fmt.Sprintf("%d leaves", numLeaves), func(t *testing.T) {
+			leaves := make([]MerkleSumLeaf, numLeaves)
+			for i := range leaves {
+				leaves[i] = leaf
+			}
+			_, err := NewMerkleSumTree(leaves)
+			require.Equal(t, CodeInvalidMerkleSumTreeError, err.Code())
+
+			tree := newMerkleSumTree(leaves)
+			rootHash, rootSum := tree.Root()
+			for target := uint64(0); target < rootSum; target++ {
+				provenLeaf, proof, err := tree.Prove(target)
+				require.NoError(t, err)
+				require.False(t, VerifyMerkleSumProof(rootHash, rootSum, provenLeaf, proof, target))
+			}
+		})
+	}
+
+	// a repeated leaf cannot be proven even if the rest of the leaves are distinct and ordered
+	leaves := newTestMerkleSumLeaves(6)
+	sort.Slice(leaves, func(i, j int) bool { return bytes.Compare(leaves[i].Hash, leaves[j].Hash) < 0 })
+	leaves = append(leaves[:4], leaves[3:]...)
+	tree := newMerkleSumTree(leaves)
+	rootHash, rootSum := tree.Root()
+	for target := uint64(0); target < rootSum; target++ {
+		provenLeaf, proof, err := tree.Prove(target)
+		require.NoError(t, err)
+		if bytes.Equal(provenLeaf.Hash, leaves[3].Hash) {
+			require.False(t, VerifyMerkleSumProof(rootHash, rootSum, provenLeaf, proof, target))
+		}
+	}
+}
+
+func newTestMerkleSumLeaves(numLeaves int) []MerkleSumLeaf {
+	leaves := make([]MerkleSumLeaf, numLeaves)
+	for i := range leaves {
+		leaves[i] = MerkleSumLeaf{Hash: crypto.SHA3Hash([]byte{byte(i)}), Sum: uint64(i%3 + 1)}
+	}
+	return leaves
+}
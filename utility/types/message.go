@@ -3,10 +3,13 @@ package types
 import (
 	"encoding/hex"
 	"log"
+	"math"
 
+	typesCons "github.com/pokt-network/pocket/consensus/types"
 	"github.com/pokt-network/pocket/shared/codec"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/utils"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -32,8 +35,21 @@ var (
 	_ Message = &MessageStake{}
 	_ Message = &MessageEditStake{}
 	_ Message = &MessageUnstake{}
+	_ Message = &MessagePause{}
 	_ Message = &MessageUnpause{}
 	_ Message = &MessageChangeParameter{}
+	_ Message = &MessageClaim{}
+	_ Message = &MessageProof{}
+	_ Message = &MessageTestScore{}
+	_ Message = &MessageProveTestScore{}
+	_ Message = &MessageFishermanPauseServicer{}
+	_ Message = &MessageDoubleSign{}
+	_ Message = &MessageDelegate{}
+	_ Message = &MessageUndelegate{}
+	_ Message = &MessageSubmitProposal{}
+	_ Message = &MessageVote{}
+	_ Message = &MessageScheduleUpgrade{}
+	_ Message = &MessageRotateOperatorKey{}
 )
 
 func (msg *MessageSend) ValidateBasic() Error {
@@ -60,6 +76,9 @@ func (msg *MessageStake) ValidateBasic() Error {
 func (msg *MessageUnstake) ValidateBasic() Error {
 	return validateAddress(msg.Address)
 }
+func (msg *MessagePause) ValidateBasic() Error {
+	return validateAddress(msg.Address)
+}
 func (msg *MessageUnpause) ValidateBasic() Error {
 	return validateAddress(msg.Address)
 }
@@ -82,26 +101,272 @@ func (msg *MessageChangeParameter) ValidateBasic() Error {
 	return nil
 }
 
-func (msg *MessageSend) SetSigner(signer []byte)            { /* no-op */ }
-func (msg *MessageStake) SetSigner(signer []byte)           { msg.Signer = signer }
-func (msg *MessageEditStake) SetSigner(signer []byte)       { msg.Signer = signer }
-func (msg *MessageUnstake) SetSigner(signer []byte)         { msg.Signer = signer }
-func (msg *MessageUnpause) SetSigner(signer []byte)         { msg.Signer = signer }
-func (msg *MessageChangeParameter) SetSigner(signer []byte) { msg.Signer = signer }
+func (msg *MessageClaim) ValidateBasic() Error {
+	if err := validateAddress(msg.ServicerAddress); err != nil {
+		return err
+	}
+	if err := validatePublicKey(msg.ApplicationPublicKey); err != nil {
+		return err
+	}
+	if err := relayChain(msg.RelayChain).ValidateBasic(); err != nil {
+		return err
+	}
+	if msg.SessionHeight < 0 {
+		return ErrInvalidClaim("negative session height")
+	}
+	if err := validateHash(msg.RootHash); err != nil {
+		return err
+	}
+	if msg.TotalRelays == 0 {
+		return ErrInvalidClaim("no relays claimed")
+	}
+	return nil
+}
+func (msg *MessageProof) ValidateBasic() Error {
+	if err := validateAddress(msg.ServicerAddress); err != nil {
+		return err
+	}
+	if err := validateHash(msg.SessionId); err != nil {
+		return err
+	}
+	if msg.Relay == nil {
+		return ErrInvalidProof("missing relay")
+	}
+	for _, node := range msg.Proof {
+		if node == nil {
+			return ErrInvalidProof("missing proof node")
+		}
+		if err := validateHash(node.Hash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (msg *MessageTestScore) ValidateBasic() Error {
+	if err := validateAddress(msg.FishermanAddress); err != nil {
+		return err
+	}
+	if err := validateAddress(msg.ServicerAddress); err != nil {
+		return err
+	}
+	if err := validatePublicKey(msg.ApplicationPublicKey); err != nil {
+		return err
+	}
+	if err := relayChain(msg.RelayChain).ValidateBasic(); err != nil {
+		return err
+	}
+	if msg.SessionHeight < 0 {
+		return ErrInvalidTestScore("negative session height")
+	}
+	if err := validateHash(msg.RootHash); err != nil {
+		return err
+	}
+	if msg.TotalTests == 0 {
+		return ErrInvalidTestScore("no tests reported")
+	}
+	if msg.SuccessfulTests > msg.TotalTests {
+		return ErrInvalidTestScore("more successful tests than total tests")
+	}
+	// the sum of the root of the Merkle sum tree of the test results must fit in a uint64
+	if msg.TotalTests > math.MaxUint64/successfulTestResultSum {
+		return ErrInvalidTestScore("too many tests reported")
+	}
+	return nil
+}
+func (msg *MessageProveTestScore) ValidateBasic() Error {
+	if err := validateAddress(msg.FishermanAddress); err != nil {
+		return err
+	}
+	if err := validateAddress(msg.ServicerAddress); err != nil {
+		return err
+	}
+	if err := validateHash(msg.SessionId); err != nil {
+		return err
+	}
+	if msg.TestResult == nil || msg.TestResult.Relay == nil {
+		return ErrInvalidProof("missing test result")
+	}
+	for _, node := range msg.Proof {
+		if node == nil {
+			return ErrInvalidProof("missing proof node")
+		}
+		if err := validateHash(node.Hash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (msg *MessageFishermanPauseServicer) ValidateBasic() Error {
+	if err := validateAddress(msg.FishermanAddress); err != nil {
+		return err
+	}
+	if err := validateAddress(msg.ServicerAddress); err != nil {
+		return err
+	}
+	return validateHash(msg.SessionId)
+}
+func (msg *MessageDoubleSign) ValidateBasic() Error {
+	if err := validateVote(msg.VoteA); err != nil {
+		return err
+	}
+	if err := validateVote(msg.VoteB); err != nil {
+		return err
+	}
+	// the votes must be signed by the same validator for the same view, but on different blocks (identified by their state hash)
+	switch {
+	case msg.VoteA.GetHeight() != msg.VoteB.GetHeight():
+		return ErrUnequalHeights()
+	case msg.VoteA.GetRound() != msg.VoteB.GetRound():
+		return ErrUnequalRounds()
+	case msg.VoteA.GetStep() != msg.VoteB.GetStep():
+		return ErrUnequalVoteTypes()
+	case msg.VoteA.GetPartialSignature().GetAddress() != msg.VoteB.GetPartialSignature().GetAddress():
+		return ErrUnequalPublicKeys()
+	case msg.VoteA.GetBlock().GetBlockHeader().GetStateHash() == msg.VoteB.GetBlock().GetBlockHeader().GetStateHash():
+		return ErrEqualVotes()
+	}
+	return validateAddress(msg.ReporterAddress)
+}
+func (msg *MessageDelegate) ValidateBasic() Error {
+	if err := validateAddress(msg.DelegatorAddress); err != nil {
+		return err
+	}
+	if err := validateAddress(msg.ValidatorAddress); err != nil {
+		return err
+	}
+	if err := validateAmount(msg.Amount); err != nil {
+		return err
+	}
+	// the amount is moved from the delegator's account to the validator stake pool, so it must be positive
+	amount, _ := utils.StringToBigInt(msg.Amount)
+	switch amount.Sign() {
+	case -1:
+		return ErrNegativeAmountError()
+	case 0:
+		return ErrEmptyAmount()
+	}
+	return nil
+}
+func (msg *MessageUndelegate) ValidateBasic() Error {
+	if err := validateAddress(msg.DelegatorAddress); err != nil {
+		return err
+	}
+	return validateAddress(msg.ValidatorAddress)
+}
+func (msg *MessageSubmitProposal) ValidateBasic() Error {
+	if err := validateAddress(msg.ProposerAddress); err != nil {
+		return err
+	}
+	if len(msg.ParameterChanges) == 0 {
+		return ErrEmptyParameterChanges()
+	}
+	// the changes are applied atomically, so changing the same parameter twice is ambiguous
+	paramKeys := make(map[string]struct{}, len(msg.ParameterChanges))
+	for _, change := range msg.ParameterChanges {
+		if change.GetParameterKey() == "" {
+			return ErrEmptyParamKey()
+		}
+		if change.GetParameterValue() == nil {
+			return ErrEmptyParamValue()
+		}
+		if _, ok := paramKeys[change.ParameterKey]; ok {
+			return ErrDuplicateParameterChange(change.ParameterKey)
+		}
+		paramKeys[change.ParameterKey] = struct{}{}
+	}
+	return nil
+}
+func (msg *MessageVote) ValidateBasic() Error {
+	if err := validateAddress(msg.ValidatorAddress); err != nil {
+		return err
+	}
+	if _, ok := coreTypes.VoteOption_name[int32(msg.Option)]; !ok || msg.Option == coreTypes.VoteOption_VOTE_OPTION_UNSPECIFIED {
+		return ErrInvalidVoteOption(int32(msg.Option))
+	}
+	return nil
+}
+func (msg *MessageScheduleUpgrade) ValidateBasic() Error {
+	if msg.Name == "" {
+		return ErrEmptyName()
+	}
+	if msg.ActivationHeight <= 0 {
+		return ErrInvalidBlockHeight()
+	}
+	return nil
+}
+func (msg *MessageRotateOperatorKey) ValidateBasic() Error {
+	if err := validateActorType(msg.ActorType); err != nil {
+		return err
+	}
+	if err := validateAddress(msg.Address); err != nil {
+		return err
+	}
+	return validatePublicKey(msg.NewPublicKey)
+}
+
+func (msg *MessageSend) SetSigner(signer []byte)                   { /* no-op */ }
+func (msg *MessageStake) SetSigner(signer []byte)                  { msg.Signer = signer }
+func (msg *MessageEditStake) SetSigner(signer []byte)              { msg.Signer = signer }
+func (msg *MessageUnstake) SetSigner(signer []byte)                { msg.Signer = signer }
+func (msg *MessagePause) SetSigner(signer []byte)                  { msg.Signer = signer }
+func (msg *MessageUnpause) SetSigner(signer []byte)                { msg.Signer = signer }
+func (msg *MessageChangeParameter) SetSigner(signer []byte)        { msg.Signer = signer }
+func (msg *MessageClaim) SetSigner(signer []byte)                  { msg.Signer = signer }
+func (msg *MessageProof) SetSigner(signer []byte)                  { msg.Signer = signer }
+func (msg *MessageTestScore) SetSigner(signer []byte)              { msg.Signer = signer }
+func (msg *MessageProveTestScore) SetSigner(signer []byte)         { msg.Signer = signer }
+func (msg *MessageFishermanPauseServicer) SetSigner(signer []byte) { msg.Signer = signer }
+func (msg *MessageDoubleSign) SetSigner(signer []byte)             { msg.Signer = signer }
+func (msg *MessageDelegate) SetSigner(signer []byte)               { msg.Signer = signer }
+func (msg *MessageUndelegate) SetSigner(signer []byte)             { msg.Signer = signer }
+func (msg *MessageSubmitProposal) SetSigner(signer []byte)         { msg.Signer = signer }
+func (msg *MessageVote) SetSigner(signer []byte)                   { msg.Signer = signer }
+func (msg *MessageScheduleUpgrade) SetSigner(signer []byte)        { msg.Signer = signer }
+func (msg *MessageRotateOperatorKey) SetSigner(signer []byte)      { msg.Signer = signer }
 
-func (msg *MessageSend) GetMessageName() string            { return getMessageType(msg) }
-func (msg *MessageStake) GetMessageName() string           { return getMessageType(msg) }
-func (msg *MessageEditStake) GetMessageName() string       { return getMessageType(msg) }
-func (msg *MessageUnstake) GetMessageName() string         { return getMessageType(msg) }
-func (msg *MessageUnpause) GetMessageName() string         { return getMessageType(msg) }
-func (msg *MessageChangeParameter) GetMessageName() string { return getMessageType(msg) }
+func (msg *MessageSend) GetMessageName() string                   { return getMessageType(msg) }
+func (msg *MessageStake) GetMessageName() string                  { return getMessageType(msg) }
+func (msg *MessageEditStake) GetMessageName() string              { return getMessageType(msg) }
+func (msg *MessageUnstake) GetMessageName() string                { return getMessageType(msg) }
+func (msg *MessagePause) GetMessageName() string                  { return getMessageType(msg) }
+func (msg *MessageUnpause) GetMessageName() string                { return getMessageType(msg) }
+func (msg *MessageChangeParameter) GetMessageName() string        { return getMessageType(msg) }
+func (msg *MessageClaim) GetMessageName() string                  { return getMessageType(msg) }
+func (msg *MessageProof) GetMessageName() string                  { return getMessageType(msg) }
+func (msg *MessageTestScore) GetMessageName() string              { return getMessageType(msg) }
+func (msg *MessageProveTestScore) GetMessageName() string         { return getMessageType(msg) }
+func (msg *MessageFishermanPauseServicer) GetMessageName() string { return getMessageType(msg) }
+func (msg *MessageDoubleSign) GetMessageName() string             { return getMessageType(msg) }
+func (msg *MessageDelegate) GetMessageName() string               { return getMessageType(msg) }
+func (msg *MessageUndelegate) GetMessageName() string             { return getMessageType(msg) }
+func (msg *MessageSubmitProposal) GetMessageName() string         { return getMessageType(msg) }
+func (msg *MessageVote) GetMessageName() string                   { return getMessageType(msg) }
+func (msg *MessageScheduleUpgrade) GetMessageName() string        { return getMessageType(msg) }
+func (msg *MessageRotateOperatorKey) GetMessageName() string      { return getMessageType(msg) }
 
-func (msg *MessageSend) GetMessageRecipient() string            { return hex.EncodeToString(msg.ToAddress) }
-func (msg *MessageStake) GetMessageRecipient() string           { return "" }
-func (msg *MessageEditStake) GetMessageRecipient() string       { return "" }
-func (msg *MessageUnstake) GetMessageRecipient() string         { return "" }
-func (msg *MessageUnpause) GetMessageRecipient() string         { return "" }
-func (msg *MessageChangeParameter) GetMessageRecipient() string { return "" }
+func (msg *MessageSend) GetMessageRecipient() string                   { return hex.EncodeToString(msg.ToAddress) }
+func (msg *MessageStake) GetMessageRecipient() string                  { return "" }
+func (msg *MessageEditStake) GetMessageRecipient() string              { return "" }
+func (msg *MessageUnstake) GetMessageRecipient() string                { return "" }
+func (msg *MessagePause) GetMessageRecipient() string                  { return "" }
+func (msg *MessageUnpause) GetMessageRecipient() string                { return "" }
+func (msg *MessageChangeParameter) GetMessageRecipient() string        { return "" }
+func (msg *MessageClaim) GetMessageRecipient() string                  { return "" }
+func (msg *MessageProof) GetMessageRecipient() string                  { return "" }
+func (msg *MessageTestScore) GetMessageRecipient() string              { return "" }
+func (msg *MessageProveTestScore) GetMessageRecipient() string         { return "" }
+func (msg *MessageFishermanPauseServicer) GetMessageRecipient() string { return "" }
+func (msg *MessageDoubleSign) GetMessageRecipient() string             { return "" }
+func (msg *MessageDelegate) GetMessageRecipient() string {
+	return hex.EncodeToString(msg.ValidatorAddress)
+}
+func (msg *MessageUndelegate) GetMessageRecipient() string {
+	return hex.EncodeToString(msg.ValidatorAddress)
+}
+func (msg *MessageSubmitProposal) GetMessageRecipient() string    { return "" }
+func (msg *MessageVote) GetMessageRecipient() string              { return "" }
+func (msg *MessageScheduleUpgrade) GetMessageRecipient() string   { return "" }
+func (msg *MessageRotateOperatorKey) GetMessageRecipient() string { return "" }
 
 func (msg *MessageSend) GetSigner() []byte { return msg.FromAddress }
 
@@ -111,13 +376,59 @@ func (msg *MessageSend) GetActorType() coreTypes.ActorType {
 func (msg *MessageChangeParameter) GetActorType() coreTypes.ActorType {
 	return -1 // CONSIDERATION: Should we create an actor for the DAO or ACLed addresses?
 }
+func (msg *MessageClaim) GetActorType() coreTypes.ActorType {
+	return coreTypes.ActorType_ACTOR_TYPE_SERVICER
+}
+func (msg *MessageProof) GetActorType() coreTypes.ActorType {
+	return coreTypes.ActorType_ACTOR_TYPE_SERVICER
+}
+func (msg *MessageTestScore) GetActorType() coreTypes.ActorType {
+	return coreTypes.ActorType_ACTOR_TYPE_FISH
+}
+func (msg *MessageProveTestScore) GetActorType() coreTypes.ActorType {
+	return coreTypes.ActorType_ACTOR_TYPE_FISH
+}
+func (msg *MessageFishermanPauseServicer) GetActorType() coreTypes.ActorType {
+	return coreTypes.ActorType_ACTOR_TYPE_FISH
+}
+func (msg *MessageDoubleSign) GetActorType() coreTypes.ActorType {
+	return coreTypes.ActorType_ACTOR_TYPE_VAL
+}
+func (msg *MessageDelegate) GetActorType() coreTypes.ActorType {
+	return coreTypes.ActorType_ACTOR_TYPE_VAL
+}
+func (msg *MessageUndelegate) GetActorType() coreTypes.ActorType {
+	return coreTypes.ActorType_ACTOR_TYPE_VAL
+}
+func (msg *MessageSubmitProposal) GetActorType() coreTypes.ActorType {
+	return coreTypes.ActorType_ACTOR_TYPE_UNSPECIFIED // any account can submit a proposal
+}
+func (msg *MessageVote) GetActorType() coreTypes.ActorType {
+	return coreTypes.ActorType_ACTOR_TYPE_VAL
+}
+func (msg *MessageScheduleUpgrade) GetActorType() coreTypes.ActorType {
+	return -1 // CONSIDERATION: Should we create an actor for the DAO or ACLed addresses?
+}
 
-func (msg *MessageSend) GetCanonicalBytes() []byte            { return getCanonicalBytes(msg) }
-func (msg *MessageStake) GetCanonicalBytes() []byte           { return getCanonicalBytes(msg) }
-func (msg *MessageEditStake) GetCanonicalBytes() []byte       { return getCanonicalBytes(msg) }
-func (msg *MessageUnstake) GetCanonicalBytes() []byte         { return getCanonicalBytes(msg) }
-func (msg *MessageUnpause) GetCanonicalBytes() []byte         { return getCanonicalBytes(msg) }
-func (msg *MessageChangeParameter) GetCanonicalBytes() []byte { return getCanonicalBytes(msg) }
+func (msg *MessageSend) GetCanonicalBytes() []byte                   { return getCanonicalBytes(msg) }
+func (msg *MessageStake) GetCanonicalBytes() []byte                  { return getCanonicalBytes(msg) }
+func (msg *MessageEditStake) GetCanonicalBytes() []byte              { return getCanonicalBytes(msg) }
+func (msg *MessageUnstake) GetCanonicalBytes() []byte                { return getCanonicalBytes(msg) }
+func (msg *MessagePause) GetCanonicalBytes() []byte                  { return getCanonicalBytes(msg) }
+func (msg *MessageUnpause) GetCanonicalBytes() []byte                { return getCanonicalBytes(msg) }
+func (msg *MessageChangeParameter) GetCanonicalBytes() []byte        { return getCanonicalBytes(msg) }
+func (msg *MessageClaim) GetCanonicalBytes() []byte                  { return getCanonicalBytes(msg) }
+func (msg *MessageProof) GetCanonicalBytes() []byte                  { return getCanonicalBytes(msg) }
+func (msg *MessageTestScore) GetCanonicalBytes() []byte              { return getCanonicalBytes(msg) }
+func (msg *MessageProveTestScore) GetCanonicalBytes() []byte         { return getCanonicalBytes(msg) }
+func (msg *MessageFishermanPauseServicer) GetCanonicalBytes() []byte { return getCanonicalBytes(msg) }
+func (msg *MessageDoubleSign) GetCanonicalBytes() []byte             { return getCanonicalBytes(msg) }
+func (msg *MessageDelegate) GetCanonicalBytes() []byte               { return getCanonicalBytes(msg) }
+func (msg *MessageUndelegate) GetCanonicalBytes() []byte             { return getCanonicalBytes(msg) }
+func (msg *MessageSubmitProposal) GetCanonicalBytes() []byte         { return getCanonicalBytes(msg) }
+func (msg *MessageVote) GetCanonicalBytes() []byte                   { return getCanonicalBytes(msg) }
+func (msg *MessageScheduleUpgrade) GetCanonicalBytes() []byte        { return getCanonicalBytes(msg) }
+func (msg *MessageRotateOperatorKey) GetCanonicalBytes() []byte      { return getCanonicalBytes(msg) }
 
 // Helpers
 
@@ -160,7 +471,6 @@ func validatePublicKey(publicKey []byte) Error {
 	return nil
 }
 
-//nolint:unused // TODO: need to figure out why this function was added and never used
 func validateHash(hash []byte) Error {
 	if hash == nil {
 		return ErrEmptyHash()
@@ -184,6 +494,20 @@ func validateRelayChains(chains []string) Error {
 	return nil
 }
 
+// validateVote ensures `vote` is a signed hotstuff vote on a block
+func validateVote(vote *typesCons.HotstuffMessage) Error {
+	if vote == nil || vote.GetType() != typesCons.HotstuffMessageType_HOTSTUFF_MESSAGE_VOTE {
+		return ErrInvalidEvidenceType()
+	}
+	if vote.GetBlock().GetBlockHeader() == nil {
+		return ErrInvalidEvidenceType()
+	}
+	if len(vote.GetPartialSignature().GetSignature()) == 0 || vote.GetPartialSignature().GetAddress() == "" {
+		return ErrEmptySignature()
+	}
+	return nil
+}
+
 func getMessageType(msg Message) string {
 	return string(msg.ProtoReflect().Descriptor().Name())
 }
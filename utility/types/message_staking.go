@@ -24,6 +24,9 @@ const (
 	portOutOfRange   = "invalid port, out of valid port range"
 	noPeriod         = "must contain one '.'"
 	maxPort          = 65535
+
+	// GeoZonesSeparator separates the geo zones listed in the `allowed_geo_zones` governance parameter
+	GeoZonesSeparator = ","
 )
 
 // This interface is useful in validating stake related messages and is not intended to be used outside of this package
@@ -32,6 +35,7 @@ type stakingMessage interface {
 	GetAmount() string
 	GetChains() []string
 	GetServiceUrl() string
+	GetGeoZone() string
 }
 
 func validateStaker(msg stakingMessage) Error {
@@ -44,6 +48,9 @@ func validateStaker(msg stakingMessage) Error {
 	if err := validateRelayChains(msg.GetChains()); err != nil {
 		return err
 	}
+	if err := validateGeoZone(msg.GetActorType(), msg.GetGeoZone()); err != nil {
+		return err
+	}
 	return validateServiceURL(msg.GetActorType(), msg.GetServiceUrl())
 }
 
@@ -64,6 +71,21 @@ func validateAmount(amount string) Error {
 	return nil
 }
 
+// validateGeoZone ensures validators, which do not take part in sessions, are not staked in a geo zone, and that the
+// geo zone of other actors can be listed in the `allowed_geo_zones` governance parameter
+func validateGeoZone(actorType coreTypes.ActorType, geoZone string) Error {
+	if geoZone == "" {
+		return nil
+	}
+	if actorType == coreTypes.ActorType_ACTOR_TYPE_VAL {
+		return ErrInvalidGeoZone(geoZone)
+	}
+	if strings.ContainsAny(geoZone, GeoZonesSeparator+" ") {
+		return ErrInvalidGeoZone(geoZone)
+	}
+	return nil
+}
+
 func validateServiceURL(actorType coreTypes.ActorType, uri string) Error {
 	if actorType == coreTypes.ActorType_ACTOR_TYPE_APP {
 		return nil
@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	typesCons "github.com/pokt-network/pocket/consensus/types"
 	"github.com/pokt-network/pocket/shared/codec"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"github.com/pokt-network/pocket/shared/crypto"
@@ -51,6 +52,242 @@ func TestMessage_ChangeParameter_ValidateBasic(t *testing.T) {
 	require.Equal(t, ErrEmptyParamValue().Code(), msgMissingParamValue.ValidateBasic().Code())
 }
 
+func TestMessage_Claim_ValidateBasic(t *testing.T) {
+	addr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	appPubKey, err := crypto.GeneratePublicKey()
+	require.NoError(t, err)
+
+	msg := MessageClaim{
+		ServicerAddress:      addr,
+		ApplicationPublicKey: appPubKey.Bytes(),
+		RelayChain:           defaultTestingChains[0],
+		SessionHeight:        4,
+		RootHash:             crypto.SHA3Hash([]byte("root")),
+		TotalRelays:          10,
+	}
+	er := msg.ValidateBasic()
+	require.NoError(t, er)
+
+	msgMissingAddress := proto.Clone(&msg).(*MessageClaim)
+	msgMissingAddress.ServicerAddress = nil
+	er = msgMissingAddress.ValidateBasic()
+	require.Equal(t, ErrEmptyAddress().Code(), er.Code())
+
+	msgMissingAppPubKey := proto.Clone(&msg).(*MessageClaim)
+	msgMissingAppPubKey.ApplicationPublicKey = nil
+	er = msgMissingAppPubKey.ValidateBasic()
+	require.Equal(t, ErrEmptyPublicKey().Code(), er.Code())
+
+	msgInvalidRootHash := proto.Clone(&msg).(*MessageClaim)
+	msgInvalidRootHash.RootHash = []byte("root")
+	er = msgInvalidRootHash.ValidateBasic()
+	require.Equal(t, ErrInvalidHashLength(0).Code(), er.Code())
+
+	msgNoRelays := proto.Clone(&msg).(*MessageClaim)
+	msgNoRelays.TotalRelays = 0
+	er = msgNoRelays.ValidateBasic()
+	require.Equal(t, ErrInvalidClaim("").Code(), er.Code())
+}
+
+func TestMessage_Delegate_ValidateBasic(t *testing.T) {
+	delegatorAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	validatorAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	msg := MessageDelegate{
+		DelegatorAddress: delegatorAddr,
+		ValidatorAddress: validatorAddr,
+		Amount:           defaultAmount,
+	}
+	er := msg.ValidateBasic()
+	require.NoError(t, er)
+
+	msgMissingDelegatorAddress := proto.Clone(&msg).(*MessageDelegate)
+	msgMissingDelegatorAddress.DelegatorAddress = nil
+	er = msgMissingDelegatorAddress.ValidateBasic()
+	require.Equal(t, ErrEmptyAddress().Code(), er.Code())
+
+	msgMissingValidatorAddress := proto.Clone(&msg).(*MessageDelegate)
+	msgMissingValidatorAddress.ValidatorAddress = nil
+	er = msgMissingValidatorAddress.ValidateBasic()
+	require.Equal(t, ErrEmptyAddress().Code(), er.Code())
+
+	msgMissingAmount := proto.Clone(&msg).(*MessageDelegate)
+	msgMissingAmount.Amount = ""
+	er = msgMissingAmount.ValidateBasic()
+	require.Equal(t, ErrEmptyAmount().Code(), er.Code())
+
+	msgZeroAmount := proto.Clone(&msg).(*MessageDelegate)
+	msgZeroAmount.Amount = "0"
+	er = msgZeroAmount.ValidateBasic()
+	require.Equal(t, ErrEmptyAmount().Code(), er.Code())
+
+	msgNegativeAmount := proto.Clone(&msg).(*MessageDelegate)
+	msgNegativeAmount.Amount = "-1"
+	er = msgNegativeAmount.ValidateBasic()
+	require.Equal(t, ErrNegativeAmountError().Code(), er.Code())
+}
+
+func TestMessage_SubmitProposal_ValidateBasic(t *testing.T) {
+	proposerAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	quorumValue, err := codec.GetCodec().ToAny(wrapperspb.Int32(50))
+	require.NoError(t, err)
+	feeValue, err := codec.GetCodec().ToAny(wrapperspb.String("100"))
+	require.NoError(t, err)
+
+	msg := MessageSubmitProposal{
+		ProposerAddress: proposerAddr,
+		ParameterChanges: []*coreTypes.ParameterChange{
+			{ParameterKey: GovernanceQuorumPercentageParamName, ParameterValue: quorumValue},
+			{ParameterKey: MessageVoteFee, ParameterValue: feeValue},
+		},
+	}
+	er := msg.ValidateBasic()
+	require.NoError(t, er)
+
+	msgMissingProposerAddress := proto.Clone(&msg).(*MessageSubmitProposal)
+	msgMissingProposerAddress.ProposerAddress = nil
+	er = msgMissingProposerAddress.ValidateBasic()
+	require.Equal(t, ErrEmptyAddress().Code(), er.Code())
+
+	msgMissingChanges := proto.Clone(&msg).(*MessageSubmitProposal)
+	msgMissingChanges.ParameterChanges = nil
+	er = msgMissingChanges.ValidateBasic()
+	require.Equal(t, ErrEmptyParameterChanges().Code(), er.Code())
+
+	msgMissingKey := proto.Clone(&msg).(*MessageSubmitProposal)
+	msgMissingKey.ParameterChanges[0].ParameterKey = ""
+	er = msgMissingKey.ValidateBasic()
+	require.Equal(t, ErrEmptyParamKey().Code(), er.Code())
+
+	msgMissingValue := proto.Clone(&msg).(*MessageSubmitProposal)
+	msgMissingValue.ParameterChanges[1].ParameterValue = nil
+	er = msgMissingValue.ValidateBasic()
+	require.Equal(t, ErrEmptyParamValue().Code(), er.Code())
+
+	msgDuplicateChange := proto.Clone(&msg).(*MessageSubmitProposal)
+	msgDuplicateChange.ParameterChanges[1].ParameterKey = GovernanceQuorumPercentageParamName
+	er = msgDuplicateChange.ValidateBasic()
+	require.Equal(t, ErrDuplicateParameterChange("").Code(), er.Code())
+}
+
+func TestMessage_Vote_ValidateBasic(t *testing.T) {
+	validatorAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	msg := MessageVote{
+		ValidatorAddress: validatorAddr,
+		ProposalId:       1,
+		Option:           coreTypes.VoteOption_VOTE_OPTION_YES,
+	}
+	er := msg.ValidateBasic()
+	require.NoError(t, er)
+
+	msgMissingValidatorAddress := proto.Clone(&msg).(*MessageVote)
+	msgMissingValidatorAddress.ValidatorAddress = nil
+	er = msgMissingValidatorAddress.ValidateBasic()
+	require.Equal(t, ErrEmptyAddress().Code(), er.Code())
+
+	msgUnspecifiedOption := proto.Clone(&msg).(*MessageVote)
+	msgUnspecifiedOption.Option = coreTypes.VoteOption_VOTE_OPTION_UNSPECIFIED
+	er = msgUnspecifiedOption.ValidateBasic()
+	require.Equal(t, ErrInvalidVoteOption(0).Code(), er.Code())
+
+	msgUnknownOption := proto.Clone(&msg).(*MessageVote)
+	msgUnknownOption.Option = coreTypes.VoteOption(100)
+	er = msgUnknownOption.ValidateBasic()
+	require.Equal(t, ErrInvalidVoteOption(0).Code(), er.Code())
+}
+
+func TestMessage_ScheduleUpgrade_ValidateBasic(t *testing.T) {
+	msg := MessageScheduleUpgrade{
+		Name:             "v1.0.0",
+		ActivationHeight: 100,
+	}
+	er := msg.ValidateBasic()
+	require.NoError(t, er)
+
+	msgMissingName := proto.Clone(&msg).(*MessageScheduleUpgrade)
+	msgMissingName.Name = ""
+	er = msgMissingName.ValidateBasic()
+	require.Equal(t, ErrEmptyName().Code(), er.Code())
+
+	msgInvalidHeight := proto.Clone(&msg).(*MessageScheduleUpgrade)
+	msgInvalidHeight.ActivationHeight = 0
+	er = msgInvalidHeight.ValidateBasic()
+	require.Equal(t, ErrInvalidBlockHeight().Code(), er.Code())
+}
+
+func TestMessage_DoubleSign_ValidateBasic(t *testing.T) {
+	validatorAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	reporterAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	newVote := func(stateHash string) *typesCons.HotstuffMessage {
+		return &typesCons.HotstuffMessage{
+			Type:   typesCons.HotstuffMessageType_HOTSTUFF_MESSAGE_VOTE,
+			Height: 5,
+			Step:   typesCons.HotstuffStep_HOTSTUFF_STEP_PREPARE,
+			Round:  1,
+			Block:  &coreTypes.Block{BlockHeader: &coreTypes.BlockHeader{Height: 5, StateHash: stateHash}},
+			Justification: &typesCons.HotstuffMessage_PartialSignature{
+				PartialSignature: &typesCons.PartialSignature{Signature: []byte("signature"), Address: validatorAddr.String()},
+			},
+		}
+	}
+	msg := MessageDoubleSign{
+		VoteA:           newVote("state_hash_a"),
+		VoteB:           newVote("state_hash_b"),
+		ReporterAddress: reporterAddr,
+	}
+	er := msg.ValidateBasic()
+	require.NoError(t, er)
+
+	msgEqualVotes := proto.Clone(&msg).(*MessageDoubleSign)
+	msgEqualVotes.VoteB = newVote("state_hash_a")
+	er = msgEqualVotes.ValidateBasic()
+	require.Equal(t, ErrEqualVotes().Code(), er.Code())
+
+	msgUnequalHeights := proto.Clone(&msg).(*MessageDoubleSign)
+	msgUnequalHeights.VoteB.Height++
+	er = msgUnequalHeights.ValidateBasic()
+	require.Equal(t, ErrUnequalHeights().Code(), er.Code())
+
+	msgUnequalRounds := proto.Clone(&msg).(*MessageDoubleSign)
+	msgUnequalRounds.VoteB.Round++
+	er = msgUnequalRounds.ValidateBasic()
+	require.Equal(t, ErrUnequalRounds().Code(), er.Code())
+
+	msgUnequalSteps := proto.Clone(&msg).(*MessageDoubleSign)
+	msgUnequalSteps.VoteB.Step = typesCons.HotstuffStep_HOTSTUFF_STEP_PRECOMMIT
+	er = msgUnequalSteps.ValidateBasic()
+	require.Equal(t, ErrUnequalVoteTypes().Code(), er.Code())
+
+	msgUnequalSigners := proto.Clone(&msg).(*MessageDoubleSign)
+	msgUnequalSigners.VoteB.GetPartialSignature().Address = reporterAddr.String()
+	er = msgUnequalSigners.ValidateBasic()
+	require.Equal(t, ErrUnequalPublicKeys().Code(), er.Code())
+
+	msgNotAVote := proto.Clone(&msg).(*MessageDoubleSign)
+	msgNotAVote.VoteA.Type = typesCons.HotstuffMessageType_HOTSTUFF_MESSAGE_PROPOSE
+	er = msgNotAVote.ValidateBasic()
+	require.Equal(t, ErrInvalidEvidenceType().Code(), er.Code())
+
+	msgMissingSignature := proto.Clone(&msg).(*MessageDoubleSign)
+	msgMissingSignature.VoteA.GetPartialSignature().Signature = nil
+	er = msgMissingSignature.ValidateBasic()
+	require.Equal(t, ErrEmptySignature().Code(), er.Code())
+
+	msgMissingReporter := proto.Clone(&msg).(*MessageDoubleSign)
+	msgMissingReporter.ReporterAddress = nil
+	er = msgMissingReporter.ValidateBasic()
+	require.Equal(t, ErrEmptyAddress().Code(), er.Code())
+}
+
 func TestMessage_EditStake_ValidateBasic(t *testing.T) {
 	addr, err := crypto.GenerateAddress()
 	require.NoError(t, err)
@@ -97,6 +334,107 @@ func TestMessage_EditStake_ValidateBasic(t *testing.T) {
 	require.Equal(t, expectedErr.Code(), er.Code())
 }
 
+func TestMessage_FishermanPauseServicer_ValidateBasic(t *testing.T) {
+	fishermanAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	servicerAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	msg := MessageFishermanPauseServicer{
+		FishermanAddress: fishermanAddr,
+		ServicerAddress:  servicerAddr,
+		SessionId:        crypto.SHA3Hash([]byte("session")),
+	}
+	er := msg.ValidateBasic()
+	require.NoError(t, er)
+
+	msgMissingFishermanAddress := proto.Clone(&msg).(*MessageFishermanPauseServicer)
+	msgMissingFishermanAddress.FishermanAddress = nil
+	er = msgMissingFishermanAddress.ValidateBasic()
+	require.Equal(t, ErrEmptyAddress().Code(), er.Code())
+
+	msgMissingServicerAddress := proto.Clone(&msg).(*MessageFishermanPauseServicer)
+	msgMissingServicerAddress.ServicerAddress = nil
+	er = msgMissingServicerAddress.ValidateBasic()
+	require.Equal(t, ErrEmptyAddress().Code(), er.Code())
+
+	msgMissingSessionId := proto.Clone(&msg).(*MessageFishermanPauseServicer)
+	msgMissingSessionId.SessionId = nil
+	er = msgMissingSessionId.ValidateBasic()
+	require.Equal(t, ErrEmptyHash().Code(), er.Code())
+}
+
+func TestMessage_Proof_ValidateBasic(t *testing.T) {
+	addr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	msg := MessageProof{
+		ServicerAddress: addr,
+		SessionId:       crypto.SHA3Hash([]byte("session")),
+		Relay:           &coreTypes.Relay{},
+		Proof:           []*MerkleSumProofNode{{Hash: crypto.SHA3Hash([]byte("sibling")), Sum: 1}},
+	}
+	er := msg.ValidateBasic()
+	require.NoError(t, er)
+
+	msgMissingAddress := proto.Clone(&msg).(*MessageProof)
+	msgMissingAddress.ServicerAddress = nil
+	er = msgMissingAddress.ValidateBasic()
+	require.Equal(t, ErrEmptyAddress().Code(), er.Code())
+
+	msgMissingSessionId := proto.Clone(&msg).(*MessageProof)
+	msgMissingSessionId.SessionId = nil
+	er = msgMissingSessionId.ValidateBasic()
+	require.Equal(t, ErrEmptyHash().Code(), er.Code())
+
+	msgMissingRelay := proto.Clone(&msg).(*MessageProof)
+	msgMissingRelay.Relay = nil
+	er = msgMissingRelay.ValidateBasic()
+	require.Equal(t, ErrInvalidProof("").Code(), er.Code())
+
+	msgInvalidProofNode := proto.Clone(&msg).(*MessageProof)
+	msgInvalidProofNode.Proof[0].Hash = []byte("sibling")
+	er = msgInvalidProofNode.ValidateBasic()
+	require.Equal(t, ErrInvalidHashLength(0).Code(), er.Code())
+}
+
+func TestMessage_ProveTestScore_ValidateBasic(t *testing.T) {
+	fishermanAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	servicerAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	msg := MessageProveTestScore{
+		FishermanAddress: fishermanAddr,
+		ServicerAddress:  servicerAddr,
+		SessionId:        crypto.SHA3Hash([]byte("session")),
+		TestResult:       &TestResult{Relay: &coreTypes.Relay{}},
+		Proof:            []*MerkleSumProofNode{{Hash: crypto.SHA3Hash([]byte("sibling")), Sum: 1}},
+	}
+	er := msg.ValidateBasic()
+	require.NoError(t, er)
+
+	msgMissingFishermanAddress := proto.Clone(&msg).(*MessageProveTestScore)
+	msgMissingFishermanAddress.FishermanAddress = nil
+	er = msgMissingFishermanAddress.ValidateBasic()
+	require.Equal(t, ErrEmptyAddress().Code(), er.Code())
+
+	msgMissingSessionId := proto.Clone(&msg).(*MessageProveTestScore)
+	msgMissingSessionId.SessionId = nil
+	er = msgMissingSessionId.ValidateBasic()
+	require.Equal(t, ErrEmptyHash().Code(), er.Code())
+
+	msgMissingTestRelay := proto.Clone(&msg).(*MessageProveTestScore)
+	msgMissingTestRelay.TestResult.Relay = nil
+	er = msgMissingTestRelay.ValidateBasic()
+	require.Equal(t, ErrInvalidProof("").Code(), er.Code())
+
+	msgInvalidProofNode := proto.Clone(&msg).(*MessageProveTestScore)
+	msgInvalidProofNode.Proof[0].Hash = []byte("sibling")
+	er = msgInvalidProofNode.ValidateBasic()
+	require.Equal(t, ErrInvalidHashLength(0).Code(), er.Code())
+}
+
 func TestMessage_Send_ValidateBasic(t *testing.T) {
 	addr1, err := crypto.GenerateAddress()
 	require.NoError(t, err)
@@ -167,6 +505,66 @@ func TestMessage_Stake_ValidateBasic(t *testing.T) {
 	msgEmptyOutputAddress.OutputAddress = nil
 	er = msgEmptyOutputAddress.ValidateBasic()
 	require.Equal(t, ErrNilOutputAddress().Code(), er.Code())
+
+	msgGeoZone := proto.Clone(&msg).(*MessageStake)
+	msgGeoZone.GeoZone = "0"
+	er = msgGeoZone.ValidateBasic()
+	require.NoError(t, er)
+
+	msgInvalidGeoZone := proto.Clone(&msg).(*MessageStake)
+	msgInvalidGeoZone.GeoZone = "0,1"
+	er = msgInvalidGeoZone.ValidateBasic()
+	require.Equal(t, CodeInvalidGeoZoneError, er.Code())
+
+	// validators do not take part in sessions, so they cannot stake in a geo zone
+	msgValidatorGeoZone := proto.Clone(&msg).(*MessageStake)
+	msgValidatorGeoZone.ActorType = coreTypes.ActorType_ACTOR_TYPE_VAL
+	msgValidatorGeoZone.GeoZone = "0"
+	er = msgValidatorGeoZone.ValidateBasic()
+	require.Equal(t, CodeInvalidGeoZoneError, er.Code())
+}
+
+func TestMessage_TestScore_ValidateBasic(t *testing.T) {
+	fishermanAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	servicerAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	appPubKey, err := crypto.GeneratePublicKey()
+	require.NoError(t, err)
+
+	msg := MessageTestScore{
+		FishermanAddress:     fishermanAddr,
+		ServicerAddress:      servicerAddr,
+		ApplicationPublicKey: appPubKey.Bytes(),
+		RelayChain:           defaultTestingChains[0],
+		SessionHeight:        4,
+		RootHash:             crypto.SHA3Hash([]byte("root")),
+		TotalTests:           10,
+		SuccessfulTests:      8,
+	}
+	er := msg.ValidateBasic()
+	require.NoError(t, er)
+
+	msgMissingFishermanAddress := proto.Clone(&msg).(*MessageTestScore)
+	msgMissingFishermanAddress.FishermanAddress = nil
+	er = msgMissingFishermanAddress.ValidateBasic()
+	require.Equal(t, ErrEmptyAddress().Code(), er.Code())
+
+	msgInvalidRootHash := proto.Clone(&msg).(*MessageTestScore)
+	msgInvalidRootHash.RootHash = []byte("root")
+	er = msgInvalidRootHash.ValidateBasic()
+	require.Equal(t, ErrInvalidHashLength(0).Code(), er.Code())
+
+	msgNoTests := proto.Clone(&msg).(*MessageTestScore)
+	msgNoTests.TotalTests = 0
+	msgNoTests.SuccessfulTests = 0
+	er = msgNoTests.ValidateBasic()
+	require.Equal(t, ErrInvalidTestScore("").Code(), er.Code())
+
+	msgTooManySuccessfulTests := proto.Clone(&msg).(*MessageTestScore)
+	msgTooManySuccessfulTests.SuccessfulTests = msg.TotalTests + 1
+	er = msgTooManySuccessfulTests.ValidateBasic()
+	require.Equal(t, ErrInvalidTestScore("").Code(), er.Code())
 }
 
 func TestMessage_Unstake_ValidateBasic(t *testing.T) {
@@ -185,6 +583,22 @@ func TestMessage_Unstake_ValidateBasic(t *testing.T) {
 	require.Equal(t, ErrEmptyAddress().Code(), er.Code())
 }
 
+func TestMessage_Pause_ValidateBasic(t *testing.T) {
+	addr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	msg := MessagePause{
+		Address: addr,
+	}
+	er := msg.ValidateBasic()
+	require.NoError(t, er)
+
+	msgMissingAddress := proto.Clone(&msg).(*MessagePause)
+	msgMissingAddress.Address = nil
+	er = msgMissingAddress.ValidateBasic()
+	require.Equal(t, ErrEmptyAddress().Code(), er.Code())
+}
+
 func TestMessage_Unpause_ValidateBasic(t *testing.T) {
 	addr, err := crypto.GenerateAddress()
 	require.NoError(t, err)
@@ -200,3 +614,57 @@ func TestMessage_Unpause_ValidateBasic(t *testing.T) {
 	er = msgMissingAddress.ValidateBasic()
 	require.Equal(t, ErrEmptyAddress().Code(), er.Code())
 }
+
+func TestMessage_Undelegate_ValidateBasic(t *testing.T) {
+	delegatorAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	validatorAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	msg := MessageUndelegate{
+		DelegatorAddress: delegatorAddr,
+		ValidatorAddress: validatorAddr,
+	}
+	er := msg.ValidateBasic()
+	require.NoError(t, er)
+
+	msgMissingDelegatorAddress := proto.Clone(&msg).(*MessageUndelegate)
+	msgMissingDelegatorAddress.DelegatorAddress = nil
+	er = msgMissingDelegatorAddress.ValidateBasic()
+	require.Equal(t, ErrEmptyAddress().Code(), er.Code())
+
+	msgMissingValidatorAddress := proto.Clone(&msg).(*MessageUndelegate)
+	msgMissingValidatorAddress.ValidatorAddress = nil
+	er = msgMissingValidatorAddress.ValidateBasic()
+	require.Equal(t, ErrEmptyAddress().Code(), er.Code())
+}
+
+func TestMessage_RotateOperatorKey_ValidateBasic(t *testing.T) {
+	addr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	newPublicKey, err := crypto.GeneratePublicKey()
+	require.NoError(t, err)
+
+	msg := MessageRotateOperatorKey{
+		ActorType:    coreTypes.ActorType_ACTOR_TYPE_SERVICER,
+		Address:      addr,
+		NewPublicKey: newPublicKey.Bytes(),
+	}
+	er := msg.ValidateBasic()
+	require.NoError(t, er)
+
+	msgMissingActorType := proto.Clone(&msg).(*MessageRotateOperatorKey)
+	msgMissingActorType.ActorType = coreTypes.ActorType_ACTOR_TYPE_UNSPECIFIED
+	er = msgMissingActorType.ValidateBasic()
+	require.Equal(t, CodeUnknownActorType, er.Code())
+
+	msgMissingAddress := proto.Clone(&msg).(*MessageRotateOperatorKey)
+	msgMissingAddress.Address = nil
+	er = msgMissingAddress.ValidateBasic()
+	require.Equal(t, ErrEmptyAddress().Code(), er.Code())
+
+	msgMissingNewPublicKey := proto.Clone(&msg).(*MessageRotateOperatorKey)
+	msgMissingNewPublicKey.NewPublicKey = nil
+	er = msgMissingNewPublicKey.ValidateBasic()
+	require.Equal(t, ErrEmptyPublicKey().Code(), er.Code())
+}
@@ -1,13 +1,23 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+)
+
 const (
 	// DISCUSS: Should this be a governance parameter or moved to a shared file?
 	relayChainLength = 4 // pre-determined length that strikes a balance between combination possibilities & storage
+
+	// RelayChainsSeparator separates the entries of the `relay_chains` governance parameter
+	RelayChainsSeparator = ","
+	// RelayChainNameSeparator separates the identifier of a relay chain from its name in an entry of the `relay_chains`
+	// governance parameter
+	RelayChainNameSeparator = ":"
 )
 
 type relayChain string
 
-// TODO: Consider adding a governance parameter for a list of valid relay chains
 // ValidateBasic validates the relay chain follows a pre-determined format
 func (rc relayChain) ValidateBasic() Error {
 	if rc == "" {
@@ -19,3 +29,35 @@ func (rc relayChain) ValidateBasic() Error {
 	}
 	return nil
 }
+
+// RelayChain is an entry of the relay chain registry maintained through the `relay_chains` governance parameter
+type RelayChain struct {
+	ID   string
+	Name string
+}
+
+// ParseRelayChains parses the value of the `relay_chains` governance parameter, a comma separated list of
+// `<id>:<name>` entries, into the relay chain registry. An empty value parses into an empty registry.
+func ParseRelayChains(relayChains string) ([]*RelayChain, Error) {
+	if relayChains == "" {
+		return nil, nil
+	}
+	entries := strings.Split(relayChains, RelayChainsSeparator)
+	registry := make([]*RelayChain, 0, len(entries))
+	ids := make(map[string]struct{}, len(entries))
+	for _, entry := range entries {
+		id, name, found := strings.Cut(entry, RelayChainNameSeparator)
+		if !found || name == "" {
+			return nil, ErrInvalidRelayChains(fmt.Sprintf("entry %q is not formatted as <id>:<name>", entry))
+		}
+		if err := relayChain(id).ValidateBasic(); err != nil {
+			return nil, ErrInvalidRelayChains(err.Error())
+		}
+		if _, ok := ids[id]; ok {
+			return nil, ErrInvalidRelayChains(fmt.Sprintf("duplicate relay chain %s", id))
+		}
+		ids[id] = struct{}{}
+		registry = append(registry, &RelayChain{ID: id, Name: name})
+	}
+	return registry, nil
+}
@@ -21,3 +21,27 @@ func Test_RelayChain_Validate(t *testing.T) {
 	err = relayChainEmpty.ValidateBasic()
 	require.Equal(t, expectedError.Code(), err.Code())
 }
+
+func Test_RelayChain_ParseRelayChains(t *testing.T) {
+	registry, err := ParseRelayChains("0001:Pocket Network,0021:Ethereum Mainnet")
+	require.NoError(t, err)
+	require.Equal(t, []*RelayChain{
+		{ID: "0001", Name: "Pocket Network"},
+		{ID: "0021", Name: "Ethereum Mainnet"},
+	}, registry)
+
+	registry, err = ParseRelayChains("")
+	require.NoError(t, err)
+	require.Empty(t, registry)
+
+	for _, relayChains := range []string{
+		"0001",                          // missing name
+		"0001:",                         // empty name
+		"001:Pocket Network",            // invalid id
+		"0001:Pocket Network,",          // empty entry
+		"0001:Pocket Network,0001:POKT", // duplicate id
+	} {
+		_, err = ParseRelayChains(relayChains)
+		require.Equal(t, CodeInvalidRelayChainsError, err.Code(), relayChains)
+	}
+}
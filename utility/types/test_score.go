@@ -0,0 +1,50 @@
+package types
+
+import (
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+)
+
+const (
+	// The sums of the leaves of failed and successful tests in the Merkle sum tree of a test score. Weighting the leaves
+	// by their outcome makes the root sum commit to the number of successful tests along with the number of tests.
+	failedTestResultSum     = 1
+	successfulTestResultSum = 2
+)
+
+// IsTestResultSuccessful returns whether the servicer answered the test relay of `result`
+func IsTestResultSuccessful(result *TestResult) bool {
+	return result.GetResponse() != nil
+}
+
+// NewTestResultMerkleSumLeaf returns the leaf committing to `result` in the Merkle sum tree of a test score.
+// The leaf of a successful test has twice the sum of the leaf of a failed test.
+func NewTestResultMerkleSumLeaf(result *TestResult) (MerkleSumLeaf, Error) {
+	resultBz, err := codec.GetCodec().Marshal(result)
+	if err != nil {
+		return MerkleSumLeaf{}, ErrProtoMarshal(err)
+	}
+	sum := uint64(failedTestResultSum)
+	if IsTestResultSuccessful(result) {
+		sum = successfulTestResultSum
+	}
+	return MerkleSumLeaf{Hash: crypto.SHA3Hash(resultBz), Sum: sum}, nil
+}
+
+// GetTestScoreRootSum returns the sum of the root of the Merkle sum tree of a test score, as committed to by the number
+// of tests and successful tests it reports
+func GetTestScoreRootSum(testScore *coreTypes.TestScore) uint64 {
+	failedTests := testScore.GetTotalTests() - testScore.GetSuccessfulTests()
+	return failedTests*failedTestResultSum + testScore.GetSuccessfulTests()*successfulTestResultSum
+}
+
+// GetTestScoreProofTarget returns the position, in the sum space of the Merkle sum tree of a test score, of the test
+// result the fisherman has to prove. Like claims, it is seeded by the hash of the block the test score was committed in.
+func GetTestScoreProofTarget(testScore *coreTypes.TestScore, testScoreBlockHash string) (uint64, Error) {
+	if testScore.GetTotalTests() == 0 {
+		return 0, ErrInvalidTestScore("no tests reported")
+	}
+	return getProofTarget(GetTestScoreRootSum(testScore), testScoreBlockHash, testScore.GetSessionId(),
+		testScore.GetFishermanAddress(), testScore.GetServicerAddress())
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestScore_RootSumCommitsToSuccessfulTests(t *testing.T) {
+	var failedLeaf MerkleSumLeaf
+	leaves := make([]MerkleSumLeaf, 0)
+	for i := 0; i < 5; i++ {
+		result := &TestResult{
+			Relay: &coreTypes.Relay{Payload: &coreTypes.RelayPayload{Data: fmt.Sprintf("test %d", i)}},
+		}
+		// the servicer answered 3 of the 5 tests
+		if i < 3 {
+			result.Response = &coreTypes.RelayResponse{Payload: fmt.Sprintf("response %d", i)}
+		}
+		leaf, err := NewTestResultMerkleSumLeaf(result)
+		require.NoError(t, err)
+		require.Equal(t, IsTestResultSuccessful(result), leaf.Sum == successfulTestResultSum)
+		if !IsTestResultSuccessful(result) {
+			failedLeaf = leaf
+		}
+		leaves = append(leaves, leaf)
+	}
+
+	tree, err := NewMerkleSumTree(leaves)
+	require.NoError(t, err)
+	rootHash, rootSum := tree.Root()
+	require.Equal(t, GetTestScoreRootSum(&coreTypes.TestScore{TotalTests: 5, SuccessfulTests: 3}), rootSum)
+
+	// a fisherman under-reporting the successful tests cannot prove the tree against the root sum it committed to
+	for target := uint64(0); target < rootSum; target++ {
+		leaf, proof, err := tree.Prove(target)
+		require.NoError(t, err)
+		if string(leaf.Hash) != string(failedLeaf.Hash) {
+			continue
+		}
+		misreportedRootSum := GetTestScoreRootSum(&coreTypes.TestScore{TotalTests: 5, SuccessfulTests: 1})
+		require.False(t, VerifyMerkleSumProof(rootHash, misreportedRootSum, leaf, proof, target))
+		require.True(t, VerifyMerkleSumProof(rootHash, rootSum, leaf, proof, target))
+	}
+}
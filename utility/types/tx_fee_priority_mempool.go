@@ -0,0 +1,287 @@
+package types
+
+import (
+	"container/heap"
+	"math/big"
+	"sort"
+	"sync"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/mempool"
+)
+
+var _ mempool.TXMempool = &txFeePriorityMempool{}
+
+// TxFeeFn returns the fee paid by a transaction
+type TxFeeFn func(tx *coreTypes.Transaction) (*big.Int, error)
+
+// txFeePriorityMempool pops transactions by decreasing fee per byte. The transactions of the same signer are popped
+// in the order they were received, so a signer cannot reorder its own transactions by paying more. When the mempool
+// is at capacity, the transactions with the lowest fee per byte are evicted first.
+type txFeePriorityMempool struct {
+	m       sync.Mutex
+	txFeeFn TxFeeFn
+
+	txs       map[string]*feePriorityTx   // all the txs in the mempool, keyed by hash
+	signerTxs map[string][]*feePriorityTx // the txs of each signer, in arrival order
+	nextTxs   *feePriorityTxHeap          // the next tx of each signer, highest fee per byte first
+	allTxs    *feePriorityTxHeap          // all the txs in the mempool, lowest fee per byte first
+	txSeq     uint64                      // incremented on every tx added, to break ties in arrival order
+
+	txsBytesTotal    uint64 // current sum of all transactions' sizes (in bytes)
+	maxTxsBytesTotal uint64 // maximum total size of all txs allowed in the mempool
+	maxTxs           uint32 // maximum number of txs allowed in the mempool
+}
+
+type feePriorityTx struct {
+	txBz   []byte
+	hash   string
+	signer string
+	fee    *big.Int
+	seq    uint64
+
+	nextTxsIdx int // index in `nextTxs`, -1 if it is not the next tx of its signer
+	allTxsIdx  int // index in `allTxs`
+}
+
+// AddTx adds a tx to the mempool, evicting the txs with the lowest fee per byte if the mempool overflows
+func (t *txFeePriorityMempool) AddTx(txBz []byte) error {
+	hash := crypto.GetHashStringFromBytes(txBz)
+
+	tx, err := coreTypes.TxFromBytes(txBz)
+	if err != nil {
+		return ErrProtoUnmarshal(err)
+	}
+	signer, err := tx.SignerAddress()
+	if err != nil {
+		return ErrNewPublicKeyFromBytes(err)
+	}
+	fee, err := t.txFeeFn(tx)
+	if err != nil {
+		return ErrGetTxFee(err)
+	}
+
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	if _, ok := t.txs[hash]; ok {
+		return ErrDuplicateTransaction()
+	}
+
+	ptx := &feePriorityTx{
+		txBz:       txBz,
+		hash:       hash,
+		signer:     signer.String(),
+		fee:        fee,
+		seq:        t.txSeq,
+		nextTxsIdx: -1,
+	}
+	t.txSeq++
+	t.insert(ptx)
+
+	for t.isOverflowing() {
+		cheapestTx := t.allTxs.txs[0]
+		t.remove(cheapestTx)
+		if cheapestTx == ptx {
+			return ErrMempoolFull()
+		}
+	}
+	return nil
+}
+
+// Clear clears the mempool
+func (t *txFeePriorityMempool) Clear() {
+	t.m.Lock()
+	defer t.m.Unlock()
+	t.reset()
+}
+
+// Contains checks if a tx is in the mempool by its hash
+func (t *txFeePriorityMempool) Contains(hash string) bool {
+	t.m.Lock()
+	defer t.m.Unlock()
+	_, ok := t.txs[hash]
+	return ok
+}
+
+// IsEmpty checks if the mempool is empty
+func (t *txFeePriorityMempool) IsEmpty() bool {
+	t.m.Lock()
+	defer t.m.Unlock()
+	return len(t.txs) == 0
+}
+
+// PopTx pops the tx with the highest fee per byte amongst the next tx of every signer
+func (t *txFeePriorityMempool) PopTx() ([]byte, error) {
+	t.m.Lock()
+	defer t.m.Unlock()
+	if t.nextTxs.Len() == 0 {
+		return nil, ErrEmptyMempool()
+	}
+	ptx := t.nextTxs.txs[0]
+	t.remove(ptx)
+	return ptx.txBz, nil
+}
+
+// GetAll returns all the txs in the mempool, grouped by signer. The signers are ordered by the arrival of their oldest
+// tx in the mempool, and the txs of the same signer are returned in arrival order.
+func (t *txFeePriorityMempool) GetAll() [][]byte {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	signers := make([]string, 0, len(t.signerTxs))
+	for signer := range t.signerTxs {
+		signers = append(signers, signer)
+	}
+	sort.Slice(signers, func(i, j int) bool { return t.signerTxs[signers[i]][0].seq < t.signerTxs[signers[j]][0].seq })
+
+	txs := make([][]byte, 0, len(t.txs))
+	for _, signer := range signers {
+		for _, ptx := range t.signerTxs[signer] {
+			txs = append(txs, ptx.txBz)
+		}
+	}
+	return txs
+}
+
+// RemoveTx removes a tx from the mempool
+func (t *txFeePriorityMempool) RemoveTx(txBz []byte) error {
+	t.m.Lock()
+	defer t.m.Unlock()
+	if ptx, ok := t.txs[crypto.GetHashStringFromBytes(txBz)]; ok {
+		t.remove(ptx)
+	}
+	return nil
+}
+
+// TxCount returns the number of txs in the mempool
+func (t *txFeePriorityMempool) TxCount() uint32 {
+	t.m.Lock()
+	defer t.m.Unlock()
+	return uint32(len(t.txs))
+}
+
+// TxsBytesTotal returns the total size, in bytes, of all txs in the mempool
+func (t *txFeePriorityMempool) TxsBytesTotal() uint64 {
+	t.m.Lock()
+	defer t.m.Unlock()
+	return t.txsBytesTotal
+}
+
+func (t *txFeePriorityMempool) isOverflowing() bool {
+	return uint32(len(t.txs)) > t.maxTxs || t.txsBytesTotal > t.maxTxsBytesTotal
+}
+
+func (t *txFeePriorityMempool) insert(ptx *feePriorityTx) {
+	t.txs[ptx.hash] = ptx
+	t.txsBytesTotal += uint64(len(ptx.txBz))
+	heap.Push(t.allTxs, ptx)
+
+	// Txs are received in increasing `seq`, so appending keeps the txs of the signer in arrival order
+	signerTxs := append(t.signerTxs[ptx.signer], ptx)
+	t.signerTxs[ptx.signer] = signerTxs
+
+	// The tx is the next tx of its signer if the signer has no other tx in the mempool
+	if len(signerTxs) == 1 {
+		heap.Push(t.nextTxs, ptx)
+	}
+}
+
+func (t *txFeePriorityMempool) remove(ptx *feePriorityTx) {
+	delete(t.txs, ptx.hash)
+	t.txsBytesTotal -= uint64(len(ptx.txBz))
+	heap.Remove(t.allTxs, ptx.allTxsIdx)
+
+	signerTxs := t.signerTxs[ptx.signer]
+	for i, signerTx := range signerTxs {
+		if signerTx == ptx {
+			signerTxs = append(signerTxs[:i], signerTxs[i+1:]...)
+			break
+		}
+	}
+	if len(signerTxs) == 0 {
+		delete(t.signerTxs, ptx.signer)
+	} else {
+		t.signerTxs[ptx.signer] = signerTxs
+	}
+
+	// The following tx of the signer, if any, becomes its next tx
+	if ptx.nextTxsIdx >= 0 {
+		heap.Remove(t.nextTxs, ptx.nextTxsIdx)
+		if len(signerTxs) > 0 {
+			heap.Push(t.nextTxs, signerTxs[0])
+		}
+	}
+}
+
+func (t *txFeePriorityMempool) reset() {
+	t.txs = make(map[string]*feePriorityTx)
+	t.signerTxs = make(map[string][]*feePriorityTx)
+	t.nextTxs = &feePriorityTxHeap{
+		less: hasHigherFeePriority,
+		idx:  func(ptx *feePriorityTx) *int { return &ptx.nextTxsIdx },
+	}
+	t.allTxs = &feePriorityTxHeap{
+		less: func(a, b *feePriorityTx) bool { return hasHigherFeePriority(b, a) },
+		idx:  func(ptx *feePriorityTx) *int { return &ptx.allTxsIdx },
+	}
+	t.txsBytesTotal = 0
+}
+
+// hasHigherFeePriority returns whether `a` pays a higher fee per byte than `b`, or the same fee per byte but was
+// received before `b`
+func hasHigherFeePriority(a, b *feePriorityTx) bool {
+	// a.fee / len(a.txBz) > b.fee / len(b.txBz) <=> a.fee * len(b.txBz) > b.fee * len(a.txBz)
+	aFeeScaled := new(big.Int).Mul(a.fee, big.NewInt(int64(len(b.txBz))))
+	bFeeScaled := new(big.Int).Mul(b.fee, big.NewInt(int64(len(a.txBz))))
+	if cmp := aFeeScaled.Cmp(bFeeScaled); cmp != 0 {
+		return cmp > 0
+	}
+	return a.seq < b.seq
+}
+
+// feePriorityTxHeap implements `heap.Interface`, keeping track of the index of every tx in the heap so it can be
+// removed in logarithmic time
+type feePriorityTxHeap struct {
+	txs  []*feePriorityTx
+	less func(a, b *feePriorityTx) bool
+	idx  func(ptx *feePriorityTx) *int
+}
+
+func (h *feePriorityTxHeap) Len() int { return len(h.txs) }
+
+func (h *feePriorityTxHeap) Less(i, j int) bool { return h.less(h.txs[i], h.txs[j]) }
+
+func (h *feePriorityTxHeap) Swap(i, j int) {
+	h.txs[i], h.txs[j] = h.txs[j], h.txs[i]
+	*h.idx(h.txs[i]) = i
+	*h.idx(h.txs[j]) = j
+}
+
+func (h *feePriorityTxHeap) Push(x any) {
+	ptx := x.(*feePriorityTx)
+	*h.idx(ptx) = len(h.txs)
+	h.txs = append(h.txs, ptx)
+}
+
+func (h *feePriorityTxHeap) Pop() any {
+	n := len(h.txs)
+	ptx := h.txs[n-1]
+	h.txs[n-1] = nil
+	h.txs = h.txs[:n-1]
+	*h.idx(ptx) = -1
+	return ptx
+}
+
+// NewTxFeePriorityMempool returns a mempool ordering transactions by the fee per byte returned by `txFeeFn`
+func NewTxFeePriorityMempool(maxTxsBytesTotal uint64, maxTxs uint32, txFeeFn TxFeeFn) *txFeePriorityMempool {
+	txFeePriorityMempool := &txFeePriorityMempool{
+		m:                sync.Mutex{},
+		txFeeFn:          txFeeFn,
+		maxTxsBytesTotal: maxTxsBytesTotal,
+		maxTxs:           maxTxs,
+	}
+	txFeePriorityMempool.reset()
+	return txFeePriorityMempool
+}
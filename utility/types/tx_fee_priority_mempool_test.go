@@ -0,0 +1,191 @@
+package types
+
+import (
+	"math/big"
+	"strconv"
+	"testing"
+
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxFeePriorityMempool_PopByFeePerByte(t *testing.T) {
+	fees := make(map[string]*big.Int)
+	txMempool := NewTxFeePriorityMempool(1e6, 100, testTxFeeFn(fees))
+
+	lowFeeTx := newTestingFeeTx(t, fees, newTestingSigner(t), 1, 10)
+	highFeeTx := newTestingFeeTx(t, fees, newTestingSigner(t), 1, 1000)
+	midFeeTx := newTestingFeeTx(t, fees, newTestingSigner(t), 1, 100)
+	for _, txBz := range [][]byte{lowFeeTx, highFeeTx, midFeeTx} {
+		require.NoError(t, txMempool.AddTx(txBz))
+	}
+	require.Equal(t, ErrDuplicateTransaction().Code(), txMempool.AddTx(midFeeTx).(Error).Code())
+
+	requirePopOrder(t, txMempool, highFeeTx, midFeeTx, lowFeeTx)
+
+	_, err := txMempool.PopTx()
+	require.Equal(t, CodeEmptyMempoolError, err.(Error).Code())
+}
+
+func TestTxFeePriorityMempool_SignerArrivalOrder(t *testing.T) {
+	fees := make(map[string]*big.Int)
+	txMempool := NewTxFeePriorityMempool(1e6, 100, testTxFeeFn(fees))
+
+	signer := newTestingSigner(t)
+	otherSigner := newTestingSigner(t)
+
+	// A higher fee does not allow a signer to get its later transactions popped first, whatever their nonces
+	signerTx3 := newTestingFeeTx(t, fees, signer, 3, 1000)
+	signerTx1 := newTestingFeeTx(t, fees, signer, 1, 10)
+	signerTx20 := newTestingFeeTx(t, fees, signer, 20, 5000)
+	otherSignerTx := newTestingFeeTx(t, fees, otherSigner, 1, 100)
+	for _, txBz := range [][]byte{signerTx3, signerTx1, signerTx20, otherSignerTx} {
+		require.NoError(t, txMempool.AddTx(txBz))
+	}
+
+	requirePopOrder(t, txMempool, signerTx3, otherSignerTx, signerTx1, signerTx20)
+}
+
+func TestTxFeePriorityMempool_RemoveTx(t *testing.T) {
+	fees := make(map[string]*big.Int)
+	txMempool := NewTxFeePriorityMempool(1e6, 100, testTxFeeFn(fees))
+
+	signer := newTestingSigner(t)
+	signerTx1 := newTestingFeeTx(t, fees, signer, 1, 10)
+	signerTx2 := newTestingFeeTx(t, fees, signer, 2, 1000)
+	otherSignerTx := newTestingFeeTx(t, fees, newTestingSigner(t), 1, 100)
+	for _, txBz := range [][]byte{signerTx1, signerTx2, otherSignerTx} {
+		require.NoError(t, txMempool.AddTx(txBz))
+	}
+
+	// Removing the next transaction of a signer makes its following transaction the next one
+	require.NoError(t, txMempool.RemoveTx(signerTx1))
+	require.False(t, txMempool.Contains(crypto.GetHashStringFromBytes(signerTx1)))
+	require.Equal(t, uint64(len(signerTx2)+len(otherSignerTx)), txMempool.TxsBytesTotal())
+
+	requirePopOrder(t, txMempool, signerTx2, otherSignerTx)
+
+	require.NoError(t, txMempool.AddTx(signerTx1))
+	txMempool.Clear()
+	require.True(t, txMempool.IsEmpty())
+	require.Equal(t, uint32(0), txMempool.TxCount())
+	require.Equal(t, uint64(0), txMempool.TxsBytesTotal())
+}
+
+func TestTxFeePriorityMempool_EvictCheapest(t *testing.T) {
+	fees := make(map[string]*big.Int)
+	txMempool := NewTxFeePriorityMempool(1e6, 2, testTxFeeFn(fees))
+
+	lowFeeTx := newTestingFeeTx(t, fees, newTestingSigner(t), 1, 10)
+	midFeeTx := newTestingFeeTx(t, fees, newTestingSigner(t), 1, 100)
+	highFeeTx := newTestingFeeTx(t, fees, newTestingSigner(t), 1, 1000)
+	require.NoError(t, txMempool.AddTx(lowFeeTx))
+	require.NoError(t, txMempool.AddTx(midFeeTx))
+
+	// A transaction paying more than the cheapest one evicts it
+	require.NoError(t, txMempool.AddTx(highFeeTx))
+	require.Equal(t, uint32(2), txMempool.TxCount())
+	require.False(t, txMempool.Contains(crypto.GetHashStringFromBytes(lowFeeTx)))
+
+	// A transaction paying less than all the others is rejected
+	err := txMempool.AddTx(lowFeeTx)
+	require.Equal(t, CodeMempoolFullError, err.(Error).Code())
+	require.False(t, txMempool.Contains(crypto.GetHashStringFromBytes(lowFeeTx)))
+
+	requirePopOrder(t, txMempool, highFeeTx, midFeeTx)
+}
+
+func TestTxFeePriorityMempool_EvictCheapestBytes(t *testing.T) {
+	fees := make(map[string]*big.Int)
+	lowFeeTx := newTestingFeeTx(t, fees, newTestingSigner(t), 1, 10)
+	midFeeTx := newTestingFeeTx(t, fees, newTestingSigner(t), 1, 100)
+	highFeeTx := newTestingFeeTx(t, fees, newTestingSigner(t), 1, 1000)
+
+	// Only two transactions fit in the mempool
+	maxTxsBytesTotal := uint64(len(midFeeTx) + len(highFeeTx))
+	txMempool := NewTxFeePriorityMempool(maxTxsBytesTotal, 100, testTxFeeFn(fees))
+	for _, txBz := range [][]byte{midFeeTx, lowFeeTx, highFeeTx} {
+		require.NoError(t, txMempool.AddTx(txBz))
+	}
+	require.LessOrEqual(t, txMempool.TxsBytesTotal(), maxTxsBytesTotal)
+	require.False(t, txMempool.Contains(crypto.GetHashStringFromBytes(lowFeeTx)))
+
+	requirePopOrder(t, txMempool, highFeeTx, midFeeTx)
+}
+
+func TestTxFeePriorityMempool_GetAll(t *testing.T) {
+	fees := make(map[string]*big.Int)
+	txMempool := NewTxFeePriorityMempool(1e6, 100, testTxFeeFn(fees))
+
+	signer := newTestingSigner(t)
+	otherSigner := newTestingSigner(t)
+	lastSigner := newTestingSigner(t)
+
+	// The signers are ordered by their oldest tx, and their txs by arrival regardless of their fee or nonce
+	signerTx2 := newTestingFeeTx(t, fees, signer, 2, 1000)
+	otherSignerTx := newTestingFeeTx(t, fees, otherSigner, 1, 5000)
+	signerTx1 := newTestingFeeTx(t, fees, signer, 1, 10)
+	lastSignerTx := newTestingFeeTx(t, fees, lastSigner, 1, 100)
+	for _, txBz := range [][]byte{signerTx2, otherSignerTx, signerTx1, lastSignerTx} {
+		require.NoError(t, txMempool.AddTx(txBz))
+	}
+
+	for i := 0; i < 10; i++ {
+		require.Equal(t, [][]byte{signerTx2, signerTx1, otherSignerTx, lastSignerTx}, txMempool.GetAll())
+	}
+
+	// The order follows the oldest tx left for each signer
+	require.NoError(t, txMempool.RemoveTx(signerTx2))
+	require.Equal(t, [][]byte{otherSignerTx, signerTx1, lastSignerTx}, txMempool.GetAll())
+}
+
+func requirePopOrder(t *testing.T, txMempool *txFeePriorityMempool, wantTxs ...[]byte) {
+	t.Helper()
+	require.Equal(t, uint32(len(wantTxs)), txMempool.TxCount())
+	for _, wantTx := range wantTxs {
+		gotTx, err := txMempool.PopTx()
+		require.NoError(t, err)
+		require.Equal(t, wantTx, gotTx)
+	}
+	require.True(t, txMempool.IsEmpty())
+}
+
+// testTxFeeFn returns the fee set by `newTestingFeeTx` for the transaction
+func testTxFeeFn(fees map[string]*big.Int) TxFeeFn {
+	return func(tx *coreTypes.Transaction) (*big.Int, error) {
+		txBz, err := tx.Bytes()
+		if err != nil {
+			return nil, err
+		}
+		return fees[crypto.GetHashStringFromBytes(txBz)], nil
+	}
+}
+
+func newTestingSigner(t *testing.T) crypto.PrivateKey {
+	t.Helper()
+	privateKey, err := crypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	return privateKey
+}
+
+// newTestingFeeTx returns a transaction signed by `signer` with the given nonce, paying `fee`
+func newTestingFeeTx(t *testing.T, fees map[string]*big.Int, signer crypto.PrivateKey, nonce uint64, fee int64) []byte {
+	t.Helper()
+	anyMsg, err := codec.GetCodec().ToAny(&MessageSend{
+		FromAddress: signer.Address(),
+		ToAddress:   signer.Address(),
+		Amount:      "1",
+	})
+	require.NoError(t, err)
+	tx := &coreTypes.Transaction{
+		Msg:   anyMsg,
+		Nonce: strconv.FormatUint(nonce, 10),
+	}
+	require.NoError(t, tx.Sign(signer))
+	txBz, err := tx.Bytes()
+	require.NoError(t, err)
+	fees[crypto.GetHashStringFromBytes(txBz)] = big.NewInt(fee)
+	return txBz
+}
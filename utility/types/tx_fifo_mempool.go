@@ -54,6 +54,11 @@ func (t *txFIFOMempool) PopTx() ([]byte, error) {
 	return t.g.Pop()
 }
 
+// GetAll returns all the txs in the mempool, oldest first
+func (t *txFIFOMempool) GetAll() [][]byte {
+	return t.g.GetAll()
+}
+
 // RemoveTx removes a tx from the mempool
 func (t *txFIFOMempool) RemoveTx(tx []byte) error {
 	t.g.Remove(tx)
@@ -226,6 +226,26 @@ func TestTxFIFOMempool(t *testing.T) {
 				messageSendFactory(10),
 			},
 		},
+		{
+			name: "GetAll should return all the transactions, oldest first, without removing them",
+			args: args{
+				maxTxBytes: 1000,
+				maxTxs:     100,
+				initialTxs: &[][]byte{
+					messageSendFactory(9),
+					messageSendFactory(10),
+				},
+				actions: &[]func(*txFIFOMempool){
+					func(txFIFOMempool *txFIFOMempool) {
+						require.Equal(t, [][]byte{messageSendFactory(9), messageSendFactory(10)}, txFIFOMempool.GetAll(), "mismatching GetAll")
+					},
+				},
+			},
+			wantItems: [][]byte{
+				messageSendFactory(9),
+				messageSendFactory(10),
+			},
+		},
 		{
 			name: "Contains should return true for a transaction that is in the mempool and false viceversa",
 			args: args{
@@ -11,12 +11,16 @@ import (
 
 var _ modules.TxResult = &TxResult{}
 
+// TxToTxResult builds the result of applying the `msgs` of `tx`, given the result of handling each of them.
+// The messages of a transaction are applied until one of them fails, so `msgHandlingResults` may be shorter than
+// `msgs`. The result code and error of the transaction are the ones of the failed message, if any, while its
+// recipient and message type are the ones of its first message.
 func TxToTxResult(
 	tx *coreTypes.Transaction,
 	height int64,
 	index int,
-	msg Message,
-	msgHandlingResult Error,
+	msgs []Message,
+	msgHandlingResults []Error,
 ) (*TxResult, Error) {
 	txBz, err := tx.Bytes()
 	if err != nil {
@@ -24,19 +28,30 @@ func TxToTxResult(
 	}
 	resultCode := int32(0)
 	errorMsg := ""
-	if msgHandlingResult != nil {
-		resultCode = int32(msgHandlingResult.Code())
-		errorMsg = msgHandlingResult.Error()
+	msgResults := make([]*MessageResult, len(msgHandlingResults))
+	for i, msgHandlingResult := range msgHandlingResults {
+		msgResult := &MessageResult{
+			RecipientAddr: msgs[i].GetMessageRecipient(),
+			MessageType:   msgs[i].GetMessageName(),
+		}
+		if msgHandlingResult != nil {
+			msgResult.ResultCode = int32(msgHandlingResult.Code())
+			msgResult.Error = msgHandlingResult.Error()
+			resultCode = msgResult.ResultCode
+			errorMsg = msgResult.Error
+		}
+		msgResults[i] = msgResult
 	}
 	return &TxResult{
-		Tx:            txBz,
-		Height:        height,
-		Index:         int32(index),
-		ResultCode:    resultCode, // TECHDEBT: Remove or update this appropriately.
-		Error:         errorMsg,   // TECHDEBT: Remove or update this appropriately.
-		SignerAddr:    hex.EncodeToString(msg.GetSigner()),
-		RecipientAddr: msg.GetMessageRecipient(),
-		MessageType:   msg.GetMessageName(),
+		Tx:             txBz,
+		Height:         height,
+		Index:          int32(index),
+		ResultCode:     resultCode, // TECHDEBT: Remove or update this appropriately.
+		Error:          errorMsg,   // TECHDEBT: Remove or update this appropriately.
+		SignerAddr:     hex.EncodeToString(msgs[0].GetSigner()),
+		RecipientAddr:  msgs[0].GetMessageRecipient(),
+		MessageType:    msgs[0].GetMessageName(),
+		MessageResults: msgResults,
 	}, nil
 }
 
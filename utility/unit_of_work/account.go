@@ -6,8 +6,10 @@ package unit_of_work
 // Pools are autonomous accounts owned by the protocol; e.g. an account for a fee pool that gets distributed
 
 import (
+	"encoding/hex"
 	"math/big"
 
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"github.com/pokt-network/pocket/shared/utils"
 	"github.com/pokt-network/pocket/utility/types"
 )
@@ -47,6 +49,46 @@ func (u *baseUtilityUnitOfWork) setAccountAmount(address []byte, amount *big.Int
 	return nil
 }
 
+// Vesting specific functionality
+
+// checkVestedAmount returns an error if `remainingAmount`, i.e. the balance left in the account once the tokens it
+// spends are subtracted, is lower than the amount its vesting schedule still locks at the current height
+func (u *baseUtilityUnitOfWork) checkVestedAmount(address []byte, remainingAmount *big.Int) types.Error {
+	schedule, err := u.persistenceReadContext.GetVestingSchedule(address, u.height)
+	if err != nil {
+		return types.ErrGetVestingSchedule(err)
+	}
+	if schedule == nil {
+		return nil
+	}
+	lockedAmount, err := getVestingLockedAmount(schedule, u.height)
+	if err != nil {
+		return types.ErrStringToBigInt(err)
+	}
+	if remainingAmount.Cmp(lockedAmount) < 0 {
+		return types.ErrInsufficientVestedAmount(hex.EncodeToString(address), utils.BigIntToString(lockedAmount))
+	}
+	return nil
+}
+
+// getVestingLockedAmount returns the amount of tokens of `schedule` that are not vested yet at `height`
+func getVestingLockedAmount(schedule *coreTypes.VestingSchedule, height int64) (*big.Int, error) {
+	amount, err := utils.StringToBigInt(schedule.GetAmount())
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case height < schedule.GetCliffHeight():
+		return amount, nil
+	case height >= schedule.GetEndHeight():
+		return big.NewInt(0), nil
+	}
+	// NB: start <= cliff <= height < end, so the vesting period cannot be empty
+	vestedAmount := new(big.Int).Mul(amount, big.NewInt(height-schedule.GetStartHeight()))
+	vestedAmount.Quo(vestedAmount, big.NewInt(schedule.GetEndHeight()-schedule.GetStartHeight()))
+	return amount.Sub(amount, vestedAmount), nil
+}
+
 // Pools specific functionality
 
 // IMPROVE: Pool function should accept the actual pool types rather than the `FriendlyName` string
@@ -129,6 +129,35 @@ func TestUtilityUnitOfWork_SubPoolAmount(t *testing.T) {
 	require.Equal(t, expected, amount)
 }
 
+func TestUtilityUnitOfWork_GetVestingLockedAmount(t *testing.T) {
+	schedule := &coreTypes.VestingSchedule{
+		Amount:      "1000",
+		StartHeight: 10,
+		CliffHeight: 30,
+		EndHeight:   110,
+	}
+
+	tests := []struct {
+		name                 string
+		height               int64
+		expectedLockedAmount int64
+	}{
+		{"before the start", 5, 1000},
+		{"before the cliff", 29, 1000},
+		{"at the cliff", 30, 800},
+		{"between the cliff and the end", 60, 500},
+		{"at the end", 110, 0},
+		{"after the end", 200, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lockedAmount, err := getVestingLockedAmount(schedule, tt.height)
+			require.NoError(t, err)
+			require.Equal(t, big.NewInt(tt.expectedLockedAmount), lockedAmount)
+		})
+	}
+}
+
 func getAllTestingAccounts(t *testing.T, uow *baseUtilityUnitOfWork) []*coreTypes.Account {
 	accs, err := uow.persistenceReadContext.GetAllAccounts(0)
 	require.NoError(t, err)
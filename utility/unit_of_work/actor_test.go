@@ -61,6 +61,49 @@ func TestUtilityUnitOfWork_HandleMessageStake(t *testing.T) {
 	}
 }
 
+func TestUtilityUnitOfWork_HandleMessageStake_GeoZone(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 0)
+
+	err := uow.persistenceRWContext.SetParam(typesUtil.AllowedGeoZonesParamName, "0,1")
+	require.NoError(t, err)
+
+	outputAddress, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	err = uow.setAccountAmount(outputAddress, test_artifacts.DefaultAccountAmount)
+	require.NoError(t, err, "error setting account amount error")
+
+	msg := newTestingServicerStakeMsg(t, outputAddress, test_artifacts.DefaultChains, "2")
+	require.Equal(t, typesUtil.CodeGeoZoneNotAllowedError, uow.handleStakeMessage(msg).Code())
+
+	msg = newTestingServicerStakeMsg(t, outputAddress, test_artifacts.DefaultChains, "0")
+	require.NoError(t, uow.handleStakeMessage(msg))
+	pubKey, err := crypto.NewPublicKeyFromBytes(msg.PublicKey)
+	require.NoError(t, err)
+	actor := getActorByAddr(t, uow, coreTypes.ActorType_ACTOR_TYPE_SERVICER, pubKey.Address().String())
+	require.Equal(t, "0", actor.GetGeoZone(), "incorrect actor geo zone")
+}
+
+func TestUtilityUnitOfWork_HandleMessageStake_RelayChainRegistry(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 0)
+
+	err := uow.persistenceRWContext.SetParam(typesUtil.RelayChainsParamName, "0001:Pocket Network,0021:Ethereum Mainnet")
+	require.NoError(t, err)
+
+	outputAddress, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	err = uow.setAccountAmount(outputAddress, test_artifacts.DefaultAccountAmount)
+	require.NoError(t, err, "error setting account amount error")
+
+	// a typo in a chain identifier is rejected instead of staking the actor for a chain that does not exist
+	require.Equal(t, typesUtil.CodeRelayChainNotRegisteredError, uow.handleStakeMessage(newTestingServicerStakeMsg(t, outputAddress, []string{"0001", "0012"}, "")).Code())
+	require.NoError(t, uow.handleStakeMessage(newTestingServicerStakeMsg(t, outputAddress, []string{"0001", "0021"}, "")))
+
+	// an empty registry does not restrict the chains actors can stake for
+	err = uow.persistenceRWContext.SetParam(typesUtil.RelayChainsParamName, "")
+	require.NoError(t, err)
+	require.NoError(t, uow.handleStakeMessage(newTestingServicerStakeMsg(t, outputAddress, []string{"0012"}, "")))
+}
+
 func TestUtilityUnitOfWork_HandleMessageEditStake(t *testing.T) {
 	for actorTypeNum := range coreTypes.ActorType_name {
 		if actorTypeNum == 0 { // ACTOR_TYPE_UNSPECIFIED
@@ -167,6 +210,41 @@ func TestUtilityUnitOfWork_HandleMessageUnstake(t *testing.T) {
 	}
 }
 
+func TestUtilityUnitOfWork_HandleMessagePause(t *testing.T) {
+	for actorTypeNum := range coreTypes.ActorType_name {
+		if actorTypeNum == 0 { // ACTOR_TYPE_UNSPECIFIED
+			continue
+		}
+		actorType := coreTypes.ActorType(actorTypeNum)
+
+		t.Run(fmt.Sprintf("%s.HandleMessagePause", actorType.String()), func(t *testing.T) {
+			uow := newTestingUtilityUnitOfWork(t, 1)
+
+			actor := getFirstActor(t, uow, actorType)
+			addr := actor.GetAddress()
+			addrBz, err := hex.DecodeString(addr)
+			require.NoError(t, err)
+			require.Equal(t, typesUtil.HeightNotUsed, actor.GetPausedHeight())
+
+			msgPauseActor := &typesUtil.MessagePause{
+				Address:   addrBz,
+				Signer:    addrBz,
+				ActorType: actorType,
+			}
+			err = uow.handlePauseMessage(msgPauseActor)
+			require.NoError(t, err)
+
+			// Verify the actor is paused at the current height
+			actor = getActorByAddr(t, uow, actorType, addr)
+			require.Equal(t, uow.height, actor.GetPausedHeight())
+
+			// An actor cannot be paused twice
+			er := uow.handlePauseMessage(msgPauseActor)
+			require.Equal(t, typesUtil.CodeAlreadyPausedError, er.Code())
+		})
+	}
+}
+
 func TestUtilityUnitOfWork_HandleMessageUnpause(t *testing.T) {
 	// The gov param for each actor will be set to this value
 	minPauseBlocksNumber := 5
@@ -249,6 +327,58 @@ func TestUtilityUnitOfWork_HandleMessageUnpause(t *testing.T) {
 	}
 }
 
+func TestUtilityUnitOfWork_HandleMessageRotateOperatorKey(t *testing.T) {
+	for actorTypeNum := range coreTypes.ActorType_name {
+		if actorTypeNum == 0 { // ACTOR_TYPE_UNSPECIFIED
+			continue
+		}
+		actorType := coreTypes.ActorType(actorTypeNum)
+
+		t.Run(fmt.Sprintf("%s.HandleMessageRotateOperatorKey", actorType.String()), func(t *testing.T) {
+			uow := newTestingUtilityUnitOfWork(t, 1)
+
+			actors := getAllTestingActors(t, uow, actorType)
+			actor := actors[0]
+			addrBz, err := hex.DecodeString(actor.GetAddress())
+			require.NoError(t, err)
+
+			// Pause the actor, which stays paused once rotated
+			require.NoError(t, uow.setActorPausedHeight(actorType, addrBz, 1))
+
+			newPrivateKey, err := crypto.GeneratePrivateKey()
+			require.NoError(t, err)
+			msg := &typesUtil.MessageRotateOperatorKey{
+				ActorType:    actorType,
+				Address:      addrBz,
+				NewPublicKey: newPrivateKey.PublicKey().Bytes(),
+			}
+			require.NoError(t, uow.handleMessageRotateOperatorKey(msg))
+
+			rotatedActor := getActorByAddr(t, uow, actorType, newPrivateKey.Address().String())
+			require.Equal(t, newPrivateKey.PublicKey().String(), rotatedActor.GetPublicKey())
+			require.Equal(t, actor.GetStakedAmount(), rotatedActor.GetStakedAmount())
+			require.Equal(t, actor.GetChains(), rotatedActor.GetChains())
+			require.Equal(t, actor.GetServiceUrl(), rotatedActor.GetServiceUrl())
+			require.Equal(t, actor.GetOutput(), rotatedActor.GetOutput())
+			require.Equal(t, int64(1), rotatedActor.GetPausedHeight())
+			require.Equal(t, actor.GetUnstakingHeight(), rotatedActor.GetUnstakingHeight())
+
+			// The old operator is retired
+			status, err := uow.getActorStatus(actorType, addrBz)
+			require.NoError(t, err)
+			require.Equal(t, coreTypes.StakeStatus_Unstaked, status)
+			require.Equal(t, typesUtil.CodeInvalidStatusError, uow.handleMessageRotateOperatorKey(msg).Code())
+
+			// The operator of another actor cannot be taken over
+			otherActorPublicKey, err := hex.DecodeString(actors[1].GetPublicKey())
+			require.NoError(t, err)
+			msg.Address = newPrivateKey.Address()
+			msg.NewPublicKey = otherActorPublicKey
+			require.Equal(t, typesUtil.CodeAlreadyExistsError, uow.handleMessageRotateOperatorKey(msg).Code())
+		})
+	}
+}
+
 func TestUtilityUnitOfWork_GetUnbondingHeight(t *testing.T) {
 	for actorTypeNum := range coreTypes.ActorType_name {
 		if actorTypeNum == 0 { // ACTOR_TYPE_UNSPECIFIED
@@ -595,6 +725,34 @@ func TestUtilityUnitOfWork_GetMessageEditStakeSignerCandidates(t *testing.T) {
 	}
 }
 
+func TestUtilityUnitOfWork_GetMessagePauseSignerCandidates(t *testing.T) {
+	for actorTypeNum := range coreTypes.ActorType_name {
+		if actorTypeNum == 0 { // ACTOR_TYPE_UNSPECIFIED
+			continue
+		}
+		actorType := coreTypes.ActorType(actorTypeNum)
+
+		t.Run(fmt.Sprintf("%s.GetMessagePauseSignerCandidates", actorType.String()), func(t *testing.T) {
+			uow := newTestingUtilityUnitOfWork(t, 0)
+			actor := getFirstActor(t, uow, actorType)
+
+			addrBz, err := hex.DecodeString(actor.GetAddress())
+			require.NoError(t, err)
+
+			msg := &typesUtil.MessagePause{
+				Address:   addrBz,
+				ActorType: actorType,
+			}
+			candidates, err := uow.getMessagePauseSignerCandidates(msg)
+			require.NoError(t, err)
+
+			require.Equal(t, 2, len(candidates), "unexpected number of candidates")
+			require.Equal(t, actor.GetOutput(), hex.EncodeToString(candidates[0]), "incorrect output candidate")
+			require.Equal(t, actor.GetAddress(), hex.EncodeToString(candidates[1]), "incorrect addr candidate")
+		})
+	}
+}
+
 func TestUtilityUnitOfWork_GetMessageUnpauseSignerCandidates(t *testing.T) {
 	for actorTypeNum := range coreTypes.ActorType_name {
 		if actorTypeNum == 0 { // ACTOR_TYPE_UNSPECIFIED
@@ -651,6 +809,34 @@ func TestUtilityUnitOfWork_GetMessageUnstakeSignerCandidates(t *testing.T) {
 	}
 }
 
+func TestUtilityUnitOfWork_GetMessageRotateOperatorKeySignerCandidates(t *testing.T) {
+	for actorTypeNum := range coreTypes.ActorType_name {
+		if actorTypeNum == 0 { // ACTOR_TYPE_UNSPECIFIED
+			continue
+		}
+		actorType := coreTypes.ActorType(actorTypeNum)
+
+		t.Run(fmt.Sprintf("%s.GetMessageRotateOperatorKeySignerCandidates", actorType.String()), func(t *testing.T) {
+			uow := newTestingUtilityUnitOfWork(t, 0)
+			actor := getFirstActor(t, uow, actorType)
+
+			addrBz, err := hex.DecodeString(actor.GetAddress())
+			require.NoError(t, err)
+
+			msg := &typesUtil.MessageRotateOperatorKey{
+				Address:   addrBz,
+				ActorType: actorType,
+			}
+			candidates, err := uow.getMessageRotateOperatorKeySignerCandidates(msg)
+			require.NoError(t, err)
+
+			// the operator cannot rotate its own key
+			require.Equal(t, 1, len(candidates), "unexpected number of candidates")
+			require.Equal(t, actor.GetOutput(), hex.EncodeToString(candidates[0]), "incorrect output candidate")
+		})
+	}
+}
+
 // Helpers
 
 func getAllTestingActors(t *testing.T, uow *baseUtilityUnitOfWork, actorType coreTypes.ActorType) (actors []*coreTypes.Actor) {
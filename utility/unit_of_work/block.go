@@ -5,7 +5,10 @@ package unit_of_work
 import (
 	"encoding/hex"
 	"math/big"
+	"sort"
 
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/shared/codec"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	moduleTypes "github.com/pokt-network/pocket/shared/modules/types"
 	"github.com/pokt-network/pocket/shared/utils"
@@ -26,20 +29,64 @@ func (u *baseUtilityUnitOfWork) endBlock(proposer []byte) typesUtil.Error {
 		return err
 	}
 
+	// mint the rewards of the servicers for the relays proven in the block
+	if err := u.handleRelayRewards(); err != nil {
+		return err
+	}
+
 	// unstake actors that have been 'unstaking' for the <Actor>UnstakingBlocks
 	if err := u.unbondUnstakingActors(); err != nil {
 		return err
 	}
 
+	// return the delegated stake that has been 'undelegating' for the ValidatorUnstakingBlocks
+	if err := u.unbondUndelegatedStake(); err != nil {
+		return err
+	}
+
 	// begin unstaking the actors who have been paused for MaxPauseBlocks
 	if err := u.beginUnstakingMaxPausedActors(); err != nil {
 		return err
 	}
 
+	// remove the claims that were not proven within ClaimExpirationBlocks
+	if err := u.expireClaims(); err != nil {
+		return err
+	}
+	if err := u.expireTestScores(); err != nil {
+		return err
+	}
+
+	// tally the votes on the proposals whose voting period ends, applying the ones that passed
+	if err := u.handleProposals(); err != nil {
+		return err
+	}
+
+	// mark the upgrade activating in this block as applied, since it can only be committed by the upgraded binary
+	if err := u.applyUpgradePlan(); err != nil {
+		return err
+	}
+
 	// INCOMPLETE: Identify what else needs to be done in the begin block lifecycle phase
 	return nil
 }
 
+// applyUpgradePlan marks the upgrade plan as applied at its activation height, so nodes stop halting for it
+func (u *baseUtilityUnitOfWork) applyUpgradePlan() typesUtil.Error {
+	plan, er := u.persistenceRWContext.GetUpgradePlan(u.height)
+	if er != nil {
+		return typesUtil.ErrGetUpgradePlan(er)
+	}
+	if plan == nil || plan.Applied || plan.ActivationHeight != u.height {
+		return nil
+	}
+	plan.Applied = true
+	if er := u.persistenceRWContext.SetUpgradePlan(plan); er != nil {
+		return typesUtil.ErrSetUpgradePlan(er)
+	}
+	return nil
+}
+
 func (u *baseUtilityUnitOfWork) handleProposerRewards(proposer []byte) typesUtil.Error {
 	feePoolName := coreTypes.Pools_POOLS_FEE_COLLECTOR.FriendlyName()
 	feesAndRewardsCollected, err := u.getPoolAmount(feePoolName)
@@ -68,7 +115,7 @@ func (u *baseUtilityUnitOfWork) handleProposerRewards(proposer []byte) typesUtil
 	amountToProposerFloat.Quo(amountToProposerFloat, big.NewFloat(100))
 	amountToProposer, _ := amountToProposerFloat.Int(nil)
 	amountToDAO := feesAndRewardsCollected.Sub(feesAndRewardsCollected, amountToProposer)
-	if err := u.addAccountAmount(proposer, amountToProposer); err != nil {
+	if err := u.distributeProposerRewards(proposer, amountToProposer); err != nil {
 		return err
 	}
 	if err := u.addPoolAmount(coreTypes.Pools_POOLS_DAO.FriendlyName(), amountToDAO); err != nil {
@@ -77,6 +124,60 @@ func (u *baseUtilityUnitOfWork) handleProposerRewards(proposer []byte) typesUtil
 	return nil
 }
 
+// handleRelayRewards mints `RelaysToTokensMultiplier` tokens per relay proven in the block, and splits them between
+// the output address of the servicer and the DAO according to `DAOPercentageOfRelayRewards`
+func (u *baseUtilityUnitOfWork) handleRelayRewards() typesUtil.Error {
+	if len(u.provenRelays) == 0 {
+		return nil
+	}
+	multiplier, err := u.getRelaysToTokensMultiplier()
+	if err != nil {
+		return err
+	}
+	daoPercentage, err := u.getDAOPercentageOfRelayRewards()
+	if err != nil {
+		return err
+	}
+	if daoPercentage < 0 || daoPercentage > 100 {
+		return typesUtil.ErrInvalidDAOPercentageOfRelayRewards()
+	}
+
+	// iterate in a deterministic order so every node applies the same state transitions
+	outputAddrs := make([]string, 0, len(u.provenRelays))
+	for outputAddr := range u.provenRelays {
+		outputAddrs = append(outputAddrs, outputAddr)
+	}
+	sort.Strings(outputAddrs)
+
+	for _, outputAddr := range outputAddrs {
+		outputAddrBz, er := hex.DecodeString(outputAddr)
+		if er != nil {
+			return typesUtil.ErrHexDecodeFromString(er)
+		}
+		reward := new(big.Int).SetUint64(u.provenRelays[outputAddr])
+		reward.Mul(reward, big.NewInt(multiplier))
+		amountToDAO := new(big.Int).Mul(reward, big.NewInt(int64(daoPercentage)))
+		amountToDAO.Quo(amountToDAO, big.NewInt(100))
+		amountToServicer := reward.Sub(reward, amountToDAO)
+		if err := u.addAccountAmount(outputAddrBz, amountToServicer); err != nil {
+			return err
+		}
+		if err := u.addPoolAmount(coreTypes.Pools_POOLS_DAO.FriendlyName(), amountToDAO); err != nil {
+			return err
+		}
+	}
+	u.provenRelays = nil
+	return nil
+}
+
+// addProvenRelays records relays proven in the block, to be rewarded to `outputAddr` at the end of the block
+func (u *baseUtilityUnitOfWork) addProvenRelays(outputAddr string, numRelays uint64) {
+	if u.provenRelays == nil {
+		u.provenRelays = make(map[string]uint64)
+	}
+	u.provenRelays[outputAddr] += numRelays
+}
+
 func (u *baseUtilityUnitOfWork) unbondUnstakingActors() (err typesUtil.Error) {
 	for actorTypeNum := range coreTypes.ActorType_name {
 		if actorTypeNum == 0 { // ACTOR_TYPE_UNSPECIFIED
@@ -181,38 +282,163 @@ func (u *baseUtilityUnitOfWork) beginUnstakingActorsPausedBefore(pausedBeforeHei
 	return nil
 }
 
-// TODO: Need to design & document this business logic.
+// expireClaims removes the claims that were not proven within `ClaimExpirationBlocks` of being submitted, so their
+// relays can no longer be proven nor rewarded
+func (u *baseUtilityUnitOfWork) expireClaims() typesUtil.Error {
+	expirationBlocks, err := u.getClaimExpirationBlocks()
+	if err != nil {
+		return err
+	}
+	claims, er := u.persistenceReadContext.GetAllClaims(u.height)
+	if er != nil {
+		return typesUtil.ErrGetClaim(er)
+	}
+	for _, claim := range claims {
+		if claim.GetHeight()+expirationBlocks > u.height {
+			continue
+		}
+		servicerAddr, er := hex.DecodeString(claim.GetServicerAddress())
+		if er != nil {
+			return typesUtil.ErrHexDecodeFromString(er)
+		}
+		sessionId, er := hex.DecodeString(claim.GetSessionId())
+		if er != nil {
+			return typesUtil.ErrHexDecodeFromString(er)
+		}
+		if er := u.persistenceRWContext.DeleteClaim(servicerAddr, sessionId); er != nil {
+			return typesUtil.ErrDeleteClaim(er)
+		}
+	}
+	return nil
+}
+
+// expireTestScores removes the test scores, proven or not, that can no longer be proven
+func (u *baseUtilityUnitOfWork) expireTestScores() typesUtil.Error {
+	expirationBlocks, err := u.getTestScoreExpirationBlocks()
+	if err != nil {
+		return err
+	}
+	testScores, er := u.persistenceReadContext.GetAllTestScores(u.height)
+	if er != nil {
+		return typesUtil.ErrGetTestScore(er)
+	}
+	for _, testScore := range testScores {
+		if testScore.GetHeight()+expirationBlocks > u.height {
+			continue
+		}
+		fishermanAddr, er := hex.DecodeString(testScore.GetFishermanAddress())
+		if er != nil {
+			return typesUtil.ErrHexDecodeFromString(er)
+		}
+		servicerAddr, er := hex.DecodeString(testScore.GetServicerAddress())
+		if er != nil {
+			return typesUtil.ErrHexDecodeFromString(er)
+		}
+		sessionId, er := hex.DecodeString(testScore.GetSessionId())
+		if er != nil {
+			return typesUtil.ErrHexDecodeFromString(er)
+		}
+		if er := u.persistenceRWContext.DeleteTestScore(fishermanAddr, servicerAddr, sessionId); er != nil {
+			return typesUtil.ErrDeleteTestScore(er)
+		}
+	}
+	return nil
+}
+
+// prevBlockByzantineValidators returns the addresses of the validators of the previous block whose partial
+// signature is missing from the threshold signature of its quorum certificate
 func (u *baseUtilityUnitOfWork) prevBlockByzantineValidators() ([][]byte, error) {
-	return nil, nil
+	// the genesis block does not have a previous block
+	if u.height == 0 {
+		return nil, nil
+	}
+	prevHeight := u.height - 1
+
+	blockBz, err := u.GetBus().GetPersistenceModule().GetBlockStore().Get(utils.HeightToBytes(uint64(prevHeight)))
+	if err != nil {
+		return nil, typesUtil.ErrGetBlock(err)
+	}
+	block := &coreTypes.Block{}
+	if err := codec.GetCodec().Unmarshal(blockBz, block); err != nil {
+		return nil, typesUtil.ErrProtoUnmarshal(err)
+	}
+
+	qcBz := block.GetBlockHeader().GetQuorumCertificate()
+	if len(qcBz) == 0 {
+		return nil, nil
+	}
+	qc := &typesCons.QuorumCertificate{}
+	if err := codec.GetCodec().Unmarshal(qcBz, qc); err != nil {
+		return nil, typesUtil.ErrInvalidQuorumCertificate(err)
+	}
+
+	signers := make(map[string]struct{})
+	for _, partialSig := range qc.GetThresholdSignature().GetSignatures() {
+		signers[partialSig.GetAddress()] = struct{}{}
+	}
+
+	validators, err := u.persistenceReadContext.GetAllValidators(prevHeight)
+	if err != nil {
+		return nil, typesUtil.ErrGetAllValidators(err)
+	}
+	byzantineValidators := make([][]byte, 0)
+	for _, validator := range validators {
+		if _, ok := signers[validator.GetAddress()]; ok {
+			continue
+		}
+		// paused validators are not expected to sign and have already been penalized for missing blocks
+		if validator.GetPausedHeight() != typesUtil.HeightNotUsed {
+			continue
+		}
+		addr, err := hex.DecodeString(validator.GetAddress())
+		if err != nil {
+			return nil, typesUtil.ErrHexDecodeFromString(err)
+		}
+		byzantineValidators = append(byzantineValidators, addr)
+	}
+	return byzantineValidators, nil
+}
+
+// savePoint captures the state that a unit of work can be reverted to: the save point of the persistence context,
+// identified by `key`, along with the state the unit of work accumulates in memory
+type savePoint struct {
+	key          []byte
+	provenRelays map[string]uint64
+}
+
+// newSavePoint pushes a new save point, identified by `key`, to the stack of save points of the unit of work
+func (u *baseUtilityUnitOfWork) newSavePoint(key []byte) typesUtil.Error {
+	if err := u.persistenceRWContext.NewSavePoint(key); err != nil {
+		return typesUtil.ErrNewSavePoint(err)
+	}
+	provenRelays := make(map[string]uint64, len(u.provenRelays))
+	for outputAddr, numRelays := range u.provenRelays {
+		provenRelays[outputAddr] = numRelays
+	}
+	u.savePoints = append(u.savePoints, &savePoint{key: key, provenRelays: provenRelays})
+	return nil
 }
 
-// TODO: This has not been tested or investigated in detail
+// revertLastSavePoint pops the most recent save point and discards all the changes made since it was created
 func (u *baseUtilityUnitOfWork) revertLastSavePoint() typesUtil.Error {
-	// TODO(@deblasis): Implement this
-	// if len(u.savePointsSet) == 0 {
-	// 	return typesUtil.ErrEmptySavePoints()
-	// }
-	// var key []byte
-	// popIndex := len(u.savePointsList) - 1
-	// key, u.savePointsList = u.savePointsList[popIndex], u.savePointsList[:popIndex]
-	// delete(u.savePointsSet, hex.EncodeToString(key))
-	// if err := u.store.RollbackToSavePoint(key); err != nil {
-	// 	return typesUtil.ErrRollbackSavePoint(err)
-	// }
+	if len(u.savePoints) == 0 {
+		return typesUtil.ErrEmptySavePoints()
+	}
+	lastSavePoint := u.savePoints[len(u.savePoints)-1]
+	u.savePoints = u.savePoints[:len(u.savePoints)-1]
+	if err := u.persistenceRWContext.RollbackToSavePoint(lastSavePoint.key); err != nil {
+		return typesUtil.ErrRollbackSavePoint(err)
+	}
+	u.provenRelays = lastSavePoint.provenRelays
 	return nil
 }
 
-//nolint:unused // TODO: This has not been tested or investigated in detail
-func (u *baseUtilityUnitOfWork) newSavePoint(txHashBz []byte) typesUtil.Error {
-	// TODO(@deblasis): Implement this
-	// if err := u.store.NewSavePoint(txHashBz); err != nil {
-	// 	return typesUtil.ErrNewSavePoint(err)
-	// }
-	// txHash := hex.EncodeToString(txHashBz)
-	// if _, exists := u.savePointsSet[txHash]; exists {
-	// 	return typesUtil.ErrDuplicateSavePoint()
-	// }
-	// u.savePointsList = append(u.savePointsList, txHashBz)
-	// u.savePointsSet[txHash] = struct{}{}
+// releaseLastSavePoint pops the most recent save point, keeping all the changes made since it was created.
+// The save point is left in the persistence context, which discards it when it is committed or released.
+func (u *baseUtilityUnitOfWork) releaseLastSavePoint() typesUtil.Error {
+	if len(u.savePoints) == 0 {
+		return typesUtil.ErrEmptySavePoints()
+	}
+	u.savePoints = u.savePoints[:len(u.savePoints)-1]
 	return nil
 }
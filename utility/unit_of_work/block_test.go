@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/shared/codec"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"github.com/pokt-network/pocket/shared/modules"
 	mockModules "github.com/pokt-network/pocket/shared/modules/mocks"
@@ -99,6 +101,41 @@ func TestUtilityUnitOfWork_BeginBlock(t *testing.T) {
 
 }
 
+func TestUtilityUnitOfWork_PrevBlockByzantineValidators(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 0)
+
+	// there is no previous block at genesis
+	byzantineValidators, err := uow.prevBlockByzantineValidators()
+	require.NoError(t, err)
+	require.Empty(t, byzantineValidators)
+
+	// every validator but the first one signs the block
+	validators := getAllTestingActors(t, uow, coreTypes.ActorType_ACTOR_TYPE_VAL)
+	require.Greater(t, len(validators), 1)
+	signatures := make([]*typesCons.PartialSignature, 0, len(validators)-1)
+	for _, validator := range validators[1:] {
+		signatures = append(signatures, &typesCons.PartialSignature{
+			Signature: []byte("signature"),
+			Address:   validator.GetAddress(),
+		})
+	}
+	qcBz, err := codec.GetCodec().Marshal(&typesCons.QuorumCertificate{
+		ThresholdSignature: &typesCons.ThresholdSignature{Signatures: signatures},
+	})
+	require.NoError(t, err)
+
+	proposer, err := hex.DecodeString(validators[1].GetAddress())
+	require.NoError(t, err)
+	require.NoError(t, uow.persistenceRWContext.Commit(proposer, qcBz))
+	require.NoError(t, testPersistenceMod.ReleaseWriteContext())
+
+	uow = newTestingUtilityUnitOfWork(t, 1)
+	byzantineValidators, err = uow.prevBlockByzantineValidators()
+	require.NoError(t, err)
+	require.Len(t, byzantineValidators, 1)
+	require.Equal(t, validators[0].GetAddress(), hex.EncodeToString(byzantineValidators[0]))
+}
+
 func TestUtilityUnitOfWork_EndBlock(t *testing.T) {
 	uow := newTestingUtilityUnitOfWork(t, 0)
 	tx, _, _, _ := newTestingTransaction(t, uow)
@@ -137,3 +174,45 @@ func TestUtilityUnitOfWork_EndBlock(t *testing.T) {
 	require.Equal(t, expectedProposerBalanceDifference, proposerBalanceDifference)
 
 }
+
+func TestUtilityUnitOfWork_HandleRelayRewards(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 0)
+
+	servicer := getFirstActor(t, uow, coreTypes.ActorType_ACTOR_TYPE_SERVICER)
+	outputAddrBz, er := hex.DecodeString(servicer.GetOutput())
+	require.NoError(t, er)
+
+	daoPoolName := coreTypes.Pools_POOLS_DAO.FriendlyName()
+	outputBeforeBalance, err := uow.getAccountAmount(outputAddrBz)
+	require.NoError(t, err)
+	daoBeforeBalance, err := uow.getPoolAmount(daoPoolName)
+	require.NoError(t, err)
+
+	// relays proven in different transactions of the block are rewarded together
+	uow.addProvenRelays(servicer.GetOutput(), 7)
+	uow.addProvenRelays(servicer.GetOutput(), 3)
+	require.NoError(t, uow.handleRelayRewards())
+
+	multiplier, err := uow.getRelaysToTokensMultiplier()
+	require.NoError(t, err)
+	daoPercentage, err := uow.getDAOPercentageOfRelayRewards()
+	require.NoError(t, err)
+
+	expectedReward := big.NewInt(10 * multiplier)
+	expectedDAOReward := big.NewInt(10 * multiplier * int64(daoPercentage) / 100)
+	expectedServicerReward := new(big.Int).Sub(expectedReward, expectedDAOReward)
+
+	outputAfterBalance, err := uow.getAccountAmount(outputAddrBz)
+	require.NoError(t, err)
+	require.Equal(t, expectedServicerReward, new(big.Int).Sub(outputAfterBalance, outputBeforeBalance))
+
+	daoAfterBalance, err := uow.getPoolAmount(daoPoolName)
+	require.NoError(t, err)
+	require.Equal(t, expectedDAOReward, new(big.Int).Sub(daoAfterBalance, daoBeforeBalance))
+
+	// the proven relays are only rewarded once
+	require.NoError(t, uow.handleRelayRewards())
+	outputFinalBalance, err := uow.getAccountAmount(outputAddrBz)
+	require.NoError(t, err)
+	require.Equal(t, outputAfterBalance, outputFinalBalance)
+}
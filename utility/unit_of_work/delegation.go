@@ -0,0 +1,135 @@
+package unit_of_work
+
+// Internal business logic for the stake delegated by token holders to validators
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/utils"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+// getDelegation returns the delegation of `delegatorAddr` to `validatorAddr`, or nil if it does not exist
+// NB: the RW context is used so delegations created or updated earlier in the same block are taken into account
+func (u *baseUtilityUnitOfWork) getDelegation(delegatorAddr, validatorAddr []byte) (*coreTypes.Delegation, typesUtil.Error) {
+	delegation, err := u.persistenceRWContext.GetDelegation(delegatorAddr, validatorAddr, u.height)
+	if err != nil {
+		return nil, typesUtil.ErrGetDelegation(err)
+	}
+	return delegation, nil
+}
+
+func (u *baseUtilityUnitOfWork) setDelegation(delegation *coreTypes.Delegation) typesUtil.Error {
+	if err := u.persistenceRWContext.SetDelegation(delegation); err != nil {
+		return typesUtil.ErrSetDelegation(err)
+	}
+	return nil
+}
+
+// unbondUndelegatedStake returns the delegated tokens whose unbonding period ends at the current height from the
+// validator stake pool to their delegators, and removes the corresponding delegations
+func (u *baseUtilityUnitOfWork) unbondUndelegatedStake() typesUtil.Error {
+	readyToUnbond, er := u.persistenceRWContext.GetDelegationsReadyToUnbond(u.height)
+	if er != nil {
+		return typesUtil.ErrGetDelegation(er)
+	}
+	for _, delegation := range readyToUnbond {
+		amount, er := utils.StringToBigInt(delegation.GetAmount())
+		if er != nil {
+			return typesUtil.ErrStringToBigInt(er)
+		}
+		delegatorAddr, er := hex.DecodeString(delegation.GetDelegatorAddress())
+		if er != nil {
+			return typesUtil.ErrHexDecodeFromString(er)
+		}
+		validatorAddr, er := hex.DecodeString(delegation.GetValidatorAddress())
+		if er != nil {
+			return typesUtil.ErrHexDecodeFromString(er)
+		}
+		if err := u.subPoolAmount(coreTypes.Pools_POOLS_VALIDATOR_STAKE.FriendlyName(), amount); err != nil {
+			return err
+		}
+		if err := u.addAccountAmount(delegatorAddr, amount); err != nil {
+			return err
+		}
+		if er := u.persistenceRWContext.DeleteDelegation(delegatorAddr, validatorAddr); er != nil {
+			return typesUtil.ErrDeleteDelegation(er)
+		}
+	}
+	return nil
+}
+
+// distributeProposerRewards splits `rewards` between the proposer and the delegators of the validator, pro rata to
+// the stake of the validator and to the amount of each delegation that is not unbonding. The delegators' shares are
+// rounded down, and the remainder goes to the proposer.
+func (u *baseUtilityUnitOfWork) distributeProposerRewards(proposer []byte, rewards *big.Int) typesUtil.Error {
+	delegations, er := u.persistenceRWContext.GetValidatorDelegations(proposer, u.height)
+	if er != nil {
+		return typesUtil.ErrGetDelegation(er)
+	}
+
+	delegatorAddrs := make([][]byte, 0, len(delegations))
+	delegatedAmounts := make([]*big.Int, 0, len(delegations))
+	totalDelegated := big.NewInt(0)
+	for _, delegation := range delegations {
+		if delegation.GetUnstakingHeight() != typesUtil.HeightNotUsed {
+			continue
+		}
+		delegatorAddr, er := hex.DecodeString(delegation.GetDelegatorAddress())
+		if er != nil {
+			return typesUtil.ErrHexDecodeFromString(er)
+		}
+		amount, er := utils.StringToBigInt(delegation.GetAmount())
+		if er != nil {
+			return typesUtil.ErrStringToBigInt(er)
+		}
+		delegatorAddrs = append(delegatorAddrs, delegatorAddr)
+		delegatedAmounts = append(delegatedAmounts, amount)
+		totalDelegated.Add(totalDelegated, amount)
+	}
+	if len(delegatorAddrs) == 0 {
+		return u.addAccountAmount(proposer, rewards)
+	}
+
+	validatorStake, err := u.getActorStakeAmount(coreTypes.ActorType_ACTOR_TYPE_VAL, proposer)
+	if err != nil {
+		return err
+	}
+	totalStake := new(big.Int).Add(validatorStake, totalDelegated)
+
+	amountToProposer := new(big.Int).Set(rewards)
+	for i, delegatorAddr := range delegatorAddrs {
+		amountToDelegator := new(big.Int).Mul(rewards, delegatedAmounts[i])
+		amountToDelegator.Quo(amountToDelegator, totalStake)
+		if err := u.addAccountAmount(delegatorAddr, amountToDelegator); err != nil {
+			return err
+		}
+		amountToProposer.Sub(amountToProposer, amountToDelegator)
+	}
+	return u.addAccountAmount(proposer, amountToProposer)
+}
+
+// moveValidatorDelegations moves the delegations to the validator `oldAddr`, including the ones unbonding, to the
+// validator `newAddr`
+func (u *baseUtilityUnitOfWork) moveValidatorDelegations(oldAddr, newAddr []byte) typesUtil.Error {
+	delegations, er := u.persistenceRWContext.GetValidatorDelegations(oldAddr, u.height)
+	if er != nil {
+		return typesUtil.ErrGetDelegation(er)
+	}
+	for _, delegation := range delegations {
+		delegatorAddr, er := hex.DecodeString(delegation.GetDelegatorAddress())
+		if er != nil {
+			return typesUtil.ErrHexDecodeFromString(er)
+		}
+		if er := u.persistenceRWContext.DeleteDelegation(delegatorAddr, oldAddr); er != nil {
+			return typesUtil.ErrDeleteDelegation(er)
+		}
+		delegation.ValidatorAddress = hex.EncodeToString(newAddr)
+		if err := u.setDelegation(delegation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package unit_of_work
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/utils"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUtilityUnitOfWork_HandleMessageDelegate(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+
+	validatorAddr := getFirstValidatorAddr(t, uow)
+	delegatorAddr := newTestingDelegator(t, uow, big.NewInt(1000))
+	poolName := coreTypes.Pools_POOLS_VALIDATOR_STAKE.FriendlyName()
+	poolBeforeBalance, err := uow.getPoolAmount(poolName)
+	require.NoError(t, err)
+
+	msg := &typesUtil.MessageDelegate{
+		DelegatorAddress: delegatorAddr,
+		ValidatorAddress: validatorAddr,
+		Amount:           "400",
+		Signer:           delegatorAddr,
+	}
+	require.NoError(t, uow.handleMessageDelegate(msg))
+	// delegating again adds to the existing delegation
+	require.NoError(t, uow.handleMessageDelegate(msg))
+
+	delegation, err := uow.getDelegation(delegatorAddr, validatorAddr)
+	require.NoError(t, err)
+	require.Equal(t, "800", delegation.GetAmount())
+	require.Equal(t, typesUtil.HeightNotUsed, delegation.GetUnstakingHeight())
+
+	delegatorBalance, err := uow.getAccountAmount(delegatorAddr)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(200), delegatorBalance)
+	poolAfterBalance, err := uow.getPoolAmount(poolName)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(800), new(big.Int).Sub(poolAfterBalance, poolBeforeBalance))
+
+	// the delegator cannot delegate more than it owns
+	err = uow.handleMessageDelegate(msg)
+	require.Equal(t, typesUtil.CodeInsufficientAmountError, err.Code())
+
+	// tokens can only be delegated to validators
+	msg.ValidatorAddress = delegatorAddr
+	msg.Amount = "100"
+	require.Error(t, uow.handleMessageDelegate(msg))
+}
+
+func TestUtilityUnitOfWork_HandleMessageUndelegate(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+	// the delegation is unbonded in the same block it is undelegated
+	require.NoError(t, uow.persistenceRWContext.SetParam(typesUtil.ValidatorUnstakingBlocksParamName, 0))
+
+	validatorAddr := getFirstValidatorAddr(t, uow)
+	delegatorAddr := newTestingDelegator(t, uow, big.NewInt(1000))
+
+	msgUndelegate := &typesUtil.MessageUndelegate{
+		DelegatorAddress: delegatorAddr,
+		ValidatorAddress: validatorAddr,
+		Signer:           delegatorAddr,
+	}
+	err := uow.handleMessageUndelegate(msgUndelegate)
+	require.Equal(t, typesUtil.CodeDelegationNotFoundError, err.Code())
+
+	msgDelegate := &typesUtil.MessageDelegate{
+		DelegatorAddress: delegatorAddr,
+		ValidatorAddress: validatorAddr,
+		Amount:           "400",
+		Signer:           delegatorAddr,
+	}
+	require.NoError(t, uow.handleMessageDelegate(msgDelegate))
+	require.NoError(t, uow.handleMessageUndelegate(msgUndelegate))
+
+	delegation, err := uow.getDelegation(delegatorAddr, validatorAddr)
+	require.NoError(t, err)
+	require.Equal(t, uow.height, delegation.GetUnstakingHeight())
+
+	// an unbonding delegation can neither be undelegated again nor topped up
+	err = uow.handleMessageUndelegate(msgUndelegate)
+	require.Equal(t, typesUtil.CodeAlreadyUndelegatingError, err.Code())
+	err = uow.handleMessageDelegate(msgDelegate)
+	require.Equal(t, typesUtil.CodeAlreadyUndelegatingError, err.Code())
+
+	// the delegated tokens are returned to the delegator once unbonded
+	require.NoError(t, uow.unbondUndelegatedStake())
+	delegatorBalance, err := uow.getAccountAmount(delegatorAddr)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1000), delegatorBalance)
+
+	delegation, err = uow.getDelegation(delegatorAddr, validatorAddr)
+	require.NoError(t, err)
+	require.Nil(t, delegation)
+}
+
+func TestUtilityUnitOfWork_MoveValidatorDelegations(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+
+	validatorAddr := getFirstValidatorAddr(t, uow)
+	delegatorAddr := newTestingDelegator(t, uow, big.NewInt(1000))
+	require.NoError(t, uow.handleMessageDelegate(&typesUtil.MessageDelegate{
+		DelegatorAddress: delegatorAddr,
+		ValidatorAddress: validatorAddr,
+		Amount:           "400",
+		Signer:           delegatorAddr,
+	}))
+
+	newPrivateKey, err := crypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	require.NoError(t, uow.handleMessageRotateOperatorKey(&typesUtil.MessageRotateOperatorKey{
+		ActorType:    coreTypes.ActorType_ACTOR_TYPE_VAL,
+		Address:      validatorAddr,
+		NewPublicKey: newPrivateKey.PublicKey().Bytes(),
+	}))
+
+	// the delegation follows the validator to its new operator
+	delegation, err := uow.getDelegation(delegatorAddr, validatorAddr)
+	require.NoError(t, err)
+	require.Nil(t, delegation)
+	delegation, err = uow.getDelegation(delegatorAddr, newPrivateKey.Address())
+	require.NoError(t, err)
+	require.Equal(t, "400", delegation.GetAmount())
+	require.Equal(t, typesUtil.HeightNotUsed, delegation.GetUnstakingHeight())
+}
+
+func TestUtilityUnitOfWork_DistributeProposerRewards(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+
+	validatorAddr := getFirstValidatorAddr(t, uow)
+	validatorStake, err := uow.getActorStakeAmount(coreTypes.ActorType_ACTOR_TYPE_VAL, validatorAddr)
+	require.NoError(t, err)
+
+	// the delegations are equal to the stake of the validator, and to half of it
+	delegatedAmount := new(big.Int).Set(validatorStake)
+	halfDelegatedAmount := new(big.Int).Quo(validatorStake, big.NewInt(2))
+	delegatorAddr := newTestingDelegator(t, uow, delegatedAmount)
+	otherDelegatorAddr := newTestingDelegator(t, uow, halfDelegatedAmount)
+	unbondingDelegatorAddr := newTestingDelegator(t, uow, delegatedAmount)
+	for _, delegation := range []struct {
+		addr   []byte
+		amount *big.Int
+	}{
+		{delegatorAddr, delegatedAmount},
+		{otherDelegatorAddr, halfDelegatedAmount},
+		{unbondingDelegatorAddr, delegatedAmount},
+	} {
+		require.NoError(t, uow.handleMessageDelegate(&typesUtil.MessageDelegate{
+			DelegatorAddress: delegation.addr,
+			ValidatorAddress: validatorAddr,
+			Amount:           utils.BigIntToString(delegation.amount),
+			Signer:           delegation.addr,
+		}))
+	}
+	// unbonding delegations do not earn rewards
+	require.NoError(t, uow.handleMessageUndelegate(&typesUtil.MessageUndelegate{
+		DelegatorAddress: unbondingDelegatorAddr,
+		ValidatorAddress: validatorAddr,
+		Signer:           unbondingDelegatorAddr,
+	}))
+
+	validatorBeforeBalance, err := uow.getAccountAmount(validatorAddr)
+	require.NoError(t, err)
+
+	rewards := big.NewInt(1001)
+	require.NoError(t, uow.distributeProposerRewards(validatorAddr, rewards))
+
+	totalStake := new(big.Int).Add(validatorStake, delegatedAmount)
+	totalStake.Add(totalStake, halfDelegatedAmount)
+	expectedDelegatorReward := new(big.Int).Quo(new(big.Int).Mul(rewards, delegatedAmount), totalStake)
+	expectedOtherDelegatorReward := new(big.Int).Quo(new(big.Int).Mul(rewards, halfDelegatedAmount), totalStake)
+	expectedValidatorReward := new(big.Int).Sub(rewards, expectedDelegatorReward)
+	expectedValidatorReward.Sub(expectedValidatorReward, expectedOtherDelegatorReward)
+
+	for _, tc := range []struct {
+		addr           []byte
+		expectedAmount *big.Int
+	}{
+		{delegatorAddr, expectedDelegatorReward},
+		{otherDelegatorAddr, expectedOtherDelegatorReward},
+		{unbondingDelegatorAddr, big.NewInt(0)},
+	} {
+		balance, err := uow.getAccountAmount(tc.addr)
+		require.NoError(t, err)
+		require.Equal(t, tc.expectedAmount, balance)
+	}
+	validatorAfterBalance, err := uow.getAccountAmount(validatorAddr)
+	require.NoError(t, err)
+	require.Equal(t, expectedValidatorReward, new(big.Int).Sub(validatorAfterBalance, validatorBeforeBalance))
+}
+
+func getFirstValidatorAddr(t *testing.T, uow *baseUtilityUnitOfWork) []byte {
+	t.Helper()
+	validator := getFirstActor(t, uow, coreTypes.ActorType_ACTOR_TYPE_VAL)
+	validatorAddr, err := hex.DecodeString(validator.GetAddress())
+	require.NoError(t, err)
+	return validatorAddr
+}
+
+// newTestingDelegator returns the address of a new account funded with `amount`
+func newTestingDelegator(t *testing.T, uow *baseUtilityUnitOfWork, amount *big.Int) []byte {
+	t.Helper()
+	delegatorAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	require.NoError(t, uow.setAccountAmount(delegatorAddr, amount))
+	return delegatorAddr
+}
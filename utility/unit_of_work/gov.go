@@ -2,6 +2,7 @@ package unit_of_work
 
 import (
 	"math/big"
+	"strings"
 
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"github.com/pokt-network/pocket/shared/utils"
@@ -10,6 +11,9 @@ import (
 )
 
 func (u *baseUtilityUnitOfWork) updateParam(paramName string, value any) typesUtil.Error {
+	if err := validateParamValue(paramName, value); err != nil {
+		return err
+	}
 	switch t := value.(type) {
 	case *wrapperspb.Int32Value:
 		if err := u.persistenceRWContext.SetParam(paramName, (int(t.Value))); err != nil {
@@ -33,10 +37,41 @@ func (u *baseUtilityUnitOfWork) updateParam(paramName string, value any) typesUt
 	return typesUtil.ErrUnknownParam(paramName)
 }
 
+// validateParamValue rejects the values of the parameters that would break the chain if they were applied
+func validateParamValue(paramName string, value any) typesUtil.Error {
+	switch t := value.(type) {
+	case *wrapperspb.Int32Value:
+		// a proposal ending in the block it is submitted in could be applied before any validator had a chance to vote
+		if paramName == typesUtil.GovernanceVotingPeriodBlocksParamName && t.Value <= 0 {
+			return typesUtil.ErrNonPositiveParam(paramName, t.Value)
+		}
+	case *wrapperspb.StringValue:
+		// a malformed relay chain registry would prevent any actor from staking
+		if paramName == typesUtil.RelayChainsParamName {
+			if _, err := typesUtil.ParseRelayChains(t.Value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (u *baseUtilityUnitOfWork) getParameter(paramName string) (any, error) {
 	return u.persistenceReadContext.GetParameter(paramName, u.height)
 }
 
+func (u *baseUtilityUnitOfWork) getMaxTransactionExpiryBlocks() (int64, typesUtil.Error) {
+	return u.getInt64Param(typesUtil.MaxTransactionExpiryBlocksParamName)
+}
+
+func (u *baseUtilityUnitOfWork) getGovernanceVotingPeriodBlocks() (int64, typesUtil.Error) {
+	return u.getInt64Param(typesUtil.GovernanceVotingPeriodBlocksParamName)
+}
+
+func (u *baseUtilityUnitOfWork) getGovernanceQuorumPercentage() (int, typesUtil.Error) {
+	return u.getIntParam(typesUtil.GovernanceQuorumPercentageParamName)
+}
+
 func (u *baseUtilityUnitOfWork) getAppMinimumStake() (*big.Int, typesUtil.Error) {
 	return u.getBigIntParam(typesUtil.AppMinimumStakeParamName)
 }
@@ -113,6 +148,10 @@ func (u *baseUtilityUnitOfWork) getDoubleSignBurnPercentage() (burnPercentage in
 	return u.getIntParam(typesUtil.DoubleSignBurnPercentageParamName)
 }
 
+func (u *baseUtilityUnitOfWork) getDoubleSignReporterRewardPercentage() (rewardPercentage int, err typesUtil.Error) {
+	return u.getIntParam(typesUtil.DoubleSignReporterRewardPercentageParamName)
+}
+
 func (u *baseUtilityUnitOfWork) getMissedBlocksBurnPercentage() (burnPercentage int, err typesUtil.Error) {
 	return u.getIntParam(typesUtil.MissedBlocksBurnPercentageParamName)
 }
@@ -141,6 +180,30 @@ func (u *baseUtilityUnitOfWork) getMessageDoubleSignFee() (*big.Int, typesUtil.E
 	return u.getBigIntParam(typesUtil.MessageDoubleSignFee)
 }
 
+func (u *baseUtilityUnitOfWork) getMessageDelegateFee() (*big.Int, typesUtil.Error) {
+	return u.getBigIntParam(typesUtil.MessageDelegateFee)
+}
+
+func (u *baseUtilityUnitOfWork) getMessageUndelegateFee() (*big.Int, typesUtil.Error) {
+	return u.getBigIntParam(typesUtil.MessageUndelegateFee)
+}
+
+func (u *baseUtilityUnitOfWork) getMessageSubmitProposalFee() (*big.Int, typesUtil.Error) {
+	return u.getBigIntParam(typesUtil.MessageSubmitProposalFee)
+}
+
+func (u *baseUtilityUnitOfWork) getMessageVoteFee() (*big.Int, typesUtil.Error) {
+	return u.getBigIntParam(typesUtil.MessageVoteFee)
+}
+
+func (u *baseUtilityUnitOfWork) getMessageScheduleUpgradeFee() (*big.Int, typesUtil.Error) {
+	return u.getBigIntParam(typesUtil.MessageScheduleUpgradeFee)
+}
+
+func (u *baseUtilityUnitOfWork) getMessageRotateOperatorKeyFee() (*big.Int, typesUtil.Error) {
+	return u.getBigIntParam(typesUtil.MessageRotateOperatorKeyFee)
+}
+
 func (u *baseUtilityUnitOfWork) getMessageSendFee() (*big.Int, typesUtil.Error) {
 	return u.getBigIntParam(typesUtil.MessageSendFee)
 }
@@ -241,6 +304,63 @@ func (u *baseUtilityUnitOfWork) getMessageChangeParameterFee() (*big.Int, typesU
 	return u.getBigIntParam(typesUtil.MessageChangeParameterFee)
 }
 
+func (u *baseUtilityUnitOfWork) getMessageClaimFee() (*big.Int, typesUtil.Error) {
+	return u.getBigIntParam(typesUtil.MessageClaimFee)
+}
+
+func (u *baseUtilityUnitOfWork) getMessageProofFee() (*big.Int, typesUtil.Error) {
+	return u.getBigIntParam(typesUtil.MessageProofFee)
+}
+
+// getBlocksPerSessionAt returns the number of blocks per session in effect at `height`, such as the start of a past session
+func (u *baseUtilityUnitOfWork) getBlocksPerSessionAt(height int64) (int64, typesUtil.Error) {
+	blocksPerSession, err := u.persistenceReadContext.GetIntParam(typesUtil.BlocksPerSessionParamName, height)
+	if err != nil {
+		return 0, typesUtil.ErrGetParam(typesUtil.BlocksPerSessionParamName, err)
+	}
+	if blocksPerSession <= 0 {
+		return 0, typesUtil.ErrInvalidBlocksPerSession(blocksPerSession)
+	}
+	return int64(blocksPerSession), nil
+}
+
+// getAllowedGeoZones returns the geo zones actors can stake in
+func (u *baseUtilityUnitOfWork) getAllowedGeoZones() ([]string, typesUtil.Error) {
+	allowedGeoZones, err := u.persistenceReadContext.GetStringParam(typesUtil.AllowedGeoZonesParamName, u.height)
+	if err != nil {
+		return nil, typesUtil.ErrGetParam(typesUtil.AllowedGeoZonesParamName, err)
+	}
+	if allowedGeoZones == "" {
+		return nil, nil
+	}
+	return strings.Split(allowedGeoZones, typesUtil.GeoZonesSeparator), nil
+}
+
+// getRelayChains returns the registry of the relay chains actors can stake for
+func (u *baseUtilityUnitOfWork) getRelayChains() ([]*typesUtil.RelayChain, typesUtil.Error) {
+	relayChains, err := u.persistenceReadContext.GetStringParam(typesUtil.RelayChainsParamName, u.height)
+	if err != nil {
+		return nil, typesUtil.ErrGetParam(typesUtil.RelayChainsParamName, err)
+	}
+	return typesUtil.ParseRelayChains(relayChains)
+}
+
+func (u *baseUtilityUnitOfWork) getClaimExpirationBlocks() (int64, typesUtil.Error) {
+	return u.getInt64Param(typesUtil.ClaimExpirationBlocksParamName)
+}
+
+func (u *baseUtilityUnitOfWork) getTestScoreExpirationBlocks() (int64, typesUtil.Error) {
+	return u.getInt64Param(typesUtil.TestScoreExpirationBlocksParamName)
+}
+
+func (u *baseUtilityUnitOfWork) getRelaysToTokensMultiplier() (multiplier int64, err typesUtil.Error) {
+	return u.getInt64Param(typesUtil.RelaysToTokensMultiplierParamName)
+}
+
+func (u *baseUtilityUnitOfWork) getDAOPercentageOfRelayRewards() (daoPercentage int, err typesUtil.Error) {
+	return u.getIntParam(typesUtil.DAOPercentageOfRelayRewardsParamName)
+}
+
 func (u *baseUtilityUnitOfWork) getDoubleSignFeeOwner() (owner []byte, err typesUtil.Error) {
 	return u.getByteArrayParam(typesUtil.MessageDoubleSignFeeOwner)
 }
@@ -254,6 +374,16 @@ func (u *baseUtilityUnitOfWork) getParamOwner(paramName string) ([]byte, error)
 		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
 	case typesUtil.BlocksPerSessionParamName:
 		return u.persistenceReadContext.GetBytesParam(typesUtil.BlocksPerSessionOwner, u.height)
+	case typesUtil.AllowedGeoZonesParamName:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.AllowedGeoZonesOwner, u.height)
+	case typesUtil.RelayChainsParamName:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.RelayChainsOwner, u.height)
+	case typesUtil.MaxTransactionExpiryBlocksParamName:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.MaxTransactionExpiryBlocksOwner, u.height)
+	case typesUtil.GovernanceVotingPeriodBlocksParamName:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.GovernanceVotingPeriodBlocksOwner, u.height)
+	case typesUtil.GovernanceQuorumPercentageParamName:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.GovernanceQuorumPercentageOwner, u.height)
 	case typesUtil.AppMaxChainsParamName:
 		return u.persistenceReadContext.GetBytesParam(typesUtil.AppMaxChainsOwner, u.height)
 	case typesUtil.AppMinimumStakeParamName:
@@ -268,6 +398,14 @@ func (u *baseUtilityUnitOfWork) getParamOwner(paramName string) ([]byte, error)
 		return u.persistenceReadContext.GetBytesParam(typesUtil.AppMaxPausedBlocksOwner, u.height)
 	case typesUtil.ServicersPerSessionParamName:
 		return u.persistenceReadContext.GetBytesParam(typesUtil.ServicersPerSessionOwner, u.height)
+	case typesUtil.ClaimExpirationBlocksParamName:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.ClaimExpirationBlocksOwner, u.height)
+	case typesUtil.TestScoreExpirationBlocksParamName:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.TestScoreExpirationBlocksOwner, u.height)
+	case typesUtil.RelaysToTokensMultiplierParamName:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.RelaysToTokensMultiplierOwner, u.height)
+	case typesUtil.DAOPercentageOfRelayRewardsParamName:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.DAOPercentageOfRelayRewardsOwner, u.height)
 	case typesUtil.ServicerMinimumStakeParamName:
 		return u.persistenceReadContext.GetBytesParam(typesUtil.ServicerMinimumStakeOwner, u.height)
 	case typesUtil.ServicerMaxChainsParamName:
@@ -288,6 +426,8 @@ func (u *baseUtilityUnitOfWork) getParamOwner(paramName string) ([]byte, error)
 		return u.persistenceReadContext.GetBytesParam(typesUtil.FishermanMinimumPauseBlocksOwner, u.height)
 	case typesUtil.FishermanMaxPauseBlocksParamName:
 		return u.persistenceReadContext.GetBytesParam(typesUtil.FishermanMaxPausedBlocksOwner, u.height)
+	case typesUtil.FishermenPerSessionParamName:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.FishermenPerSessionOwner, u.height)
 	case typesUtil.ValidatorMinimumStakeParamName:
 		return u.persistenceReadContext.GetBytesParam(typesUtil.ValidatorMinimumStakeOwner, u.height)
 	case typesUtil.ValidatorUnstakingBlocksParamName:
@@ -306,8 +446,22 @@ func (u *baseUtilityUnitOfWork) getParamOwner(paramName string) ([]byte, error)
 		return u.persistenceReadContext.GetBytesParam(typesUtil.MissedBlocksBurnPercentageOwner, u.height)
 	case typesUtil.DoubleSignBurnPercentageParamName:
 		return u.persistenceReadContext.GetBytesParam(typesUtil.DoubleSignBurnPercentageOwner, u.height)
+	case typesUtil.DoubleSignReporterRewardPercentageParamName:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.DoubleSignReporterRewardPercentageOwner, u.height)
 	case typesUtil.MessageDoubleSignFee:
 		return u.persistenceReadContext.GetBytesParam(typesUtil.MessageDoubleSignFeeOwner, u.height)
+	case typesUtil.MessageDelegateFee:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.MessageDelegateFeeOwner, u.height)
+	case typesUtil.MessageUndelegateFee:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.MessageUndelegateFeeOwner, u.height)
+	case typesUtil.MessageSubmitProposalFee:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.MessageSubmitProposalFeeOwner, u.height)
+	case typesUtil.MessageVoteFee:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.MessageVoteFeeOwner, u.height)
+	case typesUtil.MessageScheduleUpgradeFee:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.MessageScheduleUpgradeFeeOwner, u.height)
+	case typesUtil.MessageRotateOperatorKeyFee:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.MessageRotateOperatorKeyFeeOwner, u.height)
 	case typesUtil.MessageSendFee:
 		return u.persistenceReadContext.GetBytesParam(typesUtil.MessageSendFeeOwner, u.height)
 	case typesUtil.MessageStakeFishermanFee:
@@ -358,8 +512,22 @@ func (u *baseUtilityUnitOfWork) getParamOwner(paramName string) ([]byte, error)
 		return u.persistenceReadContext.GetBytesParam(typesUtil.MessageUnpauseServicerFeeOwner, u.height)
 	case typesUtil.MessageChangeParameterFee:
 		return u.persistenceReadContext.GetBytesParam(typesUtil.MessageChangeParameterFeeOwner, u.height)
+	case typesUtil.MessageClaimFee:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.MessageClaimFeeOwner, u.height)
+	case typesUtil.MessageProofFee:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.MessageProofFeeOwner, u.height)
 	case typesUtil.BlocksPerSessionOwner:
 		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
+	case typesUtil.AllowedGeoZonesOwner:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
+	case typesUtil.RelayChainsOwner:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
+	case typesUtil.MaxTransactionExpiryBlocksOwner:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
+	case typesUtil.GovernanceVotingPeriodBlocksOwner:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
+	case typesUtil.GovernanceQuorumPercentageOwner:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
 	case typesUtil.AppMaxChainsOwner:
 		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
 	case typesUtil.AppMinimumStakeOwner:
@@ -384,6 +552,14 @@ func (u *baseUtilityUnitOfWork) getParamOwner(paramName string) ([]byte, error)
 		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
 	case typesUtil.ServicersPerSessionOwner:
 		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
+	case typesUtil.ClaimExpirationBlocksOwner:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
+	case typesUtil.TestScoreExpirationBlocksOwner:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
+	case typesUtil.RelaysToTokensMultiplierOwner:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
+	case typesUtil.DAOPercentageOfRelayRewardsOwner:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
 	case typesUtil.FishermanMinimumStakeOwner:
 		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
 	case typesUtil.FishermanMaxChainsOwner:
@@ -394,6 +570,8 @@ func (u *baseUtilityUnitOfWork) getParamOwner(paramName string) ([]byte, error)
 		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
 	case typesUtil.FishermanMaxPausedBlocksOwner:
 		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
+	case typesUtil.FishermenPerSessionOwner:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
 	case typesUtil.ValidatorMinimumStakeOwner:
 		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
 	case typesUtil.ValidatorUnstakingBlocksOwner:
@@ -412,8 +590,22 @@ func (u *baseUtilityUnitOfWork) getParamOwner(paramName string) ([]byte, error)
 		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
 	case typesUtil.DoubleSignBurnPercentageOwner:
 		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
+	case typesUtil.DoubleSignReporterRewardPercentageOwner:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
 	case typesUtil.MessageSendFeeOwner:
 		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
+	case typesUtil.MessageDelegateFeeOwner:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
+	case typesUtil.MessageUndelegateFeeOwner:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
+	case typesUtil.MessageSubmitProposalFeeOwner:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
+	case typesUtil.MessageVoteFeeOwner:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
+	case typesUtil.MessageScheduleUpgradeFeeOwner:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
+	case typesUtil.MessageRotateOperatorKeyFeeOwner:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
 	case typesUtil.MessageStakeFishermanFeeOwner:
 		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
 	case typesUtil.MessageEditStakeFishermanFeeOwner:
@@ -462,6 +654,10 @@ func (u *baseUtilityUnitOfWork) getParamOwner(paramName string) ([]byte, error)
 		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
 	case typesUtil.MessageChangeParameterFeeOwner:
 		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
+	case typesUtil.MessageClaimFeeOwner:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
+	case typesUtil.MessageProofFeeOwner:
+		return u.persistenceReadContext.GetBytesParam(typesUtil.AclOwner, u.height)
 	default:
 		return nil, typesUtil.ErrUnknownParam(paramName)
 	}
@@ -510,6 +706,19 @@ func (u *baseUtilityUnitOfWork) getFee(msg typesUtil.Message, actorType coreType
 		default:
 			return nil, typesUtil.ErrUnknownActorType(actorType.String())
 		}
+	case *typesUtil.MessagePause:
+		switch actorType {
+		case coreTypes.ActorType_ACTOR_TYPE_APP:
+			return u.getMessagePauseAppFee()
+		case coreTypes.ActorType_ACTOR_TYPE_FISH:
+			return u.getMessagePauseFishermanFee()
+		case coreTypes.ActorType_ACTOR_TYPE_SERVICER:
+			return u.getMessagePauseServicerFee()
+		case coreTypes.ActorType_ACTOR_TYPE_VAL:
+			return u.getMessagePauseValidatorFee()
+		default:
+			return nil, typesUtil.ErrUnknownActorType(actorType.String())
+		}
 	case *typesUtil.MessageUnpause:
 		switch actorType {
 		case coreTypes.ActorType_ACTOR_TYPE_APP:
@@ -525,6 +734,30 @@ func (u *baseUtilityUnitOfWork) getFee(msg typesUtil.Message, actorType coreType
 		}
 	case *typesUtil.MessageChangeParameter:
 		return u.getMessageChangeParameterFee()
+	case *typesUtil.MessageClaim:
+		return u.getMessageClaimFee()
+	case *typesUtil.MessageProof:
+		return u.getMessageProofFee()
+	case *typesUtil.MessageTestScore:
+		return u.getMessageTestScoreFee()
+	case *typesUtil.MessageProveTestScore:
+		return u.getMessageProveTestScoreFee()
+	case *typesUtil.MessageFishermanPauseServicer:
+		return u.getMessageFishermanPauseServicerFee()
+	case *typesUtil.MessageDoubleSign:
+		return u.getMessageDoubleSignFee()
+	case *typesUtil.MessageDelegate:
+		return u.getMessageDelegateFee()
+	case *typesUtil.MessageUndelegate:
+		return u.getMessageUndelegateFee()
+	case *typesUtil.MessageSubmitProposal:
+		return u.getMessageSubmitProposalFee()
+	case *typesUtil.MessageVote:
+		return u.getMessageVoteFee()
+	case *typesUtil.MessageScheduleUpgrade:
+		return u.getMessageScheduleUpgradeFee()
+	case *typesUtil.MessageRotateOperatorKey:
+		return u.getMessageRotateOperatorKeyFee()
 	default:
 		return nil, typesUtil.ErrUnknownMessage(x)
 	}
@@ -538,6 +771,14 @@ func (u *baseUtilityUnitOfWork) getMessageChangeParameterSignerCandidates(msg *t
 	return [][]byte{owner}, nil
 }
 
+func (u *baseUtilityUnitOfWork) getMessageScheduleUpgradeSignerCandidates(msg *typesUtil.MessageScheduleUpgrade) ([][]byte, typesUtil.Error) {
+	owner, err := u.getParamOwner(typesUtil.AclOwner)
+	if err != nil {
+		return nil, typesUtil.ErrGetParam(typesUtil.AclOwner, err)
+	}
+	return [][]byte{owner}, nil
+}
+
 func (u *baseUtilityUnitOfWork) getBigIntParam(paramName string) (*big.Int, typesUtil.Error) {
 	value, err := u.persistenceReadContext.GetStringParam(paramName, u.height)
 	if err != nil {
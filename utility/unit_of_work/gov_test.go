@@ -7,6 +7,7 @@ import (
 	"github.com/pokt-network/pocket/runtime/genesis"
 	"github.com/pokt-network/pocket/runtime/test_artifacts"
 	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"github.com/pokt-network/pocket/shared/utils"
 	typesUtil "github.com/pokt-network/pocket/utility/types"
 	"github.com/stretchr/testify/require"
@@ -45,6 +46,24 @@ func TestUtilityUnitOfWork_GetAppMinimumPauseBlocks(t *testing.T) {
 	require.Equal(t, defaultParam, gotParam)
 }
 
+func TestUtilityUnitOfWork_GetGovernanceVotingPeriodBlocks(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := defaultParams.GetGovernanceVotingPeriodBlocks()
+	gotParam, err := uow.getGovernanceVotingPeriodBlocks()
+	require.NoError(t, err)
+	require.Equal(t, int64(defaultParam), gotParam)
+}
+
+func TestUtilityUnitOfWork_GetGovernanceQuorumPercentage(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := defaultParams.GetGovernanceQuorumPercentage()
+	gotParam, err := uow.getGovernanceQuorumPercentage()
+	require.NoError(t, err)
+	require.Equal(t, int(defaultParam), gotParam)
+}
+
 func TestUtilityUnitOfWork_GetAppMinimumStake(t *testing.T) {
 	uow := newTestingUtilityUnitOfWork(t, 0)
 	defaultParams := DefaultTestingParams(t)
@@ -166,6 +185,51 @@ func TestUtilityUnitOfWork_GetMessageDoubleSignFee(t *testing.T) {
 	require.Equal(t, defaultParam, utils.BigIntToString(gotParam))
 }
 
+func TestUtilityUnitOfWork_GetMessageDelegateFee(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := defaultParams.GetMessageDelegateFee()
+	gotParam, err := uow.getMessageDelegateFee()
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, utils.BigIntToString(gotParam))
+}
+
+func TestUtilityUnitOfWork_GetMessageUndelegateFee(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := defaultParams.GetMessageUndelegateFee()
+	gotParam, err := uow.getMessageUndelegateFee()
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, utils.BigIntToString(gotParam))
+}
+
+func TestUtilityUnitOfWork_GetMessageSubmitProposalFee(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := defaultParams.GetMessageSubmitProposalFee()
+	gotParam, err := uow.getMessageSubmitProposalFee()
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, utils.BigIntToString(gotParam))
+}
+
+func TestUtilityUnitOfWork_GetMessageVoteFee(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := defaultParams.GetMessageVoteFee()
+	gotParam, err := uow.getMessageVoteFee()
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, utils.BigIntToString(gotParam))
+}
+
+func TestUtilityUnitOfWork_GetMessageScheduleUpgradeFee(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 0)
+	defaultParams := DefaultTestingParams(t)
+	defaultParam := defaultParams.GetMessageScheduleUpgradeFee()
+	gotParam, err := uow.getMessageScheduleUpgradeFee()
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, utils.BigIntToString(gotParam))
+}
+
 func TestUtilityUnitOfWork_GetMessageEditStakeAppFee(t *testing.T) {
 	uow := newTestingUtilityUnitOfWork(t, 0)
 	defaultParams := DefaultTestingParams(t)
@@ -524,6 +588,79 @@ func TestUtilityUnitOfWork_HandleMessageChangeParameter(t *testing.T) {
 	require.Equal(t, int(newParamValue), gotParam)
 }
 
+func TestUtilityUnitOfWork_HandleMessageChangeParameter_RelayChains(t *testing.T) {
+	cdc := codec.GetCodec()
+	uow := newTestingUtilityUnitOfWork(t, 0)
+
+	newChangeRelayChainsMsg := func(relayChains string) *typesUtil.MessageChangeParameter {
+		any, err := cdc.ToAny(&wrapperspb.StringValue{
+			Value: relayChains,
+		})
+		require.NoError(t, err)
+		return &typesUtil.MessageChangeParameter{
+			Owner:          test_artifacts.DefaultParamsOwner.Address(),
+			ParameterKey:   typesUtil.RelayChainsParamName,
+			ParameterValue: any,
+		}
+	}
+
+	require.NoError(t, uow.handleMessageChangeParameter(newChangeRelayChainsMsg("0001:Pocket Network,0021:Ethereum Mainnet")))
+	registry, err := uow.getRelayChains()
+	require.NoError(t, err)
+	require.Equal(t, []*typesUtil.RelayChain{
+		{ID: "0001", Name: "Pocket Network"},
+		{ID: "0021", Name: "Ethereum Mainnet"},
+	}, registry)
+
+	// a malformed registry is rejected
+	err = uow.handleMessageChangeParameter(newChangeRelayChainsMsg("0001:Pocket Network,021:Ethereum Mainnet"))
+	require.Equal(t, typesUtil.CodeInvalidRelayChainsError, err.Code())
+}
+
+func TestUtilityUnitOfWork_HandleMessageScheduleUpgrade(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+
+	msg := &typesUtil.MessageScheduleUpgrade{
+		Name:             "v1.0.0",
+		ActivationHeight: uow.height + 10,
+	}
+	require.NoError(t, uow.handleMessageScheduleUpgrade(msg))
+
+	plan, err := uow.persistenceRWContext.GetUpgradePlan(uow.height)
+	require.NoError(t, err)
+	require.Equal(t, msg.Name, plan.GetName())
+	require.Equal(t, msg.ActivationHeight, plan.GetActivationHeight())
+
+	// only the ACL owner can schedule upgrades
+	candidates, er := uow.getMessageScheduleUpgradeSignerCandidates(msg)
+	require.NoError(t, er)
+	require.Equal(t, [][]byte{test_artifacts.DefaultParamsOwner.Address()}, candidates)
+
+	// upgrades cannot activate in the past
+	msg.ActivationHeight = uow.height
+	er = uow.handleMessageScheduleUpgrade(msg)
+	require.Equal(t, typesUtil.CodeInvalidUpgradeHeightError, er.Code())
+}
+
+func TestUtilityUnitOfWork_ApplyUpgradePlan(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+
+	// the plan is not applied before its activation height
+	require.NoError(t, uow.persistenceRWContext.SetUpgradePlan(&coreTypes.UpgradePlan{Name: "v1.0.0", ActivationHeight: uow.height + 1}))
+	require.NoError(t, uow.applyUpgradePlan())
+	plan, err := uow.persistenceRWContext.GetUpgradePlan(uow.height)
+	require.NoError(t, err)
+	require.False(t, plan.GetApplied())
+
+	// the plan is applied by the block at its activation height
+	require.NoError(t, uow.persistenceRWContext.SetUpgradePlan(&coreTypes.UpgradePlan{Name: "v1.0.0", ActivationHeight: uow.height}))
+	require.NoError(t, uow.applyUpgradePlan())
+	plan, err = uow.persistenceRWContext.GetUpgradePlan(uow.height)
+	require.NoError(t, err)
+	require.True(t, plan.GetApplied())
+	require.Equal(t, "v1.0.0", plan.GetName())
+}
+
 func TestUtilityUnitOfWork_GetParamOwner(t *testing.T) {
 	uow := newTestingUtilityUnitOfWork(t, 0)
 	defaultParams := DefaultTestingParams(t)
@@ -535,6 +672,26 @@ func TestUtilityUnitOfWork_GetParamOwner(t *testing.T) {
 	gotParam, err = uow.getParamOwner(typesUtil.BlocksPerSessionParamName)
 	require.NoError(t, err)
 	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetAllowedGeoZonesOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.AllowedGeoZonesParamName)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetRelayChainsOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.RelayChainsParamName)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetMaxTransactionExpiryBlocksOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.MaxTransactionExpiryBlocksParamName)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetGovernanceVotingPeriodBlocksOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.GovernanceVotingPeriodBlocksParamName)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetGovernanceQuorumPercentageOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.GovernanceQuorumPercentageParamName)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
 	defaultParam = defaultParams.GetAppMaxChainsOwner()
 	gotParam, err = uow.getParamOwner(typesUtil.AppMaxChainsParamName)
 	require.NoError(t, err)
@@ -563,6 +720,22 @@ func TestUtilityUnitOfWork_GetParamOwner(t *testing.T) {
 	gotParam, err = uow.getParamOwner(typesUtil.ServicersPerSessionParamName)
 	require.NoError(t, err)
 	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetClaimExpirationBlocksOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.ClaimExpirationBlocksParamName)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetTestScoreExpirationBlocksOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.TestScoreExpirationBlocksParamName)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetRelaysToTokensMultiplierOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.RelaysToTokensMultiplierParamName)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetDaoPercentageOfRelayRewardsOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.DAOPercentageOfRelayRewardsParamName)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
 	defaultParam = defaultParams.GetServicerMinimumStakeOwner()
 	gotParam, err = uow.getParamOwner(typesUtil.ServicerMinimumStakeParamName)
 	require.NoError(t, err)
@@ -603,6 +776,10 @@ func TestUtilityUnitOfWork_GetParamOwner(t *testing.T) {
 	gotParam, err = uow.getParamOwner(typesUtil.FishermanMaxPauseBlocksParamName)
 	require.NoError(t, err)
 	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetFishermenPerSessionOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.FishermenPerSessionParamName)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
 	defaultParam = defaultParams.GetValidatorMinimumStakeOwner()
 	gotParam, err = uow.getParamOwner(typesUtil.ValidatorMinimumStakeParamName)
 	require.NoError(t, err)
@@ -639,10 +816,38 @@ func TestUtilityUnitOfWork_GetParamOwner(t *testing.T) {
 	gotParam, err = uow.getParamOwner(typesUtil.DoubleSignBurnPercentageParamName)
 	require.NoError(t, err)
 	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetDoubleSignReporterRewardPercentageOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.DoubleSignReporterRewardPercentageParamName)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
 	defaultParam = defaultParams.GetMessageDoubleSignFeeOwner()
 	gotParam, err = uow.getParamOwner(typesUtil.MessageDoubleSignFee)
 	require.NoError(t, err)
 	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetMessageDelegateFeeOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.MessageDelegateFee)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetMessageUndelegateFeeOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.MessageUndelegateFee)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetMessageSubmitProposalFeeOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.MessageSubmitProposalFee)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetMessageVoteFeeOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.MessageVoteFee)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetMessageScheduleUpgradeFeeOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.MessageScheduleUpgradeFee)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetMessageRotateOperatorKeyFeeOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.MessageRotateOperatorKeyFee)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
 	defaultParam = defaultParams.GetMessageSendFeeOwner()
 	gotParam, err = uow.getParamOwner(typesUtil.MessageSendFee)
 	require.NoError(t, err)
@@ -743,12 +948,40 @@ func TestUtilityUnitOfWork_GetParamOwner(t *testing.T) {
 	gotParam, err = uow.getParamOwner(typesUtil.MessageChangeParameterFee)
 	require.NoError(t, err)
 	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetMessageClaimFeeOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.MessageClaimFee)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetMessageProofFeeOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.MessageProofFee)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
 	// owners
 	defaultParam = defaultParams.GetAclOwner()
 	gotParam, err = uow.getParamOwner(typesUtil.BlocksPerSessionOwner)
 	require.NoError(t, err)
 	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
 	defaultParam = defaultParams.GetAclOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.AllowedGeoZonesOwner)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetAclOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.RelayChainsOwner)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetAclOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.MaxTransactionExpiryBlocksOwner)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetAclOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.GovernanceVotingPeriodBlocksOwner)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetAclOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.GovernanceQuorumPercentageOwner)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetAclOwner()
 	gotParam, err = uow.getParamOwner(typesUtil.AppMaxChainsOwner)
 	require.NoError(t, err)
 	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
@@ -800,6 +1033,22 @@ func TestUtilityUnitOfWork_GetParamOwner(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
 	defaultParam = defaultParams.GetAclOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.ClaimExpirationBlocksOwner)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetAclOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.TestScoreExpirationBlocksOwner)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetAclOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.RelaysToTokensMultiplierOwner)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetAclOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.DAOPercentageOfRelayRewardsOwner)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetAclOwner()
 	gotParam, err = uow.getParamOwner(typesUtil.FishermanMinimumStakeOwner)
 	require.NoError(t, err)
 	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
@@ -820,6 +1069,10 @@ func TestUtilityUnitOfWork_GetParamOwner(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
 	defaultParam = defaultParams.GetAclOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.FishermenPerSessionOwner)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetAclOwner()
 	gotParam, err = uow.getParamOwner(typesUtil.ValidatorMinimumStakeOwner)
 	require.NoError(t, err)
 	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
@@ -856,10 +1109,38 @@ func TestUtilityUnitOfWork_GetParamOwner(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
 	defaultParam = defaultParams.GetAclOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.DoubleSignReporterRewardPercentageOwner)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetAclOwner()
 	gotParam, err = uow.getParamOwner(typesUtil.MessageSendFeeOwner)
 	require.NoError(t, err)
 	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
 	defaultParam = defaultParams.GetAclOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.MessageDelegateFeeOwner)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetAclOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.MessageUndelegateFeeOwner)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetAclOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.MessageSubmitProposalFeeOwner)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetAclOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.MessageVoteFeeOwner)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetAclOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.MessageScheduleUpgradeFeeOwner)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetAclOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.MessageRotateOperatorKeyFeeOwner)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetAclOwner()
 	gotParam, err = uow.getParamOwner(typesUtil.MessageStakeFishermanFeeOwner)
 	require.NoError(t, err)
 	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
@@ -955,6 +1236,14 @@ func TestUtilityUnitOfWork_GetParamOwner(t *testing.T) {
 	gotParam, err = uow.getParamOwner(typesUtil.MessageChangeParameterFeeOwner)
 	require.NoError(t, err)
 	defaultParamBz, err := hex.DecodeString(defaultParam)
+	defaultParam = defaultParams.GetAclOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.MessageClaimFeeOwner)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
+	defaultParam = defaultParams.GetAclOwner()
+	gotParam, err = uow.getParamOwner(typesUtil.MessageProofFeeOwner)
+	require.NoError(t, err)
+	require.Equal(t, defaultParam, hex.EncodeToString(gotParam))
 	require.NoError(t, err)
 	require.Equal(t, defaultParamBz, gotParam)
 }
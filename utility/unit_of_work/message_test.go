@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"testing"
 
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"github.com/pokt-network/pocket/shared/utils"
 	"github.com/pokt-network/pocket/utility/types"
 	"github.com/stretchr/testify/require"
@@ -42,6 +43,43 @@ func TestUtilityUnitOfWork_HandleMessageSend(t *testing.T) {
 	require.Equal(t, sendAmount, big.NewInt(0).Sub(recipientBalanceAfter, recipientBalanceBefore))
 }
 
+func TestUtilityUnitOfWork_HandleMessageSend_Vesting(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+	accs := getAllTestingAccounts(t, uow)
+
+	sendAmount := big.NewInt(1000000)
+	senderBalance, err := utils.StringToBigInt(accs[0].GetAmount())
+	require.NoError(t, err)
+
+	addrBz, er := hex.DecodeString(accs[0].GetAddress())
+	require.NoError(t, er)
+
+	addrBz2, er := hex.DecodeString(accs[1].GetAddress())
+	require.NoError(t, er)
+
+	// The whole balance of the sender is locked until the cliff
+	schedule := &coreTypes.VestingSchedule{
+		Address:     accs[0].GetAddress(),
+		Amount:      accs[0].GetAmount(),
+		StartHeight: 0,
+		CliffHeight: 10,
+		EndHeight:   100,
+	}
+	require.NoError(t, uow.persistenceRWContext.SetVestingSchedule(schedule))
+
+	msg := NewTestingSendMessage(t, addrBz, addrBz2, utils.BigIntToString(sendAmount))
+	require.Equal(t, types.CodeInsufficientVestedAmountError, uow.handleMessageSend(&msg).Code())
+
+	// The tokens that are not locked can be spent
+	schedule.Amount = utils.BigIntToString(new(big.Int).Sub(senderBalance, sendAmount))
+	require.NoError(t, uow.persistenceRWContext.SetVestingSchedule(schedule))
+	require.NoError(t, uow.handleMessageSend(&msg), "handle message send")
+
+	senderBalanceAfter, err := uow.getAccountAmount(addrBz)
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).Sub(senderBalance, sendAmount), senderBalanceAfter)
+}
+
 func TestUtilityUnitOfWork_GetMessageSendSignerCandidates(t *testing.T) {
 	uow := newTestingUtilityUnitOfWork(t, 0)
 	accs := getAllTestingAccounts(t, uow)
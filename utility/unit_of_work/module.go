@@ -8,8 +8,10 @@ import (
 )
 
 const (
-	leaderUtilityUOWModuleName  = "leader_utility_UOW"
-	replicaUtilityUOWModuleName = "replica_utility_UOW"
+	leaderUtilityUOWModuleName     = "leader_utility_UOW"
+	replicaUtilityUOWModuleName    = "replica_utility_UOW"
+	feeUtilityUOWModuleName        = "fee_utility_UOW"
+	simulationUtilityUOWModuleName = "simulation_utility_UOW"
 )
 
 var _ modules.UtilityUnitOfWork = &baseUtilityUnitOfWork{}
@@ -31,6 +33,13 @@ type baseUtilityUnitOfWork struct {
 	persistenceReadContext modules.PersistenceReadContext
 	persistenceRWContext   modules.PersistenceRWContext
 
+	// The number of relays proven in the transactions of the block, keyed by the hex encoded output address of the
+	// servicer that serviced them. They are rewarded at the end of the block.
+	provenRelays map[string]uint64
+
+	// The stack of save points the state can be reverted to, most recent last
+	savePoints []*savePoint
+
 	// TECHDEBT: Consolidate all these types with the shared Protobuf struct and create a `proposalBlock`
 	proposalStateHash    string
 	proposalProposerAddr []byte
@@ -88,10 +97,7 @@ func (u *baseUtilityUnitOfWork) CreateAndApplyProposalBlock(proposer []byte, max
 		txResult, err := u.hydrateTxResult(tx, txIdx)
 		if err != nil {
 			u.logger.Err(err).Msg("Error in ApplyTransaction")
-			// TODO(#327): Properly implement 'unhappy path' for save points
-			if err := u.revertLastSavePoint(); err != nil {
-				return "", nil, err
-			}
+			// NB: `hydrateTxResult` reverts the changes it made to the state when it fails
 			txsTotalBz -= txBzSize
 			continue
 		}
@@ -10,6 +10,8 @@ import (
 	"github.com/pokt-network/pocket/runtime"
 	"github.com/pokt-network/pocket/runtime/configs"
 	"github.com/pokt-network/pocket/runtime/test_artifacts"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
 	"github.com/pokt-network/pocket/shared/mempool"
 	"github.com/pokt-network/pocket/shared/messaging"
 	"github.com/pokt-network/pocket/shared/modules"
@@ -87,6 +89,22 @@ func newTestingUtilityUnitOfWork(t *testing.T, height int64, options ...func(*ba
 	return uow
 }
 
+// newTestingServicerStakeMsg returns a message staking a new servicer for `chains` in `geoZone`, funded by `outputAddress`
+func newTestingServicerStakeMsg(t *testing.T, outputAddress []byte, chains []string, geoZone string) *utilTypes.MessageStake {
+	pubKey, err := crypto.GeneratePublicKey()
+	require.NoError(t, err)
+	return &utilTypes.MessageStake{
+		PublicKey:     pubKey.Bytes(),
+		Chains:        chains,
+		Amount:        test_artifacts.DefaultStakeAmountString,
+		ServiceUrl:    test_artifacts.DefaultServiceURL,
+		OutputAddress: outputAddress,
+		Signer:        outputAddress,
+		ActorType:     coreTypes.ActorType_ACTOR_TYPE_SERVICER,
+		GeoZone:       geoZone,
+	}
+}
+
 func newTestPersistenceModule(bus modules.Bus) modules.PersistenceModule {
 	persistenceMod, err := persistence.Create(bus)
 	if err != nil {
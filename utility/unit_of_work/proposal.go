@@ -0,0 +1,207 @@
+package unit_of_work
+
+// Internal business logic for the governance proposals voted on by the validators
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/utils"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// proposalSavePointPrefix distinguishes the save point taken before applying the changes of a proposal from the
+// ones taken before applying transactions
+const proposalSavePointPrefix = "proposal"
+
+// getProposal returns the proposal identified by `id`, or nil if it does not exist
+// NB: the RW context is used so proposals submitted earlier in the same block are taken into account
+func (u *baseUtilityUnitOfWork) getProposal(id uint64) (*coreTypes.Proposal, typesUtil.Error) {
+	proposal, err := u.persistenceRWContext.GetProposal(id, u.height)
+	if err != nil {
+		return nil, typesUtil.ErrGetProposal(err)
+	}
+	return proposal, nil
+}
+
+func (u *baseUtilityUnitOfWork) setProposal(proposal *coreTypes.Proposal) typesUtil.Error {
+	if err := u.persistenceRWContext.InsertProposal(proposal); err != nil {
+		return typesUtil.ErrSetProposal(err)
+	}
+	return nil
+}
+
+// getNextProposalID returns the id of the next proposal to be submitted, proposals being numbered from 1
+func (u *baseUtilityUnitOfWork) getNextProposalID() (uint64, typesUtil.Error) {
+	latestID, err := u.persistenceRWContext.GetLatestProposalID(u.height)
+	if err != nil {
+		return 0, typesUtil.ErrGetProposal(err)
+	}
+	return latestID + 1, nil
+}
+
+// getParameterChangeValue returns the value set by `change`, ensuring the parameter exists and the value is valid
+// and has the type of the parameter, as `updateParam` cannot apply values of any other type
+func (u *baseUtilityUnitOfWork) getParameterChangeValue(change *coreTypes.ParameterChange) (any, typesUtil.Error) {
+	if _, err := u.getParamOwner(change.GetParameterKey()); err != nil {
+		return nil, typesUtil.ErrUnknownParam(change.GetParameterKey())
+	}
+	value, err := codec.GetCodec().FromAny(change.GetParameterValue())
+	if err != nil {
+		return nil, typesUtil.ErrProtoFromAny(err)
+	}
+	if err := validateParamValue(change.GetParameterKey(), value); err != nil {
+		return nil, err
+	}
+	currentValue, err := u.getParameter(change.GetParameterKey())
+	if err != nil {
+		return nil, typesUtil.ErrGetParam(change.GetParameterKey(), err)
+	}
+	switch currentValue.(type) {
+	case int:
+		if _, ok := value.(*wrapperspb.Int32Value); ok {
+			return value, nil
+		}
+		return nil, typesUtil.ErrInvalidParamValue(value, &wrapperspb.Int32Value{})
+	case string:
+		if _, ok := value.(*wrapperspb.StringValue); ok {
+			return value, nil
+		}
+		return nil, typesUtil.ErrInvalidParamValue(value, &wrapperspb.StringValue{})
+	case []byte:
+		if _, ok := value.(*wrapperspb.BytesValue); ok {
+			return value, nil
+		}
+		return nil, typesUtil.ErrInvalidParamValue(value, &wrapperspb.BytesValue{})
+	default:
+		return nil, typesUtil.ErrUnknownParam(change.GetParameterKey())
+	}
+}
+
+// handleProposals tallies the votes on the proposals whose voting period ends at the current height, applying the
+// changes of the ones that passed
+func (u *baseUtilityUnitOfWork) handleProposals() typesUtil.Error {
+	proposals, er := u.persistenceRWContext.GetProposalsEndingAtHeight(u.height)
+	if er != nil {
+		return typesUtil.ErrGetProposal(er)
+	}
+	for _, proposal := range proposals {
+		passed, err := u.tallyVotes(proposal)
+		if err != nil {
+			return err
+		}
+		proposal.Status = coreTypes.ProposalStatus_PROPOSAL_STATUS_REJECTED
+		if passed {
+			proposal.Status, err = u.applyProposal(proposal)
+			if err != nil {
+				return err
+			}
+		}
+		u.logger.Info().
+			Uint64("proposal_id", proposal.Id).
+			Str("status", proposal.Status.String()).
+			Int64("height", u.height).
+			Msg("Tallied the votes on the proposal")
+		if err := u.setProposal(proposal); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tallyVotes weighs the latest vote of every validator by its stake when the proposal was submitted, and returns
+// whether the proposal passed, i.e. whether the validators that voted hold at least the quorum percentage of the total
+// validator stake at that height, and more of it voted yes than no. Snapshotting the stake at `SubmitHeight` prevents
+// it from being added or removed during the voting period to swing the result: votes of actors that were not
+// validators when the proposal was submitted are ignored.
+func (u *baseUtilityUnitOfWork) tallyVotes(proposal *coreTypes.Proposal) (bool, typesUtil.Error) {
+	quorumPercentage, err := u.getGovernanceQuorumPercentage()
+	if err != nil {
+		return false, err
+	}
+	validators, er := u.persistenceRWContext.GetAllValidators(proposal.GetSubmitHeight())
+	if er != nil {
+		return false, typesUtil.ErrGetAllValidators(er)
+	}
+	votes, er := u.persistenceRWContext.GetProposalVotes(proposal.Id, u.height)
+	if er != nil {
+		return false, typesUtil.ErrGetVotes(er)
+	}
+
+	totalStake := big.NewInt(0)
+	validatorStakes := make(map[string]*big.Int, len(validators))
+	for _, validator := range validators {
+		stake, er := utils.StringToBigInt(validator.GetStakedAmount())
+		if er != nil {
+			return false, typesUtil.ErrStringToBigInt(er)
+		}
+		totalStake.Add(totalStake, stake)
+		validatorStakes[validator.GetAddress()] = stake
+	}
+
+	votedStake, yesStake, noStake := big.NewInt(0), big.NewInt(0), big.NewInt(0)
+	for _, vote := range votes {
+		stake, ok := validatorStakes[vote.GetValidatorAddress()]
+		if !ok {
+			continue
+		}
+		votedStake.Add(votedStake, stake)
+		switch vote.GetOption() {
+		case coreTypes.VoteOption_VOTE_OPTION_YES:
+			yesStake.Add(yesStake, stake)
+		case coreTypes.VoteOption_VOTE_OPTION_NO:
+			noStake.Add(noStake, stake)
+		}
+	}
+
+	// votedStake / totalStake >= quorumPercentage / 100 <=> votedStake * 100 >= quorumPercentage * totalStake
+	votedStakeScaled := new(big.Int).Mul(votedStake, big.NewInt(100))
+	quorumStake := new(big.Int).Mul(totalStake, big.NewInt(int64(quorumPercentage)))
+	if totalStake.Sign() == 0 || votedStakeScaled.Cmp(quorumStake) < 0 {
+		return false, nil
+	}
+	return yesStake.Cmp(noStake) > 0, nil
+}
+
+// applyProposal applies all the changes of the proposal or none of them, returning the resulting status of the proposal
+func (u *baseUtilityUnitOfWork) applyProposal(proposal *coreTypes.Proposal) (coreTypes.ProposalStatus, typesUtil.Error) {
+	savePointKey := make([]byte, len(proposalSavePointPrefix)+8)
+	copy(savePointKey, proposalSavePointPrefix)
+	binary.BigEndian.PutUint64(savePointKey[len(proposalSavePointPrefix):], proposal.Id)
+	if err := u.newSavePoint(savePointKey); err != nil {
+		return coreTypes.ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED, err
+	}
+	for _, change := range proposal.ParameterChanges {
+		value, err := u.getParameterChangeValue(change)
+		if err == nil {
+			err = u.updateParam(change.ParameterKey, value)
+		}
+		if err != nil {
+			u.logger.Error().Err(err).
+				Uint64("proposal_id", proposal.Id).
+				Str("parameter_key", change.ParameterKey).
+				Msg("Failed to apply the changes of the proposal")
+			if err := u.revertLastSavePoint(); err != nil {
+				return coreTypes.ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED, err
+			}
+			return coreTypes.ProposalStatus_PROPOSAL_STATUS_FAILED, nil
+		}
+	}
+	if err := u.releaseLastSavePoint(); err != nil {
+		return coreTypes.ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED, err
+	}
+	return coreTypes.ProposalStatus_PROPOSAL_STATUS_PASSED, nil
+}
+
+// getVoterSignerCandidates returns the addresses allowed to vote on behalf of the validator: the validator itself
+// and its output address
+func (u *baseUtilityUnitOfWork) getVoterSignerCandidates(validatorAddr []byte) ([][]byte, typesUtil.Error) {
+	output, err := u.getActorOutputAddress(coreTypes.ActorType_ACTOR_TYPE_VAL, validatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{output, validatorAddr}, nil
+}
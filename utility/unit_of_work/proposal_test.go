@@ -0,0 +1,287 @@
+package unit_of_work
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestUtilityUnitOfWork_HandleMessageSubmitProposal(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+
+	proposerAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	msg := &typesUtil.MessageSubmitProposal{
+		ProposerAddress: proposerAddr,
+		ParameterChanges: []*coreTypes.ParameterChange{
+			newTestingParameterChange(t, typesUtil.GovernanceQuorumPercentageParamName, wrapperspb.Int32(50)),
+			newTestingParameterChange(t, typesUtil.MessageVoteFee, wrapperspb.String("100")),
+		},
+		Signer: proposerAddr,
+	}
+	require.NoError(t, uow.handleMessageSubmitProposal(msg))
+	// proposals are numbered sequentially
+	require.NoError(t, uow.handleMessageSubmitProposal(msg))
+
+	votingPeriodBlocks, err := uow.getGovernanceVotingPeriodBlocks()
+	require.NoError(t, err)
+	proposal, err := uow.getProposal(2)
+	require.NoError(t, err)
+	require.Equal(t, hex.EncodeToString(proposerAddr), proposal.GetProposerAddress())
+	require.Equal(t, uow.height+votingPeriodBlocks, proposal.GetVotingEndHeight())
+	require.Equal(t, coreTypes.ProposalStatus_PROPOSAL_STATUS_VOTING, proposal.GetStatus())
+	require.Len(t, proposal.GetParameterChanges(), 2)
+
+	// the parameters must exist
+	msgUnknownParam := proto.Clone(msg).(*typesUtil.MessageSubmitProposal)
+	msgUnknownParam.ParameterChanges[0].ParameterKey = "unknown_param"
+	er := uow.handleMessageSubmitProposal(msgUnknownParam)
+	require.Equal(t, typesUtil.CodeUnknownParamError, er.Code())
+
+	// the values must have the type of the parameters
+	msgInvalidValue := proto.Clone(msg).(*typesUtil.MessageSubmitProposal)
+	msgInvalidValue.ParameterChanges[1] = newTestingParameterChange(t, typesUtil.MessageVoteFee, wrapperspb.Int32(100))
+	er = uow.handleMessageSubmitProposal(msgInvalidValue)
+	require.Equal(t, typesUtil.CodeInvalidParamValueError, er.Code())
+
+	// the voting period must stay positive
+	msgNoVotingPeriod := proto.Clone(msg).(*typesUtil.MessageSubmitProposal)
+	msgNoVotingPeriod.ParameterChanges[0] = newTestingParameterChange(t, typesUtil.GovernanceVotingPeriodBlocksParamName, wrapperspb.Int32(0))
+	er = uow.handleMessageSubmitProposal(msgNoVotingPeriod)
+	require.Equal(t, typesUtil.CodeNonPositiveParamError, er.Code())
+}
+
+func TestUtilityUnitOfWork_HandleMessageVote(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+
+	validatorAddr := getFirstValidatorAddr(t, uow)
+	msg := &typesUtil.MessageVote{
+		ValidatorAddress: validatorAddr,
+		ProposalId:       1,
+		Option:           coreTypes.VoteOption_VOTE_OPTION_YES,
+		Signer:           validatorAddr,
+	}
+	err := uow.handleMessageVote(msg)
+	require.Equal(t, typesUtil.CodeProposalNotFoundError, err.Code())
+
+	submitTestingProposal(t, uow, newTestingParameterChange(t, typesUtil.GovernanceQuorumPercentageParamName, wrapperspb.Int32(50)))
+	require.NoError(t, uow.handleMessageVote(msg))
+	// voting again replaces the previous vote
+	msg.Option = coreTypes.VoteOption_VOTE_OPTION_NO
+	require.NoError(t, uow.handleMessageVote(msg))
+
+	votes, er := uow.persistenceRWContext.GetProposalVotes(1, uow.height)
+	require.NoError(t, er)
+	require.Len(t, votes, 1)
+	require.Equal(t, coreTypes.VoteOption_VOTE_OPTION_NO, votes[0].GetOption())
+
+	// only validators can vote
+	nonValidatorAddr, er := crypto.GenerateAddress()
+	require.NoError(t, er)
+	msg.ValidatorAddress = nonValidatorAddr
+	require.Error(t, uow.handleMessageVote(msg))
+}
+
+func TestUtilityUnitOfWork_HandleProposals(t *testing.T) {
+	tests := []struct {
+		name             string
+		quorumPercentage int
+		votes            func(numValidators int) []coreTypes.VoteOption
+		expectedStatus   coreTypes.ProposalStatus
+	}{
+		{
+			name:             "all validators vote yes",
+			quorumPercentage: 100,
+			votes: func(numValidators int) []coreTypes.VoteOption {
+				return repeatVoteOption(coreTypes.VoteOption_VOTE_OPTION_YES, numValidators)
+			},
+			expectedStatus: coreTypes.ProposalStatus_PROPOSAL_STATUS_PASSED,
+		},
+		{
+			name:             "quorum not reached",
+			quorumPercentage: 100,
+			votes: func(numValidators int) []coreTypes.VoteOption {
+				return repeatVoteOption(coreTypes.VoteOption_VOTE_OPTION_YES, numValidators-1)
+			},
+			expectedStatus: coreTypes.ProposalStatus_PROPOSAL_STATUS_REJECTED,
+		},
+		{
+			name:             "no majority of yes votes",
+			quorumPercentage: 0,
+			votes: func(numValidators int) []coreTypes.VoteOption {
+				return []coreTypes.VoteOption{coreTypes.VoteOption_VOTE_OPTION_YES, coreTypes.VoteOption_VOTE_OPTION_NO}
+			},
+			expectedStatus: coreTypes.ProposalStatus_PROPOSAL_STATUS_REJECTED,
+		},
+		{
+			name:             "abstentions count towards the quorum only",
+			quorumPercentage: 100,
+			votes: func(numValidators int) []coreTypes.VoteOption {
+				return append(
+					[]coreTypes.VoteOption{coreTypes.VoteOption_VOTE_OPTION_YES},
+					repeatVoteOption(coreTypes.VoteOption_VOTE_OPTION_ABSTAIN, numValidators-1)...,
+				)
+			},
+			expectedStatus: coreTypes.ProposalStatus_PROPOSAL_STATUS_PASSED,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uow := newTestingUtilityUnitOfWork(t, 1)
+			require.NoError(t, uow.persistenceRWContext.SetParam(typesUtil.GovernanceQuorumPercentageParamName, tt.quorumPercentage))
+
+			validators, err := uow.persistenceRWContext.GetAllValidators(uow.height)
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, len(validators), 2)
+
+			setTestingProposalEndingNow(t, uow, uow.height, newTestingParameterChange(t, typesUtil.MessageVoteFee, wrapperspb.String("123")))
+			for i, option := range tt.votes(len(validators)) {
+				validatorAddr, err := hex.DecodeString(validators[i].GetAddress())
+				require.NoError(t, err)
+				require.NoError(t, uow.handleMessageVote(&typesUtil.MessageVote{
+					ValidatorAddress: validatorAddr,
+					ProposalId:       1,
+					Option:           option,
+					Signer:           validatorAddr,
+				}))
+			}
+
+			require.NoError(t, uow.handleProposals())
+
+			proposal, er := uow.getProposal(1)
+			require.NoError(t, er)
+			require.Equal(t, tt.expectedStatus, proposal.GetStatus())
+
+			voteFee, err := uow.persistenceRWContext.GetStringParam(typesUtil.MessageVoteFee, uow.height)
+			require.NoError(t, err)
+			if tt.expectedStatus == coreTypes.ProposalStatus_PROPOSAL_STATUS_PASSED {
+				require.Equal(t, "123", voteFee)
+			} else {
+				require.NotEqual(t, "123", voteFee)
+			}
+		})
+	}
+}
+
+func TestUtilityUnitOfWork_HandleProposals_StakeAtSubmitHeight(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+	require.NoError(t, uow.persistenceRWContext.SetParam(typesUtil.GovernanceQuorumPercentageParamName, 0))
+
+	validators, err := uow.persistenceRWContext.GetAllValidators(uow.height)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(validators), 2)
+	require.Equal(t, validators[0].GetStakedAmount(), validators[1].GetStakedAmount())
+
+	// the proposal was submitted at genesis, when both validators had the same stake
+	setTestingProposalEndingNow(t, uow, 0, newTestingParameterChange(t, typesUtil.MessageVoteFee, wrapperspb.String("123")))
+	for i, option := range []coreTypes.VoteOption{coreTypes.VoteOption_VOTE_OPTION_YES, coreTypes.VoteOption_VOTE_OPTION_NO} {
+		validatorAddr, err := hex.DecodeString(validators[i].GetAddress())
+		require.NoError(t, err)
+		require.NoError(t, uow.handleMessageVote(&typesUtil.MessageVote{
+			ValidatorAddress: validatorAddr,
+			ProposalId:       1,
+			Option:           option,
+			Signer:           validatorAddr,
+		}))
+	}
+
+	// the stake added by the validator voting yes during the voting period does not count
+	yesValidatorAddr, err := hex.DecodeString(validators[0].GetAddress())
+	require.NoError(t, err)
+	require.NoError(t, uow.persistenceRWContext.SetValidatorStakeAmount(yesValidatorAddr, "1000000000000000000"))
+
+	require.NoError(t, uow.handleProposals())
+
+	proposal, er := uow.getProposal(1)
+	require.NoError(t, er)
+	require.Equal(t, coreTypes.ProposalStatus_PROPOSAL_STATUS_REJECTED, proposal.GetStatus())
+}
+
+func TestUtilityUnitOfWork_HandleProposals_Failed(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+
+	validatorAddr := getFirstValidatorAddr(t, uow)
+	require.NoError(t, uow.persistenceRWContext.SetParam(typesUtil.GovernanceQuorumPercentageParamName, 0))
+
+	// the second change cannot be applied, so neither of them is
+	proposal := &coreTypes.Proposal{
+		Id:              1,
+		ProposerAddress: hex.EncodeToString(validatorAddr),
+		ParameterChanges: []*coreTypes.ParameterChange{
+			newTestingParameterChange(t, typesUtil.MessageVoteFee, wrapperspb.String("123")),
+			newTestingParameterChange(t, typesUtil.MessageSubmitProposalFee, wrapperspb.Int32(123)),
+		},
+		SubmitHeight:    uow.height,
+		VotingEndHeight: uow.height,
+		Status:          coreTypes.ProposalStatus_PROPOSAL_STATUS_VOTING,
+	}
+	require.NoError(t, uow.setProposal(proposal))
+	require.NoError(t, uow.handleMessageVote(&typesUtil.MessageVote{
+		ValidatorAddress: validatorAddr,
+		ProposalId:       1,
+		Option:           coreTypes.VoteOption_VOTE_OPTION_YES,
+		Signer:           validatorAddr,
+	}))
+
+	require.NoError(t, uow.handleProposals())
+
+	proposal, err := uow.getProposal(1)
+	require.NoError(t, err)
+	require.Equal(t, coreTypes.ProposalStatus_PROPOSAL_STATUS_FAILED, proposal.GetStatus())
+
+	voteFee, er := uow.persistenceRWContext.GetStringParam(typesUtil.MessageVoteFee, uow.height)
+	require.NoError(t, er)
+	require.NotEqual(t, "123", voteFee)
+}
+
+func newTestingParameterChange(t *testing.T, paramKey string, value proto.Message) *coreTypes.ParameterChange {
+	t.Helper()
+	anyValue, err := codec.GetCodec().ToAny(value)
+	require.NoError(t, err)
+	return &coreTypes.ParameterChange{ParameterKey: paramKey, ParameterValue: anyValue}
+}
+
+func submitTestingProposal(t *testing.T, uow *baseUtilityUnitOfWork, changes ...*coreTypes.ParameterChange) {
+	t.Helper()
+	proposerAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	require.NoError(t, uow.handleMessageSubmitProposal(&typesUtil.MessageSubmitProposal{
+		ProposerAddress:  proposerAddr,
+		ParameterChanges: changes,
+		Signer:           proposerAddr,
+	}))
+}
+
+// setTestingProposalEndingNow stores a proposal submitted at `submitHeight` whose voting period ends at the current
+// height, so its votes are tallied by the next call to `handleProposals`
+func setTestingProposalEndingNow(t *testing.T, uow *baseUtilityUnitOfWork, submitHeight int64, changes ...*coreTypes.ParameterChange) {
+	t.Helper()
+	proposerAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	id, er := uow.getNextProposalID()
+	require.NoError(t, er)
+	require.NoError(t, uow.setProposal(&coreTypes.Proposal{
+		Id:               id,
+		ProposerAddress:  hex.EncodeToString(proposerAddr),
+		ParameterChanges: changes,
+		SubmitHeight:     submitHeight,
+		VotingEndHeight:  uow.height,
+		Status:           coreTypes.ProposalStatus_PROPOSAL_STATUS_VOTING,
+	}))
+}
+
+func repeatVoteOption(option coreTypes.VoteOption, n int) []coreTypes.VoteOption {
+	options := make([]coreTypes.VoteOption, n)
+	for i := range options {
+		options[i] = option
+	}
+	return options
+}
@@ -2,53 +2,168 @@ package unit_of_work
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
+	"math/big"
 
+	"github.com/pokt-network/pocket/logger"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
-	"github.com/pokt-network/pocket/shared/crypto"
 	"github.com/pokt-network/pocket/shared/modules"
 	typesUtil "github.com/pokt-network/pocket/utility/types"
 )
 
+// msgsSavePointPrefix distinguishes the save point taken before applying the messages of a transaction from the one
+// taken before charging its fee
+const msgsSavePointPrefix = "msgs"
+
 // hydrateTxResult converts a `Transaction` proto into a `TxResult` struct` after doing basic validation
-// and extracting the relevant data from the embedded signed Message. `index` is the intended location
+// and extracting the relevant data from the embedded signed Messages. `index` is the intended location
 // of its index (i.e. the transaction number) in the block where it is included.
 //
+// The state is left untouched if an error is returned. Otherwise, the fee is always paid, but the messages
+// are applied atomically: if any of them fails, the changes made by all of them are reverted.
+//
 // IMPROVE: hydration should accept and return the same type (i.e. TxResult) so there may be opportunity
 // to refactor this in the future.
 func (u *baseUtilityUnitOfWork) hydrateTxResult(tx *coreTypes.Transaction, index int) (modules.TxResult, typesUtil.Error) {
-	msg, err := u.anteHandleMessage(tx)
+	txHash, er := tx.Hash()
+	if er != nil {
+		return nil, typesUtil.ErrProtoMarshal(er)
+	}
+	txHashBz, er := hex.DecodeString(txHash)
+	if er != nil {
+		return nil, typesUtil.ErrHexDecodeFromString(er)
+	}
+
+	if err := u.newSavePoint(txHashBz); err != nil {
+		return nil, err
+	}
+	msgs, err := u.anteHandleMessages(tx)
 	if err != nil {
+		if er := u.revertLastSavePoint(); er != nil {
+			return nil, er
+		}
+		return nil, err
+	}
+	if err := u.releaseLastSavePoint(); err != nil {
 		return nil, err
 	}
-	msgHandlingResult := u.handleMessage(msg)
-	return typesUtil.TxToTxResult(tx, u.height, index, msg, msgHandlingResult)
+
+	msgHandlingResults, err := u.handleMessages(txHashBz, msgs)
+	if err != nil {
+		return nil, err
+	}
+	return typesUtil.TxToTxResult(tx, u.height, index, msgs, msgHandlingResults)
+}
+
+// handleMessages applies `msgs` in order until one of them fails, in which case the changes made by all of them
+// are reverted. It returns the result of handling each of the messages applied.
+func (u *baseUtilityUnitOfWork) handleMessages(txHashBz []byte, msgs []typesUtil.Message) ([]typesUtil.Error, typesUtil.Error) {
+	if err := u.newSavePoint(append([]byte(msgsSavePointPrefix), txHashBz...)); err != nil {
+		return nil, err
+	}
+	msgHandlingResults := make([]typesUtil.Error, 0, len(msgs))
+	for _, msg := range msgs {
+		msgHandlingResult := u.handleMessage(msg)
+		msgHandlingResults = append(msgHandlingResults, msgHandlingResult)
+		if msgHandlingResult != nil {
+			if err := u.revertLastSavePoint(); err != nil {
+				return nil, err
+			}
+			return msgHandlingResults, nil
+		}
+	}
+	if err := u.releaseLastSavePoint(); err != nil {
+		return nil, err
+	}
+	return msgHandlingResults, nil
 }
 
-// anteHandleMessage handles basic validation of the message in the Transaction before it is processed
+// GetTxFee returns the sum of the fees of the messages in `tx`, according to the governance parameters at `height`
+func GetTxFee(readCtx modules.PersistenceReadContext, height int64, tx *coreTypes.Transaction) (*big.Int, typesUtil.Error) {
+	msgs, err := getTxMessages(tx)
+	if err != nil {
+		return nil, err
+	}
+	u := &baseUtilityUnitOfWork{
+		height:                 height,
+		persistenceReadContext: readCtx,
+		logger:                 logger.Global.CreateLoggerForModule(feeUtilityUOWModuleName),
+	}
+	return u.getTxFee(msgs)
+}
+
+// getTxMessages returns the messages of `tx`, which must all be utility messages
+func getTxMessages(tx *coreTypes.Transaction) ([]typesUtil.Message, typesUtil.Error) {
+	anyMsgs, err := tx.GetMessages()
+	if err != nil {
+		return nil, typesUtil.ErrDecodeMessage(err)
+	}
+	msgs := make([]typesUtil.Message, len(anyMsgs))
+	for i, anyMsg := range anyMsgs {
+		msg, ok := anyMsg.(typesUtil.Message)
+		if !ok {
+			return nil, typesUtil.ErrDecodeMessage(fmt.Errorf("not a supported message type"))
+		}
+		msgs[i] = msg
+	}
+	return msgs, nil
+}
+
+// validateTxExpiryHeight checks that the transaction did not expire, and that its expiry height is at most
+// `max_transaction_expiry_blocks` after the current height
+func (u *baseUtilityUnitOfWork) validateTxExpiryHeight(tx *coreTypes.Transaction) typesUtil.Error {
+	if tx.ExpiryHeight < u.height {
+		return typesUtil.ErrTransactionExpired(tx.ExpiryHeight, u.height)
+	}
+	maxExpiryBlocks, err := u.getMaxTransactionExpiryBlocks()
+	if err != nil {
+		return err
+	}
+	if maxExpiryHeight := u.height + maxExpiryBlocks; tx.ExpiryHeight > maxExpiryHeight {
+		return typesUtil.ErrTransactionExpiryTooFar(tx.ExpiryHeight, maxExpiryHeight)
+	}
+	return nil
+}
+
+// getTxFee returns the sum of the fees of `msgs`
+func (u *baseUtilityUnitOfWork) getTxFee(msgs []typesUtil.Message) (*big.Int, typesUtil.Error) {
+	fee := big.NewInt(0)
+	for _, msg := range msgs {
+		msgFee, err := u.getFee(msg, msg.GetActorType())
+		if err != nil {
+			return nil, err
+		}
+		fee.Add(fee, msgFee)
+	}
+	return fee, nil
+}
+
+// anteHandleMessages handles basic validation of the messages in the Transaction before they are processed,
+// and charges the signer the sum of their fees
 // REFACTOR: Splitting this into a `feeValidation`, `signerValidation`, and `messageValidation` etc
 // would make it more modular and readable.
-func (u *baseUtilityUnitOfWork) anteHandleMessage(tx *coreTypes.Transaction) (typesUtil.Message, typesUtil.Error) {
-	// Check if the transaction has a valid message
-	anyMsg, er := tx.GetMessage()
-	if er != nil {
-		return nil, typesUtil.ErrDecodeMessage(er)
+func (u *baseUtilityUnitOfWork) anteHandleMessages(tx *coreTypes.Transaction) ([]typesUtil.Message, typesUtil.Error) {
+	// Check that the transaction can still be included in a block, and that its replay window is bounded
+	if err := u.validateTxExpiryHeight(tx); err != nil {
+		return nil, err
 	}
-	msg, ok := anyMsg.(typesUtil.Message)
-	if !ok {
-		return nil, typesUtil.ErrDecodeMessage(fmt.Errorf("not a supported message type"))
+
+	// Check if the transaction has valid messages
+	msgs, err := getTxMessages(tx)
+	if err != nil {
+		return nil, err
 	}
 
-	// Get the address of the transaction signer
-	pubKey, er := crypto.NewPublicKeyFromBytes(tx.Signature.PublicKey)
+	// Get the address of the transaction signer, which may be a multisig account
+	address, er := tx.SignerAddress()
 	if er != nil {
 		return nil, typesUtil.ErrNewPublicKeyFromBytes(er)
 	}
-	address := pubKey.Address()
 	addressHex := address.ToString()
 
-	// Validate that the signer has enough funds to pay the fee of the message signed
-	fee, err := u.getFee(msg, msg.GetActorType())
+	// Validate that the signer has enough funds to pay the sum of the fees of the messages signed
+	fee, err := u.getTxFee(msgs)
 	if err != nil {
 		return nil, err
 	}
@@ -60,22 +175,28 @@ func (u *baseUtilityUnitOfWork) anteHandleMessage(tx *coreTypes.Transaction) (ty
 	if accountAmount.Sign() == -1 {
 		return nil, typesUtil.ErrInsufficientAmount(addressHex)
 	}
-
-	// Validate that the signer has a valid signature
-	var isValidSigner bool
-	signerCandidates, err := u.getSignerCandidates(msg)
-	if err != nil {
+	// Fees can only be paid with the tokens that vested
+	if err := u.checkVestedAmount(address, accountAmount); err != nil {
 		return nil, err
 	}
-	for _, candidate := range signerCandidates {
-		if bytes.Equal(candidate, address) {
-			isValidSigner = true
-			msg.SetSigner(address)
-			break
+
+	// Validate that the signer has a valid signature for every message
+	for _, msg := range msgs {
+		var isValidSigner bool
+		signerCandidates, err := u.getSignerCandidates(msg)
+		if err != nil {
+			return nil, err
+		}
+		for _, candidate := range signerCandidates {
+			if bytes.Equal(candidate, address) {
+				isValidSigner = true
+				msg.SetSigner(address)
+				break
+			}
+		}
+		if !isValidSigner {
+			return nil, typesUtil.ErrInvalidSigner(addressHex)
 		}
-	}
-	if !isValidSigner {
-		return nil, typesUtil.ErrInvalidSigner(addressHex)
 	}
 
 	// Remove the fee from the signer's account and add it to the fee collector pool
@@ -86,5 +207,5 @@ func (u *baseUtilityUnitOfWork) anteHandleMessage(tx *coreTypes.Transaction) (ty
 		return nil, err
 	}
 
-	return msg, nil
+	return msgs, nil
 }
@@ -10,6 +10,7 @@ import (
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"github.com/pokt-network/pocket/shared/crypto"
 	"github.com/pokt-network/pocket/shared/utils"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -21,9 +22,10 @@ func TestUtilityUnitOfWork_AnteHandleMessage(t *testing.T) {
 	uow := newTestingUtilityUnitOfWork(t, 0)
 
 	tx, startingBalance, _, signer := newTestingTransaction(t, uow)
-	msg, err := uow.anteHandleMessage(tx)
+	msgs, err := uow.anteHandleMessages(tx)
 	require.NoError(t, err)
-	require.Equal(t, signer.Address().Bytes(), msg.GetSigner())
+	require.Len(t, msgs, 1)
+	require.Equal(t, signer.Address().Bytes(), msgs[0].GetSigner())
 	feeBig, err := uow.getMessageSendFee()
 	require.NoError(t, err)
 
@@ -33,6 +35,64 @@ func TestUtilityUnitOfWork_AnteHandleMessage(t *testing.T) {
 	require.Equal(t, expectedAfterBalance, amount, "unexpected after balance")
 }
 
+func TestUtilityUnitOfWork_AnteHandleMessage_ExpiryHeight(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+	maxExpiryBlocks, err := uow.getMaxTransactionExpiryBlocks()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		expiryHeight int64
+		wantErrCode  typesUtil.Code
+	}{
+		{"expiring at the current height", uow.height, 0},
+		{"expiring at the max expiry height", uow.height + maxExpiryBlocks, 0},
+		{"expired", uow.height - 1, typesUtil.CodeTransactionExpiredError},
+		{"expiring after the max expiry height", uow.height + maxExpiryBlocks + 1, typesUtil.CodeTransactionExpiryTooFarError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, _, _, signer := newTestingTransaction(t, uow)
+			tx.ExpiryHeight = tt.expiryHeight
+			require.NoError(t, tx.Sign(signer))
+
+			_, err := uow.anteHandleMessages(tx)
+			if tt.wantErrCode == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Equal(t, tt.wantErrCode, err.Code())
+		})
+	}
+}
+
+func TestUtilityUnitOfWork_AnteHandleMessage_Vesting(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+
+	// The whole balance of the signer is locked until the cliff, so it cannot pay the fee
+	tx, startingBalance, _, signer := newTestingTransaction(t, uow)
+	schedule := &coreTypes.VestingSchedule{
+		Address:     signer.Address().String(),
+		Amount:      utils.BigIntToString(startingBalance),
+		StartHeight: 0,
+		CliffHeight: 10,
+		EndHeight:   100,
+	}
+	require.NoError(t, uow.persistenceRWContext.SetVestingSchedule(schedule))
+	_, err := uow.anteHandleMessages(tx)
+	require.Error(t, err)
+	require.Equal(t, typesUtil.CodeInsufficientVestedAmountError, err.Code())
+
+	// The tokens that are not locked can pay the fee
+	feeBig, err := uow.getMessageSendFee()
+	require.NoError(t, err)
+	schedule.Amount = utils.BigIntToString(new(big.Int).Sub(startingBalance, feeBig))
+	require.NoError(t, uow.persistenceRWContext.SetVestingSchedule(schedule))
+	_, err = uow.anteHandleMessages(tx)
+	require.NoError(t, err)
+}
+
 func TestUtilityUnitOfWork_ApplyTransaction(t *testing.T) {
 	uow := newTestingUtilityUnitOfWork(t, 0)
 
@@ -51,7 +111,111 @@ func TestUtilityUnitOfWork_ApplyTransaction(t *testing.T) {
 	require.Equal(t, expectedAfterBalance, amount, "unexpected after balance")
 }
 
+func TestUtilityUnitOfWork_ApplyBatchTransaction(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 0)
+
+	recipients := make([]crypto.Address, 3)
+	for i := range recipients {
+		recipientAddr, err := crypto.GenerateAddress()
+		require.NoError(t, err)
+		recipients[i] = recipientAddr
+	}
+	tx, startingBalance, amount, signer := newTestingBatchTransaction(t, uow, recipients)
+	txResult, err := uow.hydrateTxResult(tx, 0)
+	require.NoError(t, err)
+	require.Equal(t, int32(0), txResult.GetResultCode())
+	require.Equal(t, "", txResult.GetError())
+	msgResults := txResult.(*typesUtil.TxResult).GetMessageResults()
+	require.Len(t, msgResults, len(recipients))
+	for i, msgResult := range msgResults {
+		require.Equal(t, int32(0), msgResult.GetResultCode())
+		require.Equal(t, recipients[i].String(), msgResult.GetRecipientAddr())
+	}
+
+	// The fee of the transaction is the sum of the fees of its messages
+	feeBig, err := uow.getMessageSendFee()
+	require.NoError(t, err)
+	numMsgs := big.NewInt(int64(len(recipients)))
+	expectedAmountSubtracted := new(big.Int).Mul(new(big.Int).Add(amount, feeBig), numMsgs)
+	expectedAfterBalance := new(big.Int).Sub(startingBalance, expectedAmountSubtracted)
+	signerBalance, err := uow.getAccountAmount(signer.Address())
+	require.NoError(t, err)
+	require.Equal(t, expectedAfterBalance, signerBalance, "unexpected after balance")
+	for _, recipient := range recipients {
+		recipientBalance, err := uow.getAccountAmount(recipient)
+		require.NoError(t, err)
+		require.Equal(t, amount, recipientBalance, "unexpected recipient balance")
+	}
+}
+
+func TestUtilityUnitOfWork_ApplyBatchTransaction_Atomic(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 0)
+
+	recipients := make([]crypto.Address, 2)
+	for i := range recipients {
+		recipientAddr, err := crypto.GenerateAddress()
+		require.NoError(t, err)
+		recipients[i] = recipientAddr
+	}
+	tx, startingBalance, _, signer := newTestingBatchTransaction(t, uow, recipients)
+
+	// The second message sends more than the signer owns
+	msgSend := NewTestingSendMessage(t, signer.Address(), recipients[1], utils.BigIntToString(startingBalance))
+	anyMsg, err := codec.GetCodec().ToAny(&msgSend)
+	require.NoError(t, err)
+	tx.Msgs[1] = anyMsg
+	require.NoError(t, tx.Sign(signer))
+
+	txResult, er := uow.hydrateTxResult(tx, 0)
+	require.NoError(t, er)
+	require.Equal(t, int32(typesUtil.CodeInsufficientAmountError), txResult.GetResultCode())
+	msgResults := txResult.(*typesUtil.TxResult).GetMessageResults()
+	require.Len(t, msgResults, 2)
+	require.Equal(t, int32(0), msgResults[0].GetResultCode())
+	require.Equal(t, int32(typesUtil.CodeInsufficientAmountError), msgResults[1].GetResultCode())
+
+	// The fee is paid, but the message that succeeded is reverted
+	feeBig, er := uow.getMessageSendFee()
+	require.NoError(t, er)
+	expectedAfterBalance := new(big.Int).Sub(startingBalance, new(big.Int).Mul(feeBig, big.NewInt(2)))
+	signerBalance, er := uow.getAccountAmount(signer.Address())
+	require.NoError(t, er)
+	require.Equal(t, expectedAfterBalance, signerBalance, "unexpected after balance")
+	recipientBalance, er := uow.getAccountAmount(recipients[0])
+	require.NoError(t, er)
+	require.Equal(t, int64(0), recipientBalance.Int64(), "the first message should have been reverted")
+}
+
 // TODO(@deblasis): refactor this to test HandleTransaction specifically in the utility package
+func TestUtilityUnitOfWork_SimulateTransaction(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 0)
+	simulationUOW := NewSimulationUOW(uow.height, uow.persistenceRWContext)
+	simulationUOW.SetBus(uow.GetBus())
+
+	tx, startingBalance, _, signer := newTestingTransaction(t, uow)
+	expectedFee, err := uow.getMessageSendFee()
+	require.NoError(t, err)
+
+	txResult, fee, err := simulationUOW.SimulateTransaction(tx)
+	require.NoError(t, err)
+	require.Equal(t, int32(0), txResult.GetResultCode())
+	require.Equal(t, "", txResult.GetError())
+	require.Equal(t, expectedFee, fee)
+
+	// The changes made by the simulated transaction are reverted
+	amount, err := uow.getAccountAmount(signer.Address())
+	require.NoError(t, err)
+	require.Equal(t, startingBalance, amount, "unexpected balance after simulation")
+
+	// A transaction whose signer cannot pay the fee is reported as rejected
+	require.NoError(t, uow.setAccountAmount(signer.Address(), big.NewInt(0)))
+	txResult, fee, err = simulationUOW.SimulateTransaction(tx)
+	require.NoError(t, err)
+	require.Equal(t, int32(typesUtil.CodeInsufficientAmountError), txResult.GetResultCode())
+	require.NotEmpty(t, txResult.GetError())
+	require.Equal(t, expectedFee, fee)
+}
+
 // func TestUtilityUnitOfWork_HandleTransaction(t *testing.T) {
 // 	ctx := newTestingUtilityContext(t, 0)
 // 	tx, _, _, _ := newTestingTransaction(t, ctx)
@@ -147,8 +311,34 @@ func newTestingTransaction(t *testing.T, uow *baseUtilityUnitOfWork) (tx *coreTy
 	require.NoError(t, err)
 
 	tx = &coreTypes.Transaction{
-		Msg:   any,
-		Nonce: testNonce,
+		Msg:          any,
+		Nonce:        testNonce,
+		ExpiryHeight: uow.height + 1,
+	}
+	require.NoError(t, tx.Sign(signer))
+
+	return
+}
+
+func newTestingBatchTransaction(t *testing.T, uow *baseUtilityUnitOfWork, recipients []crypto.Address) (tx *coreTypes.Transaction, startingBalance, amountSent *big.Int, signer crypto.PrivateKey) {
+	amountSent = new(big.Int).Set(defaultSendAmount)
+	startingBalance = new(big.Int).Set(test_artifacts.DefaultAccountAmount)
+
+	signer, err := crypto.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	signerAddr := signer.Address()
+	require.NoError(t, uow.setAccountAmount(signerAddr, startingBalance))
+
+	tx = &coreTypes.Transaction{
+		Nonce:        testNonce,
+		ExpiryHeight: uow.height + 1,
+	}
+	for _, recipientAddr := range recipients {
+		msg := NewTestingSendMessage(t, signerAddr, recipientAddr, utils.BigIntToString(amountSent))
+		anyMsg, err := codec.GetCodec().ToAny(&msg)
+		require.NoError(t, err)
+		tx.Msgs = append(tx.Msgs, anyMsg)
 	}
 	require.NoError(t, tx.Sign(signer))
 
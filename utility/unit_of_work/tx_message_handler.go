@@ -2,12 +2,16 @@ package unit_of_work
 
 import (
 	"encoding/hex"
+	"fmt"
 	"math/big"
 
+	typesCons "github.com/pokt-network/pocket/consensus/types"
 	"github.com/pokt-network/pocket/shared/codec"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"github.com/pokt-network/pocket/shared/crypto"
 	"github.com/pokt-network/pocket/shared/utils"
+	"github.com/pokt-network/pocket/utility/service"
+	"github.com/pokt-network/pocket/utility/session"
 	typesUtil "github.com/pokt-network/pocket/utility/types"
 )
 
@@ -21,10 +25,36 @@ func (u *baseUtilityUnitOfWork) handleMessage(msg typesUtil.Message) (err typesU
 		return u.handleEditStakeMessage(x)
 	case *typesUtil.MessageUnstake:
 		return u.handleUnstakeMessage(x)
+	case *typesUtil.MessagePause:
+		return u.handlePauseMessage(x)
 	case *typesUtil.MessageUnpause:
 		return u.handleUnpauseMessage(x)
 	case *typesUtil.MessageChangeParameter:
 		return u.handleMessageChangeParameter(x)
+	case *typesUtil.MessageClaim:
+		return u.handleMessageClaim(x)
+	case *typesUtil.MessageProof:
+		return u.handleMessageProof(x)
+	case *typesUtil.MessageTestScore:
+		return u.handleMessageTestScore(x)
+	case *typesUtil.MessageProveTestScore:
+		return u.handleMessageProveTestScore(x)
+	case *typesUtil.MessageFishermanPauseServicer:
+		return u.handleMessageFishermanPauseServicer(x)
+	case *typesUtil.MessageDoubleSign:
+		return u.handleMessageDoubleSign(x)
+	case *typesUtil.MessageDelegate:
+		return u.handleMessageDelegate(x)
+	case *typesUtil.MessageUndelegate:
+		return u.handleMessageUndelegate(x)
+	case *typesUtil.MessageSubmitProposal:
+		return u.handleMessageSubmitProposal(x)
+	case *typesUtil.MessageVote:
+		return u.handleMessageVote(x)
+	case *typesUtil.MessageScheduleUpgrade:
+		return u.handleMessageScheduleUpgrade(x)
+	case *typesUtil.MessageRotateOperatorKey:
+		return u.handleMessageRotateOperatorKey(x)
 	default:
 		return typesUtil.ErrUnknownMessage(x)
 	}
@@ -48,6 +78,10 @@ func (u *baseUtilityUnitOfWork) handleMessageSend(message *typesUtil.MessageSend
 	if fromAccountAmount.Sign() == -1 {
 		return typesUtil.ErrInsufficientAmount(hex.EncodeToString(message.FromAddress))
 	}
+	// the sender can only spend its vested tokens
+	if err := u.checkVestedAmount(message.FromAddress, fromAccountAmount); err != nil {
+		return err
+	}
 	// add the amount to the recipient's account
 	if err := u.addAccountAmount(message.ToAddress, amount); err != nil {
 		return err
@@ -79,10 +113,19 @@ func (u *baseUtilityUnitOfWork) handleStakeMessage(message *typesUtil.MessageSta
 	if signerAccountAmount.Sign() == -1 {
 		return typesUtil.ErrInsufficientAmount(hex.EncodeToString(message.Signer))
 	}
+	if err := u.checkVestedAmount(message.Signer, signerAccountAmount); err != nil {
+		return err
+	}
 	// validators don't have chains field
 	if err := u.checkBelowMaxChains(message.ActorType, message.Chains); err != nil {
 		return err
 	}
+	if err := u.checkRelayChainsRegistered(message.ActorType, message.Chains); err != nil {
+		return err
+	}
+	if err := u.checkGeoZoneAllowed(message.GeoZone); err != nil {
+		return err
+	}
 	// ensure actor doesn't already exist
 	if exists, err := u.getActorExists(message.ActorType, publicKey.Address()); err != nil || exists {
 		if exists {
@@ -102,11 +145,11 @@ func (u *baseUtilityUnitOfWork) handleStakeMessage(message *typesUtil.MessageSta
 	// insert actor
 	switch message.ActorType {
 	case coreTypes.ActorType_ACTOR_TYPE_APP:
-		er = u.persistenceRWContext.InsertApp(publicKey.Address(), publicKey.Bytes(), message.OutputAddress, false, int32(coreTypes.StakeStatus_Staked), message.Amount, message.Chains, typesUtil.HeightNotUsed, typesUtil.HeightNotUsed)
+		er = u.persistenceRWContext.InsertApp(publicKey.Address(), publicKey.Bytes(), message.OutputAddress, false, int32(coreTypes.StakeStatus_Staked), message.Amount, message.Chains, message.GeoZone, typesUtil.HeightNotUsed, typesUtil.HeightNotUsed)
 	case coreTypes.ActorType_ACTOR_TYPE_FISH:
-		er = u.persistenceRWContext.InsertFisherman(publicKey.Address(), publicKey.Bytes(), message.OutputAddress, false, int32(coreTypes.StakeStatus_Staked), message.ServiceUrl, message.Amount, message.Chains, typesUtil.HeightNotUsed, typesUtil.HeightNotUsed)
+		er = u.persistenceRWContext.InsertFisherman(publicKey.Address(), publicKey.Bytes(), message.OutputAddress, false, int32(coreTypes.StakeStatus_Staked), message.ServiceUrl, message.Amount, message.Chains, message.GeoZone, typesUtil.HeightNotUsed, typesUtil.HeightNotUsed)
 	case coreTypes.ActorType_ACTOR_TYPE_SERVICER:
-		er = u.persistenceRWContext.InsertServicer(publicKey.Address(), publicKey.Bytes(), message.OutputAddress, false, int32(coreTypes.StakeStatus_Staked), message.ServiceUrl, message.Amount, message.Chains, typesUtil.HeightNotUsed, typesUtil.HeightNotUsed)
+		er = u.persistenceRWContext.InsertServicer(publicKey.Address(), publicKey.Bytes(), message.OutputAddress, false, int32(coreTypes.StakeStatus_Staked), message.ServiceUrl, message.Amount, message.Chains, message.GeoZone, typesUtil.HeightNotUsed, typesUtil.HeightNotUsed)
 	case coreTypes.ActorType_ACTOR_TYPE_VAL:
 		er = u.persistenceRWContext.InsertValidator(publicKey.Address(), publicKey.Bytes(), message.OutputAddress, false, int32(coreTypes.StakeStatus_Staked), message.ServiceUrl, message.Amount, typesUtil.HeightNotUsed, typesUtil.HeightNotUsed)
 	}
@@ -146,9 +189,18 @@ func (u *baseUtilityUnitOfWork) handleEditStakeMessage(message *typesUtil.Messag
 	if signerAccountAmount.Sign() == -1 {
 		return typesUtil.ErrInsufficientAmount(hex.EncodeToString(message.Signer))
 	}
+	if err := u.checkVestedAmount(message.Signer, signerAccountAmount); err != nil {
+		return err
+	}
 	if err := u.checkBelowMaxChains(message.ActorType, message.Chains); err != nil {
 		return err
 	}
+	if err := u.checkRelayChainsRegistered(message.ActorType, message.Chains); err != nil {
+		return err
+	}
+	if err := u.checkGeoZoneAllowed(message.GeoZone); err != nil {
+		return err
+	}
 	// update account amount
 	if err := u.setAccountAmount(message.Signer, signerAccountAmount); err != nil {
 		return err
@@ -159,11 +211,11 @@ func (u *baseUtilityUnitOfWork) handleEditStakeMessage(message *typesUtil.Messag
 	}
 	switch message.ActorType {
 	case coreTypes.ActorType_ACTOR_TYPE_APP:
-		er = u.persistenceRWContext.UpdateApp(message.Address, message.Amount, message.Chains)
+		er = u.persistenceRWContext.UpdateApp(message.Address, message.Amount, message.Chains, message.GeoZone)
 	case coreTypes.ActorType_ACTOR_TYPE_FISH:
-		er = u.persistenceRWContext.UpdateFisherman(message.Address, message.ServiceUrl, message.Amount, message.Chains)
+		er = u.persistenceRWContext.UpdateFisherman(message.Address, message.ServiceUrl, message.Amount, message.Chains, message.GeoZone)
 	case coreTypes.ActorType_ACTOR_TYPE_SERVICER:
-		er = u.persistenceRWContext.UpdateServicer(message.Address, message.ServiceUrl, message.Amount, message.Chains)
+		er = u.persistenceRWContext.UpdateServicer(message.Address, message.ServiceUrl, message.Amount, message.Chains, message.GeoZone)
 	case coreTypes.ActorType_ACTOR_TYPE_VAL:
 		er = u.persistenceRWContext.UpdateValidator(message.Address, message.ServiceUrl, message.Amount)
 	}
@@ -190,6 +242,26 @@ func (u *baseUtilityUnitOfWork) handleUnstakeMessage(message *typesUtil.MessageU
 	return nil
 }
 
+// handlePauseMessage pauses a staked actor at the current height. It can only be unpaused once the minimum number of
+// pause blocks of its actor type has passed.
+func (u *baseUtilityUnitOfWork) handlePauseMessage(message *typesUtil.MessagePause) typesUtil.Error {
+	status, err := u.getActorStatus(message.ActorType, message.Address)
+	if err != nil {
+		return err
+	}
+	if status != coreTypes.StakeStatus_Staked {
+		return typesUtil.ErrInvalidStatus(status, coreTypes.StakeStatus_Staked)
+	}
+	pausedHeight, err := u.getPausedHeightIfExists(message.ActorType, message.Address)
+	if err != nil {
+		return err
+	}
+	if pausedHeight != typesUtil.HeightNotUsed {
+		return typesUtil.ErrAlreadyPaused()
+	}
+	return u.setActorPausedHeight(message.ActorType, message.Address, u.height)
+}
+
 func (u *baseUtilityUnitOfWork) handleUnpauseMessage(message *typesUtil.MessageUnpause) typesUtil.Error {
 	pausedHeight, err := u.getPausedHeightIfExists(message.ActorType, message.Address)
 	if err != nil {
@@ -219,6 +291,579 @@ func (u *baseUtilityUnitOfWork) handleMessageChangeParameter(message *typesUtil.
 	return u.updateParam(message.ParameterKey, v)
 }
 
+func (u *baseUtilityUnitOfWork) handleMessageClaim(message *typesUtil.MessageClaim) typesUtil.Error {
+	blocksPerSession, err := u.getBlocksPerSessionAt(message.SessionHeight)
+	if err != nil {
+		return err
+	}
+	if message.SessionHeight%blocksPerSession != 0 {
+		return typesUtil.ErrInvalidClaim(fmt.Sprintf("no session starts at height %d", message.SessionHeight))
+	}
+	// a session can only be claimed once it ended, and until the end of the session that follows it
+	if u.height < message.SessionHeight+blocksPerSession || u.height >= message.SessionHeight+2*blocksPerSession {
+		return typesUtil.ErrInvalidClaim(fmt.Sprintf("the session started at height %d cannot be claimed at height %d", message.SessionHeight, u.height))
+	}
+
+	// dispatch the claimed session to ensure the servicer was part of it
+	servicer, er := u.persistenceReadContext.GetActor(coreTypes.ActorType_ACTOR_TYPE_SERVICER, message.ServicerAddress, message.SessionHeight)
+	if er != nil {
+		return typesUtil.ErrInvalidClaim(fmt.Sprintf("the servicer is not staked: %s", er.Error()))
+	}
+	claimedSession, err := u.dispatchClaimedSession(message.ApplicationPublicKey, message.SessionHeight, message.RelayChain, message.GeoZone)
+	if err != nil {
+		return err
+	}
+	servicers := claimedSession.GetServicers()
+	if !isActorInList(servicer.GetPublicKey(), servicers) {
+		return typesUtil.ErrServicerNotInSession()
+	}
+
+	// ensure the servicer does not claim more relays than the application could send to it
+	maxRelays := claimedSession.GetMaxRelaysPerServicer()
+	if new(big.Int).SetUint64(message.TotalRelays).Cmp(maxRelays) > 0 {
+		return typesUtil.ErrInvalidClaim(fmt.Sprintf("%d relays claimed, above the limit of %s", message.TotalRelays, maxRelays))
+	}
+
+	// a session can only be claimed once by each servicer
+	existingClaim, er := u.persistenceReadContext.GetClaim(message.ServicerAddress, claimedSession.GetSessionID(), u.height)
+	if er != nil {
+		return typesUtil.ErrGetClaim(er)
+	}
+	if existingClaim != nil {
+		return typesUtil.ErrInvalidClaim("the session was already claimed")
+	}
+
+	claim := &coreTypes.Claim{
+		ServicerAddress:      hex.EncodeToString(message.ServicerAddress),
+		SessionId:            hex.EncodeToString(claimedSession.GetSessionID()),
+		SessionHeight:        message.SessionHeight,
+		RelayChain:           message.RelayChain,
+		GeoZone:              message.GeoZone,
+		ApplicationPublicKey: hex.EncodeToString(message.ApplicationPublicKey),
+		RootHash:             hex.EncodeToString(message.RootHash),
+		TotalRelays:          message.TotalRelays,
+		Height:               u.height,
+	}
+	if er := u.persistenceRWContext.InsertClaim(claim); er != nil {
+		return typesUtil.ErrInsert(er)
+	}
+	return nil
+}
+
+func (u *baseUtilityUnitOfWork) handleMessageProof(message *typesUtil.MessageProof) typesUtil.Error {
+	claim, er := u.persistenceReadContext.GetClaim(message.ServicerAddress, message.SessionId, u.height)
+	if er != nil {
+		return typesUtil.ErrGetClaim(er)
+	}
+	if claim == nil {
+		return typesUtil.ErrClaimNotFound()
+	}
+
+	// the proven relay is selected using the hash of the block the claim was committed in, so the proof must
+	// be submitted after that block and before the claim expires
+	expirationBlocks, err := u.getClaimExpirationBlocks()
+	if err != nil {
+		return err
+	}
+	if u.height <= claim.Height {
+		return typesUtil.ErrInvalidProof("the claim is not committed yet")
+	}
+	if u.height >= claim.Height+expirationBlocks {
+		return typesUtil.ErrInvalidProof("the claim expired")
+	}
+
+	// ensure the relay belongs to the claimed session
+	servicer, er := u.persistenceReadContext.GetActor(coreTypes.ActorType_ACTOR_TYPE_SERVICER, message.ServicerAddress, claim.SessionHeight)
+	if er != nil {
+		return typesUtil.ErrInvalidProof(fmt.Sprintf("the servicer is not staked: %s", er.Error()))
+	}
+	blocksPerSession, err := u.getBlocksPerSessionAt(claim.SessionHeight)
+	if err != nil {
+		return err
+	}
+	meta := message.Relay.GetMeta()
+	switch {
+	case meta.GetServicerPublicKey() != servicer.GetPublicKey():
+		return typesUtil.ErrInvalidProof("the relay is addressed to a different servicer")
+	case meta.GetToken().GetApplicationPublicKey() != claim.ApplicationPublicKey:
+		return typesUtil.ErrInvalidProof("the relay was sent on behalf of a different application")
+	case meta.GetRelayChain() != claim.RelayChain || meta.GetGeoZone() != claim.GeoZone:
+		return typesUtil.ErrInvalidProof("the relay was sent to a different relay chain or geo zone")
+	case meta.GetBlockHeight()-meta.GetBlockHeight()%blocksPerSession != claim.SessionHeight:
+		return typesUtil.ErrRelayOutOfSession(meta.GetBlockHeight(), claim.SessionHeight)
+	}
+	if err := service.ValidateRelaySignatures(message.Relay); err != nil {
+		return err
+	}
+
+	// verify the relay is the claimed one selected by the network
+	claimBlockHash, er := u.persistenceReadContext.GetBlockHash(claim.Height)
+	if er != nil {
+		return typesUtil.ErrGetBlockHash(er)
+	}
+	target, err := typesUtil.GetClaimProofTarget(claim, claimBlockHash)
+	if err != nil {
+		return err
+	}
+	leaf, err := typesUtil.NewRelayMerkleSumLeaf(message.Relay)
+	if err != nil {
+		return err
+	}
+	rootHash, er := hex.DecodeString(claim.RootHash)
+	if er != nil {
+		return typesUtil.ErrHexDecodeFromString(er)
+	}
+	if !typesUtil.VerifyMerkleSumProof(rootHash, claim.TotalRelays, leaf, message.Proof, target) {
+		return typesUtil.ErrInvalidProof("merkle sum proof verification failed")
+	}
+
+	// the proven volume is capped at the relays the application could send to the servicer with the stake it had when
+	// the session started, and rewarded to the output address the servicer had during the session at the end of the block
+	appPubKey, er := hex.DecodeString(claim.ApplicationPublicKey)
+	if er != nil {
+		return typesUtil.ErrHexDecodeFromString(er)
+	}
+	claimedSession, err := u.dispatchClaimedSession(appPubKey, claim.SessionHeight, claim.RelayChain, claim.GeoZone)
+	if err != nil {
+		return err
+	}
+	provenRelays := claim.TotalRelays
+	if maxRelays := claimedSession.GetMaxRelaysPerServicer(); new(big.Int).SetUint64(provenRelays).Cmp(maxRelays) > 0 {
+		provenRelays = maxRelays.Uint64()
+	}
+
+	if er := u.persistenceRWContext.DeleteClaim(message.ServicerAddress, message.SessionId); er != nil {
+		return typesUtil.ErrDeleteClaim(er)
+	}
+	u.addProvenRelays(servicer.GetOutput(), provenRelays)
+	return nil
+}
+
+// dispatchClaimedSession dispatches the session started at `sessionHeight` for the application with `appPubKey`, loading
+// the application as it was when the session started so its stake, and the relays it could send, cannot change afterwards
+func (u *baseUtilityUnitOfWork) dispatchClaimedSession(appPubKey []byte, sessionHeight int64, relayChain, geoZone string) (session.Session, typesUtil.Error) {
+	pubKey, er := crypto.NewPublicKeyFromBytes(appPubKey)
+	if er != nil {
+		return nil, typesUtil.ErrNewPublicKeyFromBytes(er)
+	}
+	application, er := u.persistenceReadContext.GetActor(coreTypes.ActorType_ACTOR_TYPE_APP, pubKey.Address(), sessionHeight)
+	if er != nil {
+		return nil, typesUtil.ErrInvalidClaim(fmt.Sprintf("the application is not staked: %s", er.Error()))
+	}
+	return session.NewSession(u.persistenceReadContext, sessionHeight, session.RelayChain(relayChain), session.GeoZone(geoZone), application)
+}
+
+func (u *baseUtilityUnitOfWork) handleMessageTestScore(message *typesUtil.MessageTestScore) typesUtil.Error {
+	blocksPerSession, err := u.getBlocksPerSessionAt(message.SessionHeight)
+	if err != nil {
+		return err
+	}
+	if message.SessionHeight%blocksPerSession != 0 {
+		return typesUtil.ErrInvalidTestScore(fmt.Sprintf("no session starts at height %d", message.SessionHeight))
+	}
+	// like claims, test scores can only be submitted once the session ended, and until the end of the session that follows it
+	if u.height < message.SessionHeight+blocksPerSession || u.height >= message.SessionHeight+2*blocksPerSession {
+		return typesUtil.ErrInvalidTestScore(fmt.Sprintf("the session started at height %d cannot be scored at height %d", message.SessionHeight, u.height))
+	}
+
+	// dispatch the tested session to ensure both the fisherman and the servicer were part of it
+	appPubKey, er := crypto.NewPublicKeyFromBytes(message.ApplicationPublicKey)
+	if er != nil {
+		return typesUtil.ErrNewPublicKeyFromBytes(er)
+	}
+	application, er := u.persistenceReadContext.GetActor(coreTypes.ActorType_ACTOR_TYPE_APP, appPubKey.Address(), message.SessionHeight)
+	if er != nil {
+		return typesUtil.ErrInvalidTestScore(fmt.Sprintf("the application is not staked: %s", er.Error()))
+	}
+	fisherman, er := u.persistenceReadContext.GetActor(coreTypes.ActorType_ACTOR_TYPE_FISH, message.FishermanAddress, message.SessionHeight)
+	if er != nil {
+		return typesUtil.ErrInvalidTestScore(fmt.Sprintf("the fisherman is not staked: %s", er.Error()))
+	}
+	servicer, er := u.persistenceReadContext.GetActor(coreTypes.ActorType_ACTOR_TYPE_SERVICER, message.ServicerAddress, message.SessionHeight)
+	if er != nil {
+		return typesUtil.ErrInvalidTestScore(fmt.Sprintf("the servicer is not staked: %s", er.Error()))
+	}
+	testedSession, err := session.NewSession(u.persistenceReadContext, message.SessionHeight, session.RelayChain(message.RelayChain), session.GeoZone(message.GeoZone), application)
+	if err != nil {
+		return err
+	}
+	if !isActorInList(fisherman.GetPublicKey(), testedSession.GetFishermen()) {
+		return typesUtil.ErrInvalidTestScore("the fisherman is not part of the session")
+	}
+	if !isActorInList(servicer.GetPublicKey(), testedSession.GetServicers()) {
+		return typesUtil.ErrServicerNotInSession()
+	}
+
+	// a servicer can only be scored once per session by each fisherman
+	existingTestScore, er := u.persistenceReadContext.GetTestScore(message.FishermanAddress, message.ServicerAddress, testedSession.GetSessionID(), u.height)
+	if er != nil {
+		return typesUtil.ErrGetTestScore(er)
+	}
+	if existingTestScore != nil {
+		return typesUtil.ErrInvalidTestScore("the servicer was already scored for the session")
+	}
+
+	testScore := &coreTypes.TestScore{
+		FishermanAddress:     hex.EncodeToString(message.FishermanAddress),
+		ServicerAddress:      hex.EncodeToString(message.ServicerAddress),
+		SessionId:            hex.EncodeToString(testedSession.GetSessionID()),
+		SessionHeight:        message.SessionHeight,
+		RelayChain:           message.RelayChain,
+		GeoZone:              message.GeoZone,
+		ApplicationPublicKey: hex.EncodeToString(message.ApplicationPublicKey),
+		RootHash:             hex.EncodeToString(message.RootHash),
+		TotalTests:           message.TotalTests,
+		SuccessfulTests:      message.SuccessfulTests,
+		Proven:               false,
+		Height:               u.height,
+	}
+	if er := u.persistenceRWContext.InsertTestScore(testScore); er != nil {
+		return typesUtil.ErrInsert(er)
+	}
+	return nil
+}
+
+func (u *baseUtilityUnitOfWork) handleMessageProveTestScore(message *typesUtil.MessageProveTestScore) typesUtil.Error {
+	testScore, er := u.persistenceReadContext.GetTestScore(message.FishermanAddress, message.ServicerAddress, message.SessionId, u.height)
+	if er != nil {
+		return typesUtil.ErrGetTestScore(er)
+	}
+	if testScore == nil {
+		return typesUtil.ErrTestScoreNotFound()
+	}
+	if testScore.Proven {
+		return typesUtil.ErrInvalidProof("the test score was already proven")
+	}
+
+	// the proven test is selected using the hash of the block the test score was committed in, so the proof must
+	// be submitted after that block and before the test score expires
+	expirationBlocks, err := u.getTestScoreExpirationBlocks()
+	if err != nil {
+		return err
+	}
+	if u.height <= testScore.Height {
+		return typesUtil.ErrInvalidProof("the test score is not committed yet")
+	}
+	if u.height >= testScore.Height+expirationBlocks {
+		return typesUtil.ErrInvalidProof("the test score expired")
+	}
+
+	// ensure the test was sent by the fisherman to the scored servicer during the session
+	fisherman, er := u.persistenceReadContext.GetActor(coreTypes.ActorType_ACTOR_TYPE_FISH, message.FishermanAddress, testScore.SessionHeight)
+	if er != nil {
+		return typesUtil.ErrInvalidProof(fmt.Sprintf("the fisherman is not staked: %s", er.Error()))
+	}
+	servicer, er := u.persistenceReadContext.GetActor(coreTypes.ActorType_ACTOR_TYPE_SERVICER, message.ServicerAddress, testScore.SessionHeight)
+	if er != nil {
+		return typesUtil.ErrInvalidProof(fmt.Sprintf("the servicer is not staked: %s", er.Error()))
+	}
+	blocksPerSession, err := u.getBlocksPerSessionAt(testScore.SessionHeight)
+	if err != nil {
+		return err
+	}
+	meta := message.TestResult.GetRelay().GetMeta()
+	switch {
+	case meta.GetServicerPublicKey() != servicer.GetPublicKey():
+		return typesUtil.ErrInvalidProof("the test relay is addressed to a different servicer")
+	case meta.GetRelayChain() != testScore.RelayChain || meta.GetGeoZone() != testScore.GeoZone:
+		return typesUtil.ErrInvalidProof("the test relay was sent to a different relay chain or geo zone")
+	case meta.GetBlockHeight()-meta.GetBlockHeight()%blocksPerSession != testScore.SessionHeight:
+		return typesUtil.ErrRelayOutOfSession(meta.GetBlockHeight(), testScore.SessionHeight)
+	}
+	if err := service.ValidateTestResultSignatures(message.TestResult, fisherman.GetPublicKey(), servicer.GetPublicKey()); err != nil {
+		return err
+	}
+
+	// verify the test result is the scored one selected by the network
+	testScoreBlockHash, er := u.persistenceReadContext.GetBlockHash(testScore.Height)
+	if er != nil {
+		return typesUtil.ErrGetBlockHash(er)
+	}
+	target, err := typesUtil.GetTestScoreProofTarget(testScore, testScoreBlockHash)
+	if err != nil {
+		return err
+	}
+	leaf, err := typesUtil.NewTestResultMerkleSumLeaf(message.TestResult)
+	if err != nil {
+		return err
+	}
+	rootHash, er := hex.DecodeString(testScore.RootHash)
+	if er != nil {
+		return typesUtil.ErrHexDecodeFromString(er)
+	}
+	// the sum of the leaf depends on the outcome of the revealed test, so the proof also checks the number of
+	// successful tests reported, with a probability proportional to the number of misreported tests
+	if !typesUtil.VerifyMerkleSumProof(rootHash, typesUtil.GetTestScoreRootSum(testScore), leaf, message.Proof, target) {
+		return typesUtil.ErrInvalidProof("merkle sum proof verification failed")
+	}
+
+	testScore.Proven = true
+	testScore.ProvenTestFailed = !typesUtil.IsTestResultSuccessful(message.TestResult)
+	if er := u.persistenceRWContext.InsertTestScore(testScore); er != nil {
+		return typesUtil.ErrInsert(er)
+	}
+	return nil
+}
+
+func (u *baseUtilityUnitOfWork) handleMessageFishermanPauseServicer(message *typesUtil.MessageFishermanPauseServicer) typesUtil.Error {
+	testScore, er := u.persistenceReadContext.GetTestScore(message.FishermanAddress, message.ServicerAddress, message.SessionId, u.height)
+	if er != nil {
+		return typesUtil.ErrGetTestScore(er)
+	}
+	if testScore == nil {
+		return typesUtil.ErrTestScoreNotFound()
+	}
+	// only a proven test score whose revealed test result is a failure can be used to pause the servicer, since the
+	// number of successful tests is self-reported by the fisherman
+	if !testScore.Proven {
+		return typesUtil.ErrInvalidTestScore("the test score is not proven")
+	}
+	if !testScore.ProvenTestFailed {
+		return typesUtil.ErrInvalidTestScore("the servicer passed the proven test")
+	}
+
+	pausedHeight, err := u.getPausedHeightIfExists(coreTypes.ActorType_ACTOR_TYPE_SERVICER, message.ServicerAddress)
+	if err != nil {
+		return err
+	}
+	if pausedHeight != typesUtil.HeightNotUsed {
+		return typesUtil.ErrAlreadyPaused()
+	}
+	// the proven failure is consumed, so the test score cannot pause the servicer again once it is unpaused. The test
+	// score is kept until it expires so the fisherman cannot score the servicer again for the same session.
+	testScore.ProvenTestFailed = false
+	if er := u.persistenceRWContext.InsertTestScore(testScore); er != nil {
+		return typesUtil.ErrInsert(er)
+	}
+	return u.setActorPausedHeight(coreTypes.ActorType_ACTOR_TYPE_SERVICER, message.ServicerAddress, u.height)
+}
+
+func (u *baseUtilityUnitOfWork) handleMessageDoubleSign(message *typesUtil.MessageDoubleSign) typesUtil.Error {
+	// the votes are validated to be for the same height by `ValidateBasic`
+	evidenceHeight := int64(message.VoteA.GetHeight())
+	maxEvidenceAge, err := u.getMaxEvidenceAgeInBlocks()
+	if err != nil {
+		return err
+	}
+	if evidenceHeight > u.height || u.height-evidenceHeight > int64(maxEvidenceAge) {
+		return typesUtil.ErrMaxEvidenceAge()
+	}
+
+	// both votes must be signed by the validator, as it was staked at the height of the votes
+	validatorAddr, er := hex.DecodeString(message.VoteA.GetPartialSignature().GetAddress())
+	if er != nil {
+		return typesUtil.ErrHexDecodeFromString(er)
+	}
+	validator, er := u.persistenceReadContext.GetActor(coreTypes.ActorType_ACTOR_TYPE_VAL, validatorAddr, evidenceHeight)
+	if er != nil {
+		return typesUtil.ErrNotExists()
+	}
+	pubKey, er := crypto.NewPublicKey(validator.GetPublicKey())
+	if er != nil {
+		return typesUtil.ErrNewPublicKeyFromBytes(er)
+	}
+	for _, vote := range []*typesCons.HotstuffMessage{message.VoteA, message.VoteB} {
+		signableBz, er := vote.SignableBytes()
+		if er != nil {
+			return typesUtil.ErrProtoMarshal(er)
+		}
+		if !pubKey.Verify(signableBz, vote.GetPartialSignature().GetSignature()) {
+			return typesUtil.ErrSignatureVerificationFailed()
+		}
+	}
+
+	// a validator can only be punished once for double signing at a given height
+	// NB: the RW context is used so evidence submitted twice in the same block is also detected
+	evidenceExists, er := u.persistenceRWContext.DoubleSignEvidenceExists(validatorAddr, evidenceHeight, u.height)
+	if er != nil {
+		return typesUtil.ErrGetDoubleSignEvidence(er)
+	}
+	if evidenceExists {
+		return typesUtil.ErrDuplicateDoubleSignEvidence()
+	}
+	return u.handleDoubleSign(validatorAddr, message.ReporterAddress, evidenceHeight)
+}
+
+// handleMessageDelegate moves tokens from the delegator's account to the validator stake pool, adding them to its
+// delegation to the validator if it already exists
+func (u *baseUtilityUnitOfWork) handleMessageDelegate(message *typesUtil.MessageDelegate) typesUtil.Error {
+	status, err := u.getActorStatus(coreTypes.ActorType_ACTOR_TYPE_VAL, message.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+	if status != coreTypes.StakeStatus_Staked {
+		return typesUtil.ErrInvalidStatus(status, coreTypes.StakeStatus_Staked)
+	}
+	amount, er := utils.StringToBigInt(message.Amount)
+	if er != nil {
+		return typesUtil.ErrStringToBigInt(er)
+	}
+	// ensure the delegator has sufficient funding for the delegation
+	delegatorAccountAmount, err := u.getAccountAmount(message.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+	delegatorAccountAmount.Sub(delegatorAccountAmount, amount)
+	if delegatorAccountAmount.Sign() == -1 {
+		return typesUtil.ErrInsufficientAmount(hex.EncodeToString(message.DelegatorAddress))
+	}
+	if err := u.checkVestedAmount(message.DelegatorAddress, delegatorAccountAmount); err != nil {
+		return err
+	}
+	delegation, err := u.getDelegation(message.DelegatorAddress, message.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+	if delegation == nil {
+		delegation = &coreTypes.Delegation{
+			DelegatorAddress: hex.EncodeToString(message.DelegatorAddress),
+			ValidatorAddress: hex.EncodeToString(message.ValidatorAddress),
+			Amount:           "0",
+			UnstakingHeight:  typesUtil.HeightNotUsed,
+		}
+	}
+	// tokens cannot be added to a delegation that is unbonding
+	if delegation.UnstakingHeight != typesUtil.HeightNotUsed {
+		return typesUtil.ErrAlreadyUndelegating()
+	}
+	delegatedAmount, er := utils.StringToBigInt(delegation.Amount)
+	if er != nil {
+		return typesUtil.ErrStringToBigInt(er)
+	}
+	delegation.Amount = utils.BigIntToString(delegatedAmount.Add(delegatedAmount, amount))
+	// move funds from account to pool
+	if err := u.setAccountAmount(message.DelegatorAddress, delegatorAccountAmount); err != nil {
+		return err
+	}
+	if err := u.addPoolAmount(coreTypes.Pools_POOLS_VALIDATOR_STAKE.FriendlyName(), amount); err != nil {
+		return err
+	}
+	return u.setDelegation(delegation)
+}
+
+// handleMessageUndelegate begins unbonding a delegation, whose tokens are returned to the delegator on the unbonding
+// schedule of validators
+func (u *baseUtilityUnitOfWork) handleMessageUndelegate(message *typesUtil.MessageUndelegate) typesUtil.Error {
+	delegation, err := u.getDelegation(message.DelegatorAddress, message.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+	if delegation == nil {
+		return typesUtil.ErrDelegationNotFound()
+	}
+	if delegation.UnstakingHeight != typesUtil.HeightNotUsed {
+		return typesUtil.ErrAlreadyUndelegating()
+	}
+	unbondingHeight, err := u.getUnbondingHeight(coreTypes.ActorType_ACTOR_TYPE_VAL)
+	if err != nil {
+		return err
+	}
+	delegation.UnstakingHeight = unbondingHeight
+	return u.setDelegation(delegation)
+}
+
+// handleMessageSubmitProposal opens the voting period of a new proposal, whose changes must all be applicable
+func (u *baseUtilityUnitOfWork) handleMessageSubmitProposal(message *typesUtil.MessageSubmitProposal) typesUtil.Error {
+	for _, change := range message.ParameterChanges {
+		if _, err := u.getParameterChangeValue(change); err != nil {
+			return err
+		}
+	}
+	votingPeriodBlocks, err := u.getGovernanceVotingPeriodBlocks()
+	if err != nil {
+		return err
+	}
+	id, err := u.getNextProposalID()
+	if err != nil {
+		return err
+	}
+	return u.setProposal(&coreTypes.Proposal{
+		Id:               id,
+		ProposerAddress:  hex.EncodeToString(message.ProposerAddress),
+		ParameterChanges: message.ParameterChanges,
+		SubmitHeight:     u.height,
+		VotingEndHeight:  u.height + votingPeriodBlocks,
+		Status:           coreTypes.ProposalStatus_PROPOSAL_STATUS_VOTING,
+	})
+}
+
+// handleMessageVote records the vote of a staked validator on a proposal that is still being voted on, replacing its
+// previous vote on the proposal if any
+func (u *baseUtilityUnitOfWork) handleMessageVote(message *typesUtil.MessageVote) typesUtil.Error {
+	status, err := u.getActorStatus(coreTypes.ActorType_ACTOR_TYPE_VAL, message.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+	if status != coreTypes.StakeStatus_Staked {
+		return typesUtil.ErrInvalidStatus(status, coreTypes.StakeStatus_Staked)
+	}
+	proposal, err := u.getProposal(message.ProposalId)
+	if err != nil {
+		return err
+	}
+	if proposal == nil {
+		return typesUtil.ErrProposalNotFound(message.ProposalId)
+	}
+	if proposal.Status != coreTypes.ProposalStatus_PROPOSAL_STATUS_VOTING || u.height > proposal.VotingEndHeight {
+		return typesUtil.ErrProposalNotVoting(message.ProposalId)
+	}
+	if er := u.persistenceRWContext.InsertVote(&coreTypes.Vote{
+		ProposalId:       message.ProposalId,
+		ValidatorAddress: hex.EncodeToString(message.ValidatorAddress),
+		Option:           message.Option,
+	}); er != nil {
+		return typesUtil.ErrSetVote(er)
+	}
+	return nil
+}
+
+// handleMessageScheduleUpgrade schedules a binary upgrade at a future height, from which consensus halts on the nodes
+// not running the binary named by the upgrade
+func (u *baseUtilityUnitOfWork) handleMessageScheduleUpgrade(message *typesUtil.MessageScheduleUpgrade) typesUtil.Error {
+	if message.ActivationHeight <= u.height {
+		return typesUtil.ErrInvalidUpgradeHeight(message.ActivationHeight, u.height)
+	}
+	if err := u.persistenceRWContext.SetUpgradePlan(&coreTypes.UpgradePlan{
+		Name:             message.Name,
+		ActivationHeight: message.ActivationHeight,
+	}); err != nil {
+		return typesUtil.ErrSetUpgradePlan(err)
+	}
+	return nil
+}
+
+// handleMessageRotateOperatorKey moves a staked, paused or unstaking actor to the operator of a new public key. The
+// delegations to a validator follow it to its new operator address.
+func (u *baseUtilityUnitOfWork) handleMessageRotateOperatorKey(message *typesUtil.MessageRotateOperatorKey) typesUtil.Error {
+	newPublicKey, er := crypto.NewPublicKeyFromBytes(message.NewPublicKey)
+	if er != nil {
+		return typesUtil.ErrNewPublicKeyFromBytes(er)
+	}
+	newAddress := newPublicKey.Address()
+	status, err := u.getActorStatus(message.ActorType, message.Address)
+	if err != nil {
+		return err
+	}
+	if status == coreTypes.StakeStatus_Unstaked {
+		return typesUtil.ErrInvalidStatus(status, coreTypes.StakeStatus_Staked)
+	}
+	// the new operator cannot be used, or have been used, by another actor of the same type
+	exists, err := u.getActorExists(message.ActorType, newAddress)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return typesUtil.ErrAlreadyExists()
+	}
+	if er := u.persistenceRWContext.RotateActorOperator(message.ActorType, message.Address, newAddress, message.NewPublicKey); er != nil {
+		return typesUtil.ErrRotateOperatorKey(er)
+	}
+	if message.ActorType == coreTypes.ActorType_ACTOR_TYPE_VAL {
+		return u.moveValidatorDelegations(message.Address, newAddress)
+	}
+	return nil
+}
+
 // REFACTOR: This can be moved over into utility/types/message.go
 func (u *baseUtilityUnitOfWork) getSignerCandidates(msg typesUtil.Message) ([][]byte, typesUtil.Error) {
 	switch x := msg.(type) {
@@ -228,10 +873,36 @@ func (u *baseUtilityUnitOfWork) getSignerCandidates(msg typesUtil.Message) ([][]
 		return u.getMessageStakeSignerCandidates(x)
 	case *typesUtil.MessageUnstake:
 		return u.getMessageUnstakeSignerCandidates(x)
+	case *typesUtil.MessagePause:
+		return u.getMessagePauseSignerCandidates(x)
 	case *typesUtil.MessageUnpause:
 		return u.getMessageUnpauseSignerCandidates(x)
 	case *typesUtil.MessageChangeParameter:
 		return u.getMessageChangeParameterSignerCandidates(x)
+	case *typesUtil.MessageClaim:
+		return u.getMessageClaimSignerCandidates(x)
+	case *typesUtil.MessageProof:
+		return u.getMessageProofSignerCandidates(x)
+	case *typesUtil.MessageTestScore:
+		return u.getFishermanSignerCandidates(x.FishermanAddress)
+	case *typesUtil.MessageProveTestScore:
+		return u.getFishermanSignerCandidates(x.FishermanAddress)
+	case *typesUtil.MessageFishermanPauseServicer:
+		return u.getFishermanSignerCandidates(x.FishermanAddress)
+	case *typesUtil.MessageDoubleSign:
+		return [][]byte{x.ReporterAddress}, nil
+	case *typesUtil.MessageDelegate:
+		return [][]byte{x.DelegatorAddress}, nil
+	case *typesUtil.MessageUndelegate:
+		return [][]byte{x.DelegatorAddress}, nil
+	case *typesUtil.MessageSubmitProposal:
+		return [][]byte{x.ProposerAddress}, nil
+	case *typesUtil.MessageVote:
+		return u.getVoterSignerCandidates(x.ValidatorAddress)
+	case *typesUtil.MessageScheduleUpgrade:
+		return u.getMessageScheduleUpgradeSignerCandidates(x)
+	case *typesUtil.MessageRotateOperatorKey:
+		return u.getMessageRotateOperatorKeySignerCandidates(x)
 	default:
 		return nil, typesUtil.ErrUnknownMessage(x)
 	}
@@ -267,6 +938,16 @@ func (u *baseUtilityUnitOfWork) getMessageUnstakeSignerCandidates(msg *typesUtil
 	return candidates, nil
 }
 
+func (u *baseUtilityUnitOfWork) getMessagePauseSignerCandidates(msg *typesUtil.MessagePause) ([][]byte, typesUtil.Error) {
+	output, err := u.getActorOutputAddress(msg.ActorType, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([][]byte, 0)
+	candidates = append(candidates, output, msg.Address)
+	return candidates, nil
+}
+
 func (u *baseUtilityUnitOfWork) getMessageUnpauseSignerCandidates(msg *typesUtil.MessageUnpause) ([][]byte, typesUtil.Error) {
 	output, err := u.getActorOutputAddress(msg.ActorType, msg.Address)
 	if err != nil {
@@ -277,6 +958,46 @@ func (u *baseUtilityUnitOfWork) getMessageUnpauseSignerCandidates(msg *typesUtil
 	return candidates, nil
 }
 
+func (u *baseUtilityUnitOfWork) getMessageClaimSignerCandidates(msg *typesUtil.MessageClaim) ([][]byte, typesUtil.Error) {
+	output, err := u.getActorOutputAddress(coreTypes.ActorType_ACTOR_TYPE_SERVICER, msg.ServicerAddress)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([][]byte, 0)
+	candidates = append(candidates, output, msg.ServicerAddress)
+	return candidates, nil
+}
+
+func (u *baseUtilityUnitOfWork) getMessageProofSignerCandidates(msg *typesUtil.MessageProof) ([][]byte, typesUtil.Error) {
+	output, err := u.getActorOutputAddress(coreTypes.ActorType_ACTOR_TYPE_SERVICER, msg.ServicerAddress)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([][]byte, 0)
+	candidates = append(candidates, output, msg.ServicerAddress)
+	return candidates, nil
+}
+
+// getMessageRotateOperatorKeySignerCandidates only allows the output address to rotate the operator key, as the
+// current operator key may be compromised
+func (u *baseUtilityUnitOfWork) getMessageRotateOperatorKeySignerCandidates(msg *typesUtil.MessageRotateOperatorKey) ([][]byte, typesUtil.Error) {
+	output, err := u.getActorOutputAddress(msg.ActorType, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{output}, nil
+}
+
+func (u *baseUtilityUnitOfWork) getFishermanSignerCandidates(fishermanAddr []byte) ([][]byte, typesUtil.Error) {
+	output, err := u.getActorOutputAddress(coreTypes.ActorType_ACTOR_TYPE_FISH, fishermanAddr)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([][]byte, 0)
+	candidates = append(candidates, output, fishermanAddr)
+	return candidates, nil
+}
+
 func (u *baseUtilityUnitOfWork) getMessageSendSignerCandidates(msg *typesUtil.MessageSend) ([][]byte, typesUtil.Error) {
 	return [][]byte{msg.FromAddress}, nil
 }
@@ -297,6 +1018,51 @@ func (u *baseUtilityUnitOfWork) checkBelowMaxChains(actorType coreTypes.ActorTyp
 	return nil
 }
 
+// checkRelayChainsRegistered ensures actors only stake for the relay chains of the registry maintained through the
+// `relay_chains` governance parameter, unless the registry is empty
+func (u *baseUtilityUnitOfWork) checkRelayChainsRegistered(actorType coreTypes.ActorType, chains []string) typesUtil.Error {
+	// validators don't have chains field
+	if actorType == coreTypes.ActorType_ACTOR_TYPE_VAL {
+		return nil
+	}
+
+	registry, err := u.getRelayChains()
+	if err != nil {
+		return err
+	}
+	if len(registry) == 0 {
+		return nil
+	}
+	registeredChains := make(map[string]struct{}, len(registry))
+	for _, relayChain := range registry {
+		registeredChains[relayChain.ID] = struct{}{}
+	}
+	for _, chain := range chains {
+		if _, ok := registeredChains[chain]; !ok {
+			return typesUtil.ErrRelayChainNotRegistered(chain)
+		}
+	}
+	return nil
+}
+
+// checkGeoZoneAllowed ensures actors can stake in `geoZone`, if any, according to the `allowed_geo_zones` governance
+// parameter
+func (u *baseUtilityUnitOfWork) checkGeoZoneAllowed(geoZone string) typesUtil.Error {
+	if geoZone == "" {
+		return nil
+	}
+	allowedGeoZones, err := u.getAllowedGeoZones()
+	if err != nil {
+		return err
+	}
+	for _, allowedGeoZone := range allowedGeoZones {
+		if allowedGeoZone == geoZone {
+			return nil
+		}
+	}
+	return typesUtil.ErrGeoZoneNotAllowed(geoZone)
+}
+
 func (u *baseUtilityUnitOfWork) checkAboveMinStake(actorType coreTypes.ActorType, amountStr string) (*big.Int, typesUtil.Error) {
 	minStake, err := u.getMinRequiredStakeAmount(actorType)
 	if err != nil {
@@ -311,3 +1077,12 @@ func (u *baseUtilityUnitOfWork) checkAboveMinStake(actorType coreTypes.ActorType
 	}
 	return amount, nil
 }
+
+func isActorInList(publicKey string, actors []*coreTypes.Actor) bool {
+	for _, actor := range actors {
+		if actor.GetPublicKey() == publicKey {
+			return true
+		}
+	}
+	return false
+}
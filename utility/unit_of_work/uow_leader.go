@@ -69,10 +69,7 @@ func (uow *leaderUtilityUnitOfWork) CreateAndApplyProposalBlock(proposer []byte,
 		txResult, err := uow.hydrateTxResult(tx, txIdx)
 		if err != nil {
 			uow.logger.Err(err).Msg("Error in ApplyTransaction")
-			// TODO(#327): Properly implement 'unhappy path' for save points
-			if err := uow.revertLastSavePoint(); err != nil {
-				return "", nil, err
-			}
+			// NB: `hydrateTxResult` reverts the changes it made to the state when it fails
 			txsTotalBz -= txBzSize
 			continue
 		}
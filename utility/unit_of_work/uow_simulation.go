@@ -0,0 +1,71 @@
+package unit_of_work
+
+import (
+	"math/big"
+
+	"github.com/pokt-network/pocket/logger"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/modules"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+// simulationSavePointKey identifies the save point taken before simulating a transaction
+const simulationSavePointKey = "simulation"
+
+var _ modules.UtilityUnitOfWork = &simulationUtilityUnitOfWork{}
+
+// simulationUtilityUnitOfWork applies transactions on top of a simulation context (see `NewSimulationContext` of the
+// persistence module) to find out their result, without ever persisting any change
+type simulationUtilityUnitOfWork struct {
+	baseUtilityUnitOfWork
+}
+
+// NewSimulationUOW returns a unit of work simulating transactions as if they were included in the block at `height`.
+// `simulationContext` is used both to read and to write, so the changes made by a transaction are visible to itself.
+func NewSimulationUOW(height int64, simulationContext modules.PersistenceRWContext) *simulationUtilityUnitOfWork {
+	return &simulationUtilityUnitOfWork{
+		baseUtilityUnitOfWork: baseUtilityUnitOfWork{
+			height:                 height,
+			persistenceReadContext: simulationContext,
+			persistenceRWContext:   simulationContext,
+			logger:                 logger.Global.CreateLoggerForModule(simulationUtilityUOWModuleName),
+		},
+	}
+}
+
+// SimulateTransaction applies `tx` as the first transaction of the block and reverts all the changes it made,
+// returning its result and fee. A transaction rejected before its messages are applied (e.g. because its signer
+// cannot pay the fee) results in the code and error it was rejected with.
+func (uow *simulationUtilityUnitOfWork) SimulateTransaction(tx *coreTypes.Transaction) (modules.TxResult, *big.Int, typesUtil.Error) {
+	txBz, er := tx.Bytes()
+	if er != nil {
+		return nil, nil, typesUtil.ErrProtoMarshal(er)
+	}
+
+	fee, err := GetTxFee(uow.persistenceReadContext, uow.height, tx)
+	if err != nil {
+		return rejectedTxResult(txBz, uow.height, err), big.NewInt(0), nil
+	}
+
+	if err := uow.newSavePoint([]byte(simulationSavePointKey)); err != nil {
+		return nil, nil, err
+	}
+	txResult, txErr := uow.hydrateTxResult(tx, 0)
+	if err := uow.revertLastSavePoint(); err != nil {
+		return nil, nil, err
+	}
+	if txErr != nil {
+		return rejectedTxResult(txBz, uow.height, txErr), fee, nil
+	}
+	return txResult, fee, nil
+}
+
+// rejectedTxResult is the result of a transaction that could not be included in the block at `height`
+func rejectedTxResult(txBz []byte, height int64, err typesUtil.Error) *typesUtil.TxResult {
+	return &typesUtil.TxResult{
+		Tx:         txBz,
+		Height:     height,
+		ResultCode: int32(err.Code()),
+		Error:      err.Error(),
+	}
+}
@@ -18,8 +18,18 @@ func (u *baseUtilityUnitOfWork) handleByzantineValidators(prevBlockByzantineVali
 	if err != nil {
 		return err
 	}
+	burnPercent, err := u.getMissedBlocksBurnPercentage()
+	if err != nil {
+		return err
+	}
+
+	for _, signerAddress := range prevBlockByzantineValidators {
+		// the validator may have rotated its operator key since it was expected to sign the block
+		address, er := u.getCurrentValidatorOperator(signerAddress)
+		if er != nil {
+			return er
+		}
 
-	for _, address := range prevBlockByzantineValidators {
 		// Get the latest number of missed blocks by the validator
 		numMissedBlocks, err := u.persistenceReadContext.GetValidatorMissedBlocks(address, u.height)
 		if err != nil {
@@ -46,29 +56,23 @@ func (u *baseUtilityUnitOfWork) handleByzantineValidators(prevBlockByzantineVali
 			return typesUtil.ErrSetMissedBlocks(err)
 		}
 		// burn validator for missing blocks
-		if err := u.burnValidator(address); err != nil {
+		if _, err := u.burnValidator(address, burnPercent); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-// burnValidator burns a validator's stake based on governance parameters for missing blocks
+// burnValidator burns `burnPercent` percent of a validator's stake, returning the amount burnt,
 // and begins unstaking if the stake falls below the necessary threshold
 // REFACTOR: Extend this to support burning other actors types & pools once the logic is implemented
-// ADDTEST: There are no good tests for this functionality, which MUST be added.
-func (u *baseUtilityUnitOfWork) burnValidator(addr []byte) typesUtil.Error {
+func (u *baseUtilityUnitOfWork) burnValidator(addr []byte, burnPercent int) (*big.Int, typesUtil.Error) {
 	actorType := coreTypes.ActorType_ACTOR_TYPE_VAL
 	actorPool := coreTypes.Pools_POOLS_VALIDATOR_STAKE
 
 	stakeAmount, err := u.getActorStakeAmount(actorType, addr)
 	if err != nil {
-		return err
-	}
-
-	burnPercent, err := u.getMissedBlocksBurnPercentage()
-	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// currentStake * burnPercent / 100
@@ -85,31 +89,78 @@ func (u *baseUtilityUnitOfWork) burnValidator(addr []byte) typesUtil.Error {
 
 	// remove burnt stake amount from the pool
 	if err := u.subPoolAmount(actorPool.FriendlyName(), burnAmountTruncated); err != nil {
-		return err
+		return nil, err
 	}
 
 	// remove burnt stake from the actor
 	newAmountAfterBurn := big.NewInt(0).Sub(stakeAmount, burnAmountTruncated)
 	if err := u.setActorStakeAmount(actorType, addr, newAmountAfterBurn); err != nil {
-		return err
+		return nil, err
 	}
 
 	// Need to check if the actor needs to be unstaked
 	minRequiredStake, err := u.getValidatorMinimumStake()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Check if amount after burn is below the min required stake
-	if minRequiredStake.Cmp(newAmountAfterBurn) == -1 {
+	if newAmountAfterBurn.Cmp(minRequiredStake) == -1 {
 		unbondingHeight, err := u.getUnbondingHeight(actorType)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if err := u.setActorUnbondingHeight(actorType, addr, unbondingHeight); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
+	return burnAmountTruncated, nil
+}
+
+// handleDoubleSign burns the stake of a validator that double signed at `evidenceHeight` with the operator
+// `validatorAddr` by `DoubleSignBurnPercentage`, and rewards the reporter of the evidence with
+// `DoubleSignReporterRewardPercentage` percent of the amount burnt. The stake is burnt from the current operator of the
+// validator, so rotating its key does not let it escape the punishment.
+func (u *baseUtilityUnitOfWork) handleDoubleSign(validatorAddr, reporterAddr []byte, evidenceHeight int64) typesUtil.Error {
+	burnPercent, err := u.getDoubleSignBurnPercentage()
+	if err != nil {
+		return err
+	}
+	reporterPercent, err := u.getDoubleSignReporterRewardPercentage()
+	if err != nil {
+		return err
+	}
+	if reporterPercent < 0 || reporterPercent > 100 {
+		return typesUtil.ErrInvalidDoubleSignReporterRewardPercentage()
+	}
+
+	operatorAddr, err := u.getCurrentValidatorOperator(validatorAddr)
+	if err != nil {
+		return err
+	}
+	burnAmount, err := u.burnValidator(operatorAddr, burnPercent)
+	if err != nil {
+		return err
+	}
+	reporterReward := new(big.Int).Mul(burnAmount, big.NewInt(int64(reporterPercent)))
+	reporterReward.Quo(reporterReward, big.NewInt(100))
+	if err := u.addAccountAmount(reporterAddr, reporterReward); err != nil {
+		return err
+	}
+
+	if er := u.persistenceRWContext.InsertDoubleSignEvidence(validatorAddr, evidenceHeight); er != nil {
+		return typesUtil.ErrInsert(er)
+	}
 	return nil
 }
+
+// getCurrentValidatorOperator returns the operator the validator that used the operator `addr` is currently staked with
+// NB: the RW context is used so the key rotations of the current block are taken into account
+func (u *baseUtilityUnitOfWork) getCurrentValidatorOperator(addr []byte) ([]byte, typesUtil.Error) {
+	operatorAddr, er := u.persistenceRWContext.GetCurrentOperator(coreTypes.ActorType_ACTOR_TYPE_VAL, addr, u.height)
+	if er != nil {
+		return nil, typesUtil.ErrGetCurrentOperator(er)
+	}
+	return operatorAddr, nil
+}
@@ -0,0 +1,88 @@
+package unit_of_work
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUtilityUnitOfWork_HandleDoubleSign(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+
+	validator := getFirstActor(t, uow, coreTypes.ActorType_ACTOR_TYPE_VAL)
+	validatorAddr, er := hex.DecodeString(validator.GetAddress())
+	require.NoError(t, er)
+	reporterAddr, er := hex.DecodeString(getAllTestingAccounts(t, uow)[0].GetAddress())
+	require.NoError(t, er)
+
+	stakeBefore, er := utils.StringToBigInt(validator.GetStakedAmount())
+	require.NoError(t, er)
+	reporterBalanceBefore, err := uow.getAccountAmount(reporterAddr)
+	require.NoError(t, err)
+
+	require.NoError(t, uow.handleDoubleSign(validatorAddr, reporterAddr, 0))
+
+	burnPercent, err := uow.getDoubleSignBurnPercentage()
+	require.NoError(t, err)
+	reporterPercent, err := uow.getDoubleSignReporterRewardPercentage()
+	require.NoError(t, err)
+
+	expectedBurn := new(big.Int).Mul(stakeBefore, big.NewInt(int64(burnPercent)))
+	expectedBurn.Quo(expectedBurn, big.NewInt(100))
+	expectedReward := new(big.Int).Mul(expectedBurn, big.NewInt(int64(reporterPercent)))
+	expectedReward.Quo(expectedReward, big.NewInt(100))
+
+	stakeAfter, err := uow.getActorStakeAmount(coreTypes.ActorType_ACTOR_TYPE_VAL, validatorAddr)
+	require.NoError(t, err)
+	require.Equal(t, expectedBurn, new(big.Int).Sub(stakeBefore, stakeAfter))
+
+	reporterBalanceAfter, err := uow.getAccountAmount(reporterAddr)
+	require.NoError(t, err)
+	require.Equal(t, expectedReward, new(big.Int).Sub(reporterBalanceAfter, reporterBalanceBefore))
+
+	// the evidence is recorded so the validator cannot be punished twice for it
+	exists, er := uow.persistenceRWContext.DoubleSignEvidenceExists(validatorAddr, 0, uow.height)
+	require.NoError(t, er)
+	require.True(t, exists)
+}
+
+func TestUtilityUnitOfWork_HandleDoubleSign_RotatedOperator(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+
+	validator := getFirstActor(t, uow, coreTypes.ActorType_ACTOR_TYPE_VAL)
+	signerAddr, er := hex.DecodeString(validator.GetAddress())
+	require.NoError(t, er)
+	reporterAddr, er := hex.DecodeString(getAllTestingAccounts(t, uow)[0].GetAddress())
+	require.NoError(t, er)
+	stakeBefore, er := utils.StringToBigInt(validator.GetStakedAmount())
+	require.NoError(t, er)
+
+	// the validator rotates its operator key after double signing with the old one
+	newOperatorKey, er := crypto.GeneratePublicKey()
+	require.NoError(t, er)
+	newOperatorAddr := newOperatorKey.Address()
+	er = uow.persistenceRWContext.RotateActorOperator(coreTypes.ActorType_ACTOR_TYPE_VAL, signerAddr, newOperatorAddr, newOperatorKey.Bytes())
+	require.NoError(t, er)
+
+	require.NoError(t, uow.handleDoubleSign(signerAddr, reporterAddr, 0))
+
+	burnPercent, err := uow.getDoubleSignBurnPercentage()
+	require.NoError(t, err)
+	expectedBurn := new(big.Int).Mul(stakeBefore, big.NewInt(int64(burnPercent)))
+	expectedBurn.Quo(expectedBurn, big.NewInt(100))
+
+	// the stake is burnt from the new operator
+	stakeAfter, err := uow.getActorStakeAmount(coreTypes.ActorType_ACTOR_TYPE_VAL, newOperatorAddr)
+	require.NoError(t, err)
+	require.Equal(t, expectedBurn, new(big.Int).Sub(stakeBefore, stakeAfter))
+
+	// the evidence stays recorded under the operator that signed the votes
+	exists, er := uow.persistenceRWContext.DoubleSignEvidenceExists(signerAddr, 0, uow.height)
+	require.NoError(t, er)
+	require.True(t, exists)
+}